@@ -0,0 +1,101 @@
+// Package approval holds the approval policy of an environment's build commits and the approvals
+// recorded on them. A commit is applied once enough users allowed by the policy approve it, and
+// cancelled if that doesn't happen before the review times out.
+package approval
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultTimeout is how long a commit waits for its review when the policy doesn't set a timeout
+	DefaultTimeout = 20 * time.Minute
+	// RoleUser and RoleAdmin are the roles of the users that can approve commits, admins can approve
+	// anything a user can
+	RoleUser  = "USER"
+	RoleAdmin = "ADMIN"
+)
+
+// Policy is the `approval_policy` block of an environment. An environment without one keeps the
+// default of a single approval from any user, including the user who made the commit.
+type Policy struct {
+	// Approvals is the number of different users that have to approve a commit
+	Approvals int `hcl:"approvals,optional" json:"approvals,omitempty"`
+	// Role is the role approvers need, USER (the default) or ADMIN
+	Role string `hcl:"role,optional" json:"role,omitempty"`
+	// SelfApproval lets the user who made a commit approve it, it is allowed unless set to false
+	SelfApproval *bool `hcl:"self_approval,optional" json:"self_approval,omitempty"`
+	// Timeout is how long a commit waits for its approvals before it is cancelled
+	Timeout string `hcl:"timeout,optional" json:"timeout,omitempty"`
+	// AutoApproveRoot approves the ROOT commit of new builds without a review, meant for dev environments
+	AutoApproveRoot bool `hcl:"auto_approve_root,optional" json:"auto_approve_root,omitempty"`
+}
+
+// Approval records a user approving a build commit
+type Approval struct {
+	UserID     string    `json:"user_id"`
+	Username   string    `json:"username"`
+	Role       string    `json:"role"`
+	ApprovedAt time.Time `json:"approved_at"`
+	// Auto is set on the approval of a commit the policy approved on its own (see AutoApproveRoot)
+	Auto bool `json:"auto,omitempty"`
+}
+
+// Validate checks the number of approvals, the role and the timeout of the policy
+func (p *Policy) Validate() error {
+	if p == nil {
+		return nil
+	}
+	if p.Approvals < 0 {
+		return fmt.Errorf("approvals can't be negative")
+	}
+	if p.Role != "" && p.Role != RoleUser && p.Role != RoleAdmin {
+		return fmt.Errorf("role must be %s or %s, not \"%s\"", RoleUser, RoleAdmin, p.Role)
+	}
+	if p.Timeout != "" {
+		timeout, err := time.ParseDuration(p.Timeout)
+		if err != nil {
+			return fmt.Errorf("invalid timeout \"%s\": %v", p.Timeout, err)
+		}
+		if timeout <= 0 {
+			return fmt.Errorf("timeout must be positive")
+		}
+	}
+	return nil
+}
+
+// RequiredApprovals returns how many different users have to approve a commit
+func (p *Policy) RequiredApprovals() int {
+	if p == nil || p.Approvals < 1 {
+		return 1
+	}
+	return p.Approvals
+}
+
+// ReviewTimeout returns how long a commit waits for its approvals
+func (p *Policy) ReviewTimeout() time.Duration {
+	if p != nil {
+		if timeout, err := time.ParseDuration(p.Timeout); err == nil && timeout > 0 {
+			return timeout
+		}
+	}
+	return DefaultTimeout
+}
+
+// AutoApproves reports whether commits of the given type (ROOT, REBUILD or DELETE) skip the review
+func (p *Policy) AutoApproves(commitType string) bool {
+	return p != nil && p.AutoApproveRoot && commitType == "ROOT"
+}
+
+// Authorize checks that a user with the given role can approve a commit. author is set when the
+// user made the commit.
+func (p *Policy) Authorize(role string, author bool) error {
+	if p != nil && p.Role == RoleAdmin && role != RoleAdmin {
+		return fmt.Errorf("commits of this environment have to be approved by an %s", RoleAdmin)
+	}
+	if author && p != nil && p.SelfApproval != nil && !*p.SelfApproval {
+		return fmt.Errorf("commits of this environment can't be approved by the user who made them")
+	}
+	return nil
+}
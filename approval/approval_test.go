@@ -0,0 +1,53 @@
+package approval
+
+import "testing"
+
+func TestPolicyAuthorize(t *testing.T) {
+	allowed, denied := true, false
+	tests := []struct {
+		name    string
+		policy  *Policy
+		role    string
+		author  bool
+		wantErr bool
+	}{
+		{"no policy user", nil, RoleUser, false, false},
+		{"no policy author", nil, RoleUser, true, false},
+		{"default policy user", &Policy{}, RoleUser, false, false},
+		{"default policy admin", &Policy{}, RoleAdmin, false, false},
+		{"default policy author", &Policy{}, RoleUser, true, false},
+		{"user role user", &Policy{Role: RoleUser}, RoleUser, false, false},
+		{"admin role admin", &Policy{Role: RoleAdmin}, RoleAdmin, false, false},
+		{"admin role user", &Policy{Role: RoleAdmin}, RoleUser, false, true},
+		{"admin role unknown role", &Policy{Role: RoleAdmin}, "", false, true},
+		{"self approval allowed", &Policy{SelfApproval: &allowed}, RoleUser, true, false},
+		{"self approval denied author", &Policy{SelfApproval: &denied}, RoleUser, true, true},
+		{"self approval denied other user", &Policy{SelfApproval: &denied}, RoleUser, false, false},
+		{"self approval denied admin author", &Policy{Role: RoleAdmin, SelfApproval: &denied}, RoleAdmin, true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.policy.Authorize(tt.role, tt.author); (err != nil) != tt.wantErr {
+				t.Errorf("Authorize(%q, %v) error = %v, wantErr %v", tt.role, tt.author, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPolicyRequiredApprovals(t *testing.T) {
+	tests := []struct {
+		policy *Policy
+		want   int
+	}{
+		{nil, 1},
+		{&Policy{}, 1},
+		{&Policy{Approvals: -2}, 1},
+		{&Policy{Approvals: 1}, 1},
+		{&Policy{Approvals: 3}, 3},
+	}
+	for _, tt := range tests {
+		if got := tt.policy.RequiredApprovals(); got != tt.want {
+			t.Errorf("RequiredApprovals() of %+v = %d, want %d", tt.policy, got, tt.want)
+		}
+	}
+}
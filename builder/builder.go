@@ -2,17 +2,18 @@ package builder
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
-	"strconv"
+	"os"
 	"time"
 
+	"github.com/gen0cide/laforge/builder/simulate"
 	"github.com/gen0cide/laforge/builder/vspherensxt"
 	"github.com/gen0cide/laforge/builder/vspherensxt/nsxt"
 	"github.com/gen0cide/laforge/builder/vspherensxt/vsphere"
 	"github.com/gen0cide/laforge/ent"
 	"github.com/gen0cide/laforge/logging"
+	"github.com/go-redis/redis/v8"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/semaphore"
 )
@@ -29,189 +30,191 @@ type Builder interface {
 	TeardownNetwork(ctx context.Context, provisionedNetwork *ent.ProvisionedNetwork) (err error)
 }
 
+// Refresher is implemented by builders that can check what they deployed against the real
+// infrastructure, so hosts and networks deleted or powered off by hand can be found
+type Refresher interface {
+	RefreshHost(ctx context.Context, provisionedHost *ent.ProvisionedHost) (exists bool, poweredOn bool, err error)
+	RefreshNetwork(ctx context.Context, provisionedNetwork *ent.ProvisionedNetwork) (exists bool, err error)
+}
+
+func init() {
+	Register(vspherensxt.VSphereNSXTBuilder{}, &vspherensxt.Config{}, func(environment *ent.Environment, logger *logging.Logger) (Builder, error) {
+		return NewVSphereNSXTBuilder(environment, logger)
+	})
+	Register(simulate.SimulateBuilder{}, &simulate.Config{}, func(environment *ent.Environment, logger *logging.Logger) (Builder, error) {
+		return NewSimulateBuilder(environment, logger)
+	})
+}
+
+// BuilderFromEnvironment returns the builder registered under the environment's builder ID. If no
+// in-process builder matches, the plugin directory is searched for an out-of-process builder.
 func BuilderFromEnvironment(environment *ent.Environment, logger *logging.Logger) (genericBuilder Builder, err error) {
-	switch environment.Builder {
-	case "vsphere-nsxt":
-		genericBuilder, err = NewVSphereNSXTBuilder(environment, logger)
+	factory, exists := lookupFactory(environment.Builder)
+	if exists {
+		genericBuilder, err = factory(environment, logger)
+		if err != nil {
+			logrus.Errorf("Failed to make %s builder. Err: %v", environment.Builder, err)
+			return
+		}
+		return
+	}
+	pluginPath, exists := findPlugin(environment.Builder)
+	if exists {
+		genericBuilder, err = NewPluginBuilder(pluginPath, environment, logger)
 		if err != nil {
-			logrus.Errorf("Failed to make vSphere NSX-T builder. Err: %v", err)
+			logrus.Errorf("Failed to start %s builder plugin. Err: %v", environment.Builder, err)
 			return
 		}
 		return
 	}
-	err = fmt.Errorf("error: builder not found")
+	err = fmt.Errorf("error: builder \"%s\" not found", environment.Builder)
 	logrus.Error(err)
 	return
 }
 
 // NewVSphereNSXTBuilder creates a builder instance to deploy environments to VSphere and NSX-T
 func NewVSphereNSXTBuilder(env *ent.Environment, logger *logging.Logger) (builder vspherensxt.VSphereNSXTBuilder, err error) {
-	laforgeServerUrl, exists := env.Config["laforge_server_url"]
-	if !exists {
-		err = errors.New("laforge_server_url doesn't exist in the environment configuration")
-		return
-	}
-	vsphereUsername, exists := env.Config["vsphere_username"]
-	if !exists {
-		err = errors.New("vsphere_username doesn't exist in the environment configuration")
-		return
-	}
-	vspherePassword, exists := env.Config["vsphere_password"]
-	if !exists {
-		err = errors.New("vsphere_password doesn't exist in the environment configuration")
-		return
-	}
-	vsphereBaseUrl, exists := env.Config["vsphere_base_url"]
-	if !exists {
-		err = errors.New("vsphere_base_url doesn't exist in the environment configuration")
-		return
-	}
-	nsxtCertPath, exists := env.Config["nsxt_cert_path"]
-	if !exists {
-		err = errors.New("nsxt_cert_path doesn't exist in the environment configuration")
-		return
-	}
-	nsxtCACertPath, exists := env.Config["nsxt_ca_cert_path"]
-	if !exists {
-		err = errors.New("nsxt_ca_cert_path doesn't exist in the environment configuration")
-		return
-	}
-	nsxtKeyPath, exists := env.Config["nsxt_key_path"]
-	if !exists {
-		err = errors.New("nsxt_key_path doesn't exist in the environment configuration")
-		return
-	}
-	nsxtBaseUrl, exists := env.Config["nsxt_base_url"]
-	if !exists {
-		err = errors.New("nsxt_base_url doesn't exist in the environment configuration")
-		return
-	}
-	nsxtIpPoolName, exists := env.Config["nsxt_ip_pool_name"]
-	if !exists {
-		err = errors.New("nsxt_ip_pool_name doesn't exist in the environment configuration")
-		return
-	}
-	nsxtEdgeClusterPath, exists := env.Config["nsxt_edge_cluster_path"]
-	if !exists {
-		err = errors.New("nsxt_edge_cluster_path doesn't exist in the environment configuration")
-		return
-	}
-	contentLibraryName, exists := env.Config["vsphere_content_library"]
-	if !exists {
-		err = errors.New("vsphere_content_library doesn't exist in the environment configuration")
-		return
-	}
-	datastoreName, exists := env.Config["vsphere_datastore"]
-	if !exists {
-		err = errors.New("vsphere_datastore doesn't exist in the environment configuration")
-		return
-	}
-	resourcePoolName, exists := env.Config["vsphere_resource_pool"]
-	if !exists {
-		err = errors.New("vsphere_resource_pool doesn't exist in the environment configuration")
-		return
-	}
-	folderName, exists := env.Config["vsphere_folder"]
-	if !exists {
-		err = errors.New("vsphere_folder doesn't exist in the environment configuration")
-		return
-	}
-	templatePrefix, exists := env.Config["vsphere_template_prefix"]
-	if !exists {
-		err = errors.New("vsphere_template_prefix doesn't exist in the environment configuration")
-		return
-	}
-	maxBuildWorkersString, exists := env.Config["vsphere_max_build_workers"]
-	if !exists {
-		maxBuildWorkersString = "8"
-	}
-	maxBuildThreads, err := strconv.Atoi(maxBuildWorkersString)
-	if err != nil {
-		maxBuildThreads = 8
-	}
-	maxTeardownWorkersString, exists := env.Config["vsphere_max_teardown_workers"]
-	if !exists {
-		maxTeardownWorkersString = "16"
-	}
-	maxTeardownThreads, err := strconv.Atoi(maxTeardownWorkersString)
+	config := vspherensxt.Config{}
+	err = ParseConfig(env.Config, &config)
 	if err != nil {
-		maxTeardownThreads = 16
+		return
 	}
 
 	httpClient := http.Client{
 		Timeout: 5 * time.Minute,
 	}
 
-	nsxtHttpClient, err := nsxt.NewPrincipalIdentityClient(nsxtCertPath, nsxtKeyPath, nsxtCACertPath)
+	nsxtHttpClient, err := nsxt.NewPrincipalIdentityClient(config.NsxtCertPath, config.NsxtKeyPath, config.NsxtCACertPath)
 	if err != nil {
 		return
 	}
 
 	nsxtClient := nsxt.NSXTClient{
 		HttpClient:      nsxtHttpClient,
-		BaseUrl:         nsxtBaseUrl,
-		IpPoolName:      nsxtIpPoolName,
-		EdgeClusterPath: nsxtEdgeClusterPath,
+		BaseUrl:         config.NsxtBaseUrl,
+		IpPoolName:      config.NsxtIpPoolName,
+		EdgeClusterPath: config.NsxtEdgeClusterPath,
 		MaxRetries:      10,
 		Logger:          logger,
 	}
 
 	vsphereClient := vsphere.VSphere{
 		HttpClient: httpClient,
-		ServerUrl:  laforgeServerUrl,
-		BaseUrl:    vsphereBaseUrl,
-		Username:   vsphereUsername,
-		Password:   vspherePassword,
+		ServerUrl:  config.LaforgeServerUrl,
+		BaseUrl:    config.VSphereBaseUrl,
+		Username:   config.VSphereUsername,
+		Password:   config.VSpherePassword,
 		MaxRetries: 10,
 		Logger:     logger,
 	}
 
-	vsphere.InitializeGovmomi(&vsphereClient, vsphereBaseUrl, vsphereUsername, vspherePassword)
+	vsphere.InitializeGovmomi(&vsphereClient, config.VSphereBaseUrl, config.VSphereUsername, config.VSpherePassword)
 
 	ctx := context.Background()
 
-	datastore, exists, err := vsphereClient.GetDatastoreSummaryByName(ctx, datastoreName)
+	datastore, exists, err := vsphereClient.GetDatastoreSummaryByName(ctx, config.DatastoreName)
 	if err != nil {
 		return
 	}
 	if !exists {
-		err = fmt.Errorf("error datastore \"%s\" doesn't exist", datastoreName)
+		err = fmt.Errorf("error datastore \"%s\" doesn't exist", config.DatastoreName)
 		logrus.Error(err)
 		return
 	}
 
-	folder, err := vsphereClient.GetFolderSummaryByName(ctx, folderName)
+	folder, err := vsphereClient.GetFolderSummaryByName(ctx, config.FolderName)
 	if err != nil {
 		err = fmt.Errorf("error finding folder: %v", err)
 		logrus.Error(err)
 		return
 	}
 
-	resourcePool, err := vsphereClient.Finder.ResourcePool(ctx, resourcePoolName)
+	resourcePool, err := vsphereClient.Finder.ResourcePool(ctx, config.ResourcePoolName)
 	if err != nil {
 		err = fmt.Errorf("error finding resource pool: %v", err)
 		logrus.Error(err)
 		return
 	}
 
-	deployWorkerPool := semaphore.NewWeighted(int64(maxBuildThreads))
-	teardownWorkerPool := semaphore.NewWeighted(int64(maxTeardownThreads))
+	instanceSizes, err := vspherensxt.ParseInstanceSizes(config.InstanceSizes)
+	if err != nil {
+		return
+	}
+
+	deployWorkerPool := semaphore.NewWeighted(int64(config.MaxBuildWorkers))
+	teardownWorkerPool := semaphore.NewWeighted(int64(config.MaxTeardownWorkers))
 
 	builder = vspherensxt.VSphereNSXTBuilder{
 		HttpClient:                httpClient,
-		Username:                  vsphereUsername,
-		Password:                  vspherePassword,
+		Username:                  config.VSphereUsername,
+		Password:                  config.VSpherePassword,
 		NsxtClient:                nsxtClient,
-		TemplatePrefix:            templatePrefix,
+		TemplatePrefix:            config.TemplatePrefix,
 		VSphereClient:             vsphereClient,
-		VSphereContentLibraryName: contentLibraryName,
+		VSphereContentLibraryName: config.ContentLibraryName,
 		VSphereDatastore:          datastore,
 		VSphereResourcePool:       resourcePool,
 		VSphereFolder:             folder,
 		Logger:                    logger,
-		MaxWorkers:                maxBuildThreads,
+		MaxWorkers:                config.MaxBuildWorkers,
 		DeployWorkerPool:          deployWorkerPool,
 		TeardownWorkerPool:        teardownWorkerPool,
+		InstanceSizes:             instanceSizes,
+	}
+	return
+}
+
+// NewSimulateBuilder creates a builder instance that fakes deployments using the simulate_* keys of the environment configuration
+func NewSimulateBuilder(env *ent.Environment, logger *logging.Logger) (builder simulate.SimulateBuilder, err error) {
+	config := simulate.Config{}
+	err = ParseConfig(env.Config, &config)
+	if err != nil {
+		return
+	}
+	toSet := func(items []string) map[string]bool {
+		set := map[string]bool{}
+		for _, item := range items {
+			set[item] = true
+		}
+		return set
+	}
+	failTeams := map[int]bool{}
+	for _, teamNumber := range config.FailTeams {
+		failTeams[teamNumber] = true
+	}
+	seed := config.Seed
+	if _, exists := env.Config["simulate_seed"]; !exists {
+		seed = time.Now().UnixNano()
+	}
+
+	var rdb *redis.Client
+	if redisHost, exists := os.LookupEnv("REDIS_SERVER"); exists {
+		rdb = redis.NewClient(&redis.Options{
+			Addr:     redisHost,
+			Password: os.Getenv("REDIS_PASSWORD"),
+			DB:       0, // use default DB
+		})
+	}
+
+	builder = simulate.SimulateBuilder{
+		DeployDelay:        config.DeployDelay,
+		TeardownDelay:      config.TeardownDelay,
+		Jitter:             config.Jitter,
+		HostFailureRate:    config.HostFailureRate,
+		NetworkFailureRate: config.NetworkFailureRate,
+		FailHosts:          toSet(config.FailHosts),
+		FailNetworks:       toSet(config.FailNetworks),
+		FailTeams:          failTeams,
+		Agent:              config.Agent,
+		AgentDelay:         config.AgentDelay,
+		AgentFailureRate:   config.AgentFailureRate,
+		FailSteps:          toSet(config.FailSteps),
+		MissingHosts:       toSet(config.MissingHosts),
+		PoweredOffHosts:    toSet(config.PoweredOffHosts),
+		MissingNetworks:    toSet(config.MissingNetworks),
+		Rand:               simulate.NewLockedRand(seed),
+		RDB:                rdb,
+		Logger:             logger,
 	}
 	return
 }
@@ -0,0 +1,302 @@
+package builder
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Builders declare their configuration as a struct whose fields are tagged with the
+// environment config key they are read from:
+//
+//	type MyConfig struct {
+//		BaseUrl  string        `config:"my_base_url,required" description:"URL of the API"`
+//		Workers  int           `config:"my_workers" default:"8" min:"1"`
+//		Rate     float64       `config:"my_rate" default:"0" min:"0" max:"1"`
+//		Timeout  time.Duration `config:"my_timeout" default:"5m"`
+//		Insecure bool          `config:"my_insecure"`
+//		Hosts    []string      `config:"my_hosts"`
+//	}
+//
+// Supported field types are string, int, int64, float64, bool, time.Duration, []string and []int
+// (lists are comma separated).
+
+// ConfigError describes a problem with a single key of an environment's config
+type ConfigError struct {
+	// Key is the config key the error is about
+	Key string
+	// Missing is true when a required key wasn't set
+	Missing bool
+	// Message describes what is wrong with the value
+	Message string
+}
+
+func (e ConfigError) Error() string {
+	if e.Key == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %s", e.Key, e.Message)
+}
+
+// ConfigErrors holds every problem found while parsing a config
+type ConfigErrors []ConfigError
+
+func (e ConfigErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, configErr := range e {
+		messages = append(messages, configErr.Error())
+	}
+	return "invalid builder config: " + strings.Join(messages, "; ")
+}
+
+// ConfigValidator is implemented by config structs that need checks beyond what the struct
+// tags can describe. Validate is only called once every tagged field parsed successfully. Errors
+// prefixed with "<key>: " are attributed to that key.
+type ConfigValidator interface {
+	Validate() error
+}
+
+// ConfigField describes a single key of a builder's config schema
+type ConfigField struct {
+	Key         string
+	Type        string
+	Required    bool
+	Default     string
+	Description string
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// ParseConfig fills the tagged fields of target (a pointer to a config struct) from the
+// environment config. All problems are collected and returned as ConfigErrors.
+func ParseConfig(config map[string]string, target interface{}) error {
+	value := reflect.ValueOf(target)
+	if value.Kind() != reflect.Ptr || value.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("config target must be a pointer to a struct, got %T", target)
+	}
+	value = value.Elem()
+	configErrors := ConfigErrors{}
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		key, required, ok := parseConfigTag(field)
+		if !ok {
+			continue
+		}
+		raw, exists := config[key]
+		if !exists {
+			if required {
+				configErrors = append(configErrors, ConfigError{
+					Key:     key,
+					Missing: true,
+					Message: "required key is missing from the environment configuration",
+				})
+				continue
+			}
+			raw, exists = field.Tag.Lookup("default")
+			if !exists {
+				continue
+			}
+		}
+		err := setConfigField(value.Field(i), field, raw)
+		if err != nil {
+			configErrors = append(configErrors, ConfigError{
+				Key:     key,
+				Message: err.Error(),
+			})
+		}
+	}
+	if len(configErrors) > 0 {
+		return configErrors
+	}
+	if validator, ok := target.(ConfigValidator); ok {
+		err := validator.Validate()
+		switch err := err.(type) {
+		case nil:
+			return nil
+		case ConfigErrors:
+			return err
+		case ConfigError:
+			return ConfigErrors{err}
+		default:
+			return ConfigErrors{validatorError(target, err)}
+		}
+	}
+	return nil
+}
+
+// validatorError turns an error returned by a ConfigValidator into a ConfigError, using the key
+// the message is prefixed with when it is one of the config's keys
+func validatorError(target interface{}, err error) ConfigError {
+	parts := strings.SplitN(err.Error(), ": ", 2)
+	if len(parts) == 2 {
+		for _, field := range ConfigSchema(target) {
+			if field.Key == parts[0] {
+				return ConfigError{Key: parts[0], Message: parts[1]}
+			}
+		}
+	}
+	return ConfigError{Message: err.Error()}
+}
+
+// ConfigSchema lists the keys declared by a config struct sorted by key
+func ConfigSchema(target interface{}) []ConfigField {
+	configType := reflect.TypeOf(target)
+	for configType.Kind() == reflect.Ptr {
+		configType = configType.Elem()
+	}
+	fields := []ConfigField{}
+	if configType.Kind() != reflect.Struct {
+		return fields
+	}
+	for i := 0; i < configType.NumField(); i++ {
+		field := configType.Field(i)
+		key, required, ok := parseConfigTag(field)
+		if !ok {
+			continue
+		}
+		fieldType := field.Type.String()
+		if field.Type == durationType {
+			fieldType = "duration"
+		}
+		fields = append(fields, ConfigField{
+			Key:         key,
+			Type:        fieldType,
+			Required:    required,
+			Default:     field.Tag.Get("default"),
+			Description: field.Tag.Get("description"),
+		})
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Key < fields[j].Key
+	})
+	return fields
+}
+
+func parseConfigTag(field reflect.StructField) (key string, required bool, ok bool) {
+	tag, exists := field.Tag.Lookup("config")
+	if !exists || tag == "" || tag == "-" {
+		return "", false, false
+	}
+	parts := strings.Split(tag, ",")
+	for _, option := range parts[1:] {
+		if option == "required" {
+			required = true
+		}
+	}
+	return parts[0], required, true
+}
+
+func setConfigField(fieldValue reflect.Value, field reflect.StructField, raw string) error {
+	switch {
+	case field.Type == durationType:
+		duration, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("\"%s\" is not a valid duration (ex. \"30s\", \"5m\")", raw)
+		}
+		if err := checkBounds(field, float64(duration), func(bound string) (float64, error) {
+			d, err := time.ParseDuration(bound)
+			return float64(d), err
+		}); err != nil {
+			return err
+		}
+		fieldValue.SetInt(int64(duration))
+	case field.Type.Kind() == reflect.String:
+		if raw == "" && strings.Contains(field.Tag.Get("config"), ",required") {
+			return fmt.Errorf("value can't be empty")
+		}
+		fieldValue.SetString(raw)
+	case field.Type.Kind() == reflect.Int || field.Type.Kind() == reflect.Int64:
+		number, err := strconv.ParseInt(strings.TrimSpace(raw), 10, 64)
+		if err != nil {
+			return fmt.Errorf("\"%s\" is not a valid integer", raw)
+		}
+		if err := checkBounds(field, float64(number), parseFloatBound); err != nil {
+			return err
+		}
+		fieldValue.SetInt(number)
+	case field.Type.Kind() == reflect.Float64:
+		number, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+		if err != nil {
+			return fmt.Errorf("\"%s\" is not a valid number", raw)
+		}
+		if err := checkBounds(field, number, parseFloatBound); err != nil {
+			return err
+		}
+		fieldValue.SetFloat(number)
+	case field.Type.Kind() == reflect.Bool:
+		boolean, err := strconv.ParseBool(strings.TrimSpace(raw))
+		if err != nil {
+			return fmt.Errorf("\"%s\" is not a valid boolean (true/false)", raw)
+		}
+		fieldValue.SetBool(boolean)
+	case field.Type.Kind() == reflect.Slice && field.Type.Elem().Kind() == reflect.String:
+		items := []string{}
+		for _, item := range strings.Split(raw, ",") {
+			item = strings.TrimSpace(item)
+			if item != "" {
+				items = append(items, item)
+			}
+		}
+		fieldValue.Set(reflect.ValueOf(items))
+	case field.Type.Kind() == reflect.Slice && field.Type.Elem().Kind() == reflect.Int:
+		items := []int{}
+		for _, item := range strings.Split(raw, ",") {
+			item = strings.TrimSpace(item)
+			if item == "" {
+				continue
+			}
+			number, err := strconv.Atoi(item)
+			if err != nil {
+				return fmt.Errorf("\"%s\" is not a valid integer", item)
+			}
+			items = append(items, number)
+		}
+		fieldValue.Set(reflect.ValueOf(items))
+	default:
+		return fmt.Errorf("unsupported config field type %s", field.Type)
+	}
+	return nil
+}
+
+func parseFloatBound(bound string) (float64, error) {
+	return strconv.ParseFloat(bound, 64)
+}
+
+func checkBounds(field reflect.StructField, value float64, parseBound func(string) (float64, error)) error {
+	if minString, exists := field.Tag.Lookup("min"); exists {
+		min, err := parseBound(minString)
+		if err == nil && value < min {
+			return fmt.Errorf("value must be at least %s", minString)
+		}
+	}
+	if maxString, exists := field.Tag.Lookup("max"); exists {
+		max, err := parseBound(maxString)
+		if err == nil && value > max {
+			return fmt.Errorf("value must be at most %s", maxString)
+		}
+	}
+	return nil
+}
+
+// ValidateConfig checks an environment's config against the schema of its builder without
+// connecting to any infrastructure. Out-of-process builders validate their own config when
+// they are used, so only their presence is checked here.
+func ValidateConfig(builderID string, config map[string]string) error {
+	registryMu.RLock()
+	reg, exists := registry[builderID]
+	registryMu.RUnlock()
+	if !exists {
+		if _, found := findPlugin(builderID); found {
+			return nil
+		}
+		return fmt.Errorf("builder \"%s\" not found", builderID)
+	}
+	if reg.config == nil {
+		return nil
+	}
+	target := reflect.New(reflect.TypeOf(reg.config).Elem()).Interface()
+	return ParseConfig(config, target)
+}
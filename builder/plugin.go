@@ -0,0 +1,342 @@
+package builder
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gen0cide/laforge/builder/plugin"
+	"github.com/gen0cide/laforge/ent"
+	"github.com/gen0cide/laforge/logging"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+// PluginPrefix is the file name prefix of out-of-process builder executables.
+// A builder with the ID "my-lab" is looked up as "laforge-builder-my-lab"
+// inside of BUILDER_PLUGIN_DIR.
+const PluginPrefix = "laforge-builder-"
+
+const pluginHandshakeTimeout = 30 * time.Second
+
+type pluginProcess struct {
+	Path   string
+	Info   *plugin.InfoResponse
+	Cmd    *exec.Cmd
+	Conn   *grpc.ClientConn
+	Client *plugin.Client
+	Exited chan struct{}
+}
+
+var (
+	pluginsMu sync.Mutex
+	plugins   = make(map[string]*pluginProcess)
+)
+
+// PluginBuilder forwards builder calls to an out-of-process builder over gRPC
+type PluginBuilder struct {
+	Process *pluginProcess
+	Logger  *logging.Logger
+}
+
+func pluginDir() string {
+	dir, ok := os.LookupEnv("BUILDER_PLUGIN_DIR")
+	if !ok {
+		dir = "builders"
+	}
+	return dir
+}
+
+// findPlugin looks for an executable matching the builder id in the plugin directory
+func findPlugin(id string) (string, bool) {
+	if id == "" || strings.ContainsAny(id, `/\`) {
+		return "", false
+	}
+	path, err := filepath.Abs(filepath.Join(pluginDir(), PluginPrefix+id))
+	if err != nil {
+		return "", false
+	}
+	info, err := os.Stat(path)
+	if err != nil || info.IsDir() || info.Mode()&0111 == 0 {
+		return "", false
+	}
+	return path, true
+}
+
+// NewPluginBuilder launches (or reuses) the plugin executable for the environment's builder
+func NewPluginBuilder(path string, environment *ent.Environment, logger *logging.Logger) (*PluginBuilder, error) {
+	pluginsMu.Lock()
+	defer pluginsMu.Unlock()
+
+	process, exists := plugins[path]
+	if exists {
+		select {
+		case <-process.Exited:
+			// The plugin died since we last used it, start a fresh one
+			process.Conn.Close()
+			delete(plugins, path)
+			exists = false
+		default:
+		}
+	}
+	if !exists {
+		var err error
+		process, err = startPlugin(path)
+		if err != nil {
+			return nil, err
+		}
+		plugins[path] = process
+	}
+	if process.Info.ID != environment.Builder {
+		return nil, fmt.Errorf("plugin %s reports builder id \"%s\" but the environment requires \"%s\"", path, process.Info.ID, environment.Builder)
+	}
+	return &PluginBuilder{
+		Process: process,
+		Logger:  logger,
+	}, nil
+}
+
+func startPlugin(path string) (*pluginProcess, error) {
+	cmd := exec.Command(path)
+	cmd.Env = append(os.Environ(), plugin.MagicCookieKey+"="+plugin.MagicCookieValue)
+	pluginLog := logrus.WithField("plugin", filepath.Base(path))
+	cmd.Stderr = pluginLog.WriterLevel(logrus.InfoLevel)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("error creating stdout pipe for plugin: %v", err)
+	}
+	err = cmd.Start()
+	if err != nil {
+		return nil, fmt.Errorf("error starting plugin %s: %v", path, err)
+	}
+	exited := make(chan struct{})
+	go func() {
+		err := cmd.Wait()
+		pluginLog.Warnf("builder plugin exited: %v", err)
+		close(exited)
+	}()
+
+	handshake := make(chan string, 1)
+	go func() {
+		scanner := bufio.NewScanner(stdout)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if strings.HasPrefix(line, plugin.HandshakePrefix+"|") {
+				handshake <- line
+				break
+			}
+			pluginLog.Info(line)
+		}
+		// Keep draining stdout so the plugin never blocks on writes
+		for scanner.Scan() {
+			pluginLog.Info(scanner.Text())
+		}
+	}()
+
+	var line string
+	select {
+	case line = <-handshake:
+	case <-exited:
+		return nil, fmt.Errorf("plugin %s exited before completing the handshake", path)
+	case <-time.After(pluginHandshakeTimeout):
+		cmd.Process.Kill()
+		return nil, fmt.Errorf("timed out waiting for plugin %s handshake", path)
+	}
+
+	parts := strings.Split(line, "|")
+	if len(parts) != 4 {
+		cmd.Process.Kill()
+		return nil, fmt.Errorf("invalid handshake from plugin %s: %s", path, line)
+	}
+	if parts[1] != plugin.ProtocolVersion {
+		cmd.Process.Kill()
+		return nil, fmt.Errorf("plugin %s speaks protocol version %s, expected %s", path, parts[1], plugin.ProtocolVersion)
+	}
+	if parts[2] != "tcp" {
+		cmd.Process.Kill()
+		return nil, fmt.Errorf("plugin %s uses unsupported network \"%s\"", path, parts[2])
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), pluginHandshakeTimeout)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, parts[3], grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		cmd.Process.Kill()
+		return nil, fmt.Errorf("error connecting to plugin %s: %v", path, err)
+	}
+	client := plugin.NewClient(conn)
+	info, err := client.Info(ctx)
+	if err != nil {
+		conn.Close()
+		cmd.Process.Kill()
+		return nil, fmt.Errorf("error getting info from plugin %s: %v", path, err)
+	}
+	pluginLog.WithField("builder", info.ID).Infof("started builder plugin %s v%s", info.Name, info.Version)
+
+	return &pluginProcess{
+		Path:   path,
+		Info:   info,
+		Cmd:    cmd,
+		Conn:   conn,
+		Client: client,
+		Exited: exited,
+	}, nil
+}
+
+func (builder PluginBuilder) ID() string {
+	return builder.Process.Info.ID
+}
+
+func (builder PluginBuilder) Name() string {
+	return builder.Process.Info.Name
+}
+
+func (builder PluginBuilder) Description() string {
+	return builder.Process.Info.Description
+}
+
+func (builder PluginBuilder) Author() string {
+	return builder.Process.Info.Author
+}
+
+func (builder PluginBuilder) Version() string {
+	return builder.Process.Info.Version
+}
+
+func (builder PluginBuilder) networkRequest(ctx context.Context, provisionedNetwork *ent.ProvisionedNetwork) (*plugin.NetworkRequest, error) {
+	entBuild, err := provisionedNetwork.QueryProvisionedNetworkToBuild().Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't query build from provisioned network \"%s\": %v", provisionedNetwork.Name, err)
+	}
+	entEnvironment, err := entBuild.QueryBuildToEnvironment().Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't query environment from build: %v", err)
+	}
+	entCompetition, err := entBuild.QueryBuildToCompetition().Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't query competition from build: %v", err)
+	}
+	entTeam, err := provisionedNetwork.QueryProvisionedNetworkToTeam().Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't query team from provisioned network \"%s\": %v", provisionedNetwork.Name, err)
+	}
+	entNetwork, err := provisionedNetwork.QueryProvisionedNetworkToNetwork().Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't query network from provisioned network \"%s\": %v", provisionedNetwork.Name, err)
+	}
+	return &plugin.NetworkRequest{
+		Environment:        entEnvironment,
+		Competition:        entCompetition,
+		Build:              entBuild,
+		Team:               entTeam,
+		Network:            entNetwork,
+		ProvisionedNetwork: provisionedNetwork,
+	}, nil
+}
+
+func (builder PluginBuilder) hostRequest(ctx context.Context, provisionedHost *ent.ProvisionedHost) (*plugin.HostRequest, error) {
+	entProNetwork, err := provisionedHost.QueryProvisionedHostToProvisionedNetwork().Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't query provisioned network from provisioned host \"%s\": %v", provisionedHost.SubnetIP, err)
+	}
+	networkRequest, err := builder.networkRequest(ctx, entProNetwork)
+	if err != nil {
+		return nil, err
+	}
+	entHost, err := provisionedHost.QueryProvisionedHostToHost().Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't query host from provisioned host \"%s\": %v", provisionedHost.SubnetIP, err)
+	}
+	entDisk, err := entHost.QueryHostToDisk().Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("couldn't query disk from host \"%s\": %v", entHost.Hostname, err)
+	}
+	return &plugin.HostRequest{
+		Environment:        networkRequest.Environment,
+		Competition:        networkRequest.Competition,
+		Build:              networkRequest.Build,
+		Team:               networkRequest.Team,
+		Network:            networkRequest.Network,
+		ProvisionedNetwork: entProNetwork,
+		Host:               entHost,
+		Disk:               entDisk,
+		ProvisionedHost:    provisionedHost,
+	}, nil
+}
+
+// saveTeamVars persists any vars the plugin handed back to the team
+func (builder PluginBuilder) saveTeamVars(ctx context.Context, team *ent.Team, response *plugin.Response) error {
+	if response == nil || len(response.TeamVars) == 0 {
+		return nil
+	}
+	if team.Vars == nil {
+		team.Vars = map[string]string{}
+	}
+	for key, value := range response.TeamVars {
+		// An empty value lets plugins remove vars on teardown
+		if value == "" {
+			delete(team.Vars, key)
+			continue
+		}
+		team.Vars[key] = value
+	}
+	err := team.Update().SetVars(team.Vars).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("couldn't update team vars: %v", err)
+	}
+	return nil
+}
+
+func (builder PluginBuilder) DeployHost(ctx context.Context, provisionedHost *ent.ProvisionedHost) (err error) {
+	request, err := builder.hostRequest(ctx, provisionedHost)
+	if err != nil {
+		return
+	}
+	response, err := builder.Process.Client.DeployHost(ctx, request)
+	if err != nil {
+		return fmt.Errorf("plugin %s failed to deploy host: %v", builder.ID(), err)
+	}
+	return builder.saveTeamVars(ctx, request.Team, response)
+}
+
+func (builder PluginBuilder) DeployNetwork(ctx context.Context, provisionedNetwork *ent.ProvisionedNetwork) (err error) {
+	request, err := builder.networkRequest(ctx, provisionedNetwork)
+	if err != nil {
+		return
+	}
+	response, err := builder.Process.Client.DeployNetwork(ctx, request)
+	if err != nil {
+		return fmt.Errorf("plugin %s failed to deploy network: %v", builder.ID(), err)
+	}
+	return builder.saveTeamVars(ctx, request.Team, response)
+}
+
+func (builder PluginBuilder) TeardownHost(ctx context.Context, provisionedHost *ent.ProvisionedHost) (err error) {
+	request, err := builder.hostRequest(ctx, provisionedHost)
+	if err != nil {
+		return
+	}
+	response, err := builder.Process.Client.TeardownHost(ctx, request)
+	if err != nil {
+		return fmt.Errorf("plugin %s failed to teardown host: %v", builder.ID(), err)
+	}
+	return builder.saveTeamVars(ctx, request.Team, response)
+}
+
+func (builder PluginBuilder) TeardownNetwork(ctx context.Context, provisionedNetwork *ent.ProvisionedNetwork) (err error) {
+	request, err := builder.networkRequest(ctx, provisionedNetwork)
+	if err != nil {
+		return
+	}
+	response, err := builder.Process.Client.TeardownNetwork(ctx, request)
+	if err != nil {
+		return fmt.Errorf("plugin %s failed to teardown network: %v", builder.ID(), err)
+	}
+	return builder.saveTeamVars(ctx, request.Team, response)
+}
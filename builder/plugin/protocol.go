@@ -0,0 +1,207 @@
+// Package plugin implements the protocol used by out-of-process builders.
+//
+// An out-of-process builder is a separate executable that the LaForge server
+// launches on demand. The executable serves a small gRPC service on a local
+// port and announces the address on stdout. Because plugins don't have
+// access to the LaForge database, every request carries a snapshot of the
+// ent objects the builder needs to deploy or teardown the resource.
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/gen0cide/laforge/ent"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+)
+
+const (
+	// ProtocolVersion is bumped whenever the messages below change in an
+	// incompatible way
+	ProtocolVersion = "1"
+	// MagicCookieKey and MagicCookieValue are set in the plugin environment
+	// so plugins can tell they were launched by the LaForge server
+	MagicCookieKey   = "LAFORGE_BUILDER_PLUGIN"
+	MagicCookieValue = "8e5f6a3b-laforge-builder"
+	// HandshakePrefix starts the line the plugin prints to stdout once its
+	// gRPC server is listening: laforge-builder|<version>|<network>|<address>
+	HandshakePrefix = "laforge-builder"
+	// CodecName is the gRPC content-subtype used by the plugin protocol
+	CodecName = "laforge-json"
+
+	serviceName = "laforge.builder.Builder"
+)
+
+// InfoResponse contains the metadata of the builder served by the plugin
+type InfoResponse struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Author      string `json:"author"`
+	Version     string `json:"version"`
+}
+
+// HostRequest is sent to the plugin to deploy or teardown a host
+type HostRequest struct {
+	Environment        *ent.Environment        `json:"environment"`
+	Competition        *ent.Competition        `json:"competition"`
+	Build              *ent.Build              `json:"build"`
+	Team               *ent.Team               `json:"team"`
+	Network            *ent.Network            `json:"network"`
+	ProvisionedNetwork *ent.ProvisionedNetwork `json:"provisioned_network"`
+	Host               *ent.Host               `json:"host"`
+	Disk               *ent.Disk               `json:"disk,omitempty"`
+	ProvisionedHost    *ent.ProvisionedHost    `json:"provisioned_host"`
+}
+
+// NetworkRequest is sent to the plugin to deploy or teardown a network
+type NetworkRequest struct {
+	Environment        *ent.Environment        `json:"environment"`
+	Competition        *ent.Competition        `json:"competition"`
+	Build              *ent.Build              `json:"build"`
+	Team               *ent.Team               `json:"team"`
+	Network            *ent.Network            `json:"network"`
+	ProvisionedNetwork *ent.ProvisionedNetwork `json:"provisioned_network"`
+}
+
+// Response is returned by the plugin for every deploy/teardown call
+type Response struct {
+	// TeamVars are merged into the team's vars by the server (an empty value
+	// removes the var). Plugins can't write to the database, so this is how
+	// they persist values needed later on (ex. gateway IPs for teardown).
+	TeamVars map[string]string `json:"team_vars,omitempty"`
+}
+
+// Empty is used for calls without any arguments
+type Empty struct{}
+
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+func (jsonCodec) Name() string {
+	return CodecName
+}
+
+func init() {
+	encoding.RegisterCodec(jsonCodec{})
+}
+
+// CallOptions returns the call options clients must use to talk to a plugin
+func CallOptions() []grpc.CallOption {
+	return []grpc.CallOption{grpc.CallContentSubtype(CodecName)}
+}
+
+// builderServer is the server side of the plugin service
+type builderServer interface {
+	Info(context.Context, *Empty) (*InfoResponse, error)
+	DeployHost(context.Context, *HostRequest) (*Response, error)
+	DeployNetwork(context.Context, *NetworkRequest) (*Response, error)
+	TeardownHost(context.Context, *HostRequest) (*Response, error)
+	TeardownNetwork(context.Context, *NetworkRequest) (*Response, error)
+}
+
+func unaryHandler(method string, newRequest func() interface{}, call func(srv builderServer, ctx context.Context, req interface{}) (interface{}, error)) grpc.MethodDesc {
+	return grpc.MethodDesc{
+		MethodName: method,
+		Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+			in := newRequest()
+			if err := dec(in); err != nil {
+				return nil, err
+			}
+			if interceptor == nil {
+				return call(srv.(builderServer), ctx, in)
+			}
+			info := &grpc.UnaryServerInfo{
+				Server:     srv,
+				FullMethod: "/" + serviceName + "/" + method,
+			}
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return call(srv.(builderServer), ctx, req)
+			}
+			return interceptor(ctx, in, info, handler)
+		},
+	}
+}
+
+// serviceDesc is written by hand since the plugin protocol uses JSON
+// messages instead of protobuf generated types
+var serviceDesc = grpc.ServiceDesc{
+	ServiceName: serviceName,
+	HandlerType: (*builderServer)(nil),
+	Methods: []grpc.MethodDesc{
+		unaryHandler("Info", func() interface{} { return new(Empty) }, func(srv builderServer, ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Info(ctx, req.(*Empty))
+		}),
+		unaryHandler("DeployHost", func() interface{} { return new(HostRequest) }, func(srv builderServer, ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeployHost(ctx, req.(*HostRequest))
+		}),
+		unaryHandler("DeployNetwork", func() interface{} { return new(NetworkRequest) }, func(srv builderServer, ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeployNetwork(ctx, req.(*NetworkRequest))
+		}),
+		unaryHandler("TeardownHost", func() interface{} { return new(HostRequest) }, func(srv builderServer, ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.TeardownHost(ctx, req.(*HostRequest))
+		}),
+		unaryHandler("TeardownNetwork", func() interface{} { return new(NetworkRequest) }, func(srv builderServer, ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.TeardownNetwork(ctx, req.(*NetworkRequest))
+		}),
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "builder/plugin/protocol.go",
+}
+
+// Client is the client side of the plugin service
+type Client struct {
+	conn *grpc.ClientConn
+}
+
+// NewClient wraps an established connection to a plugin
+func NewClient(conn *grpc.ClientConn) *Client {
+	return &Client{conn: conn}
+}
+
+func (c *Client) invoke(ctx context.Context, method string, in, out interface{}) error {
+	return c.conn.Invoke(ctx, "/"+serviceName+"/"+method, in, out, CallOptions()...)
+}
+
+// Info returns the metadata of the plugin builder
+func (c *Client) Info(ctx context.Context) (*InfoResponse, error) {
+	out := new(InfoResponse)
+	err := c.invoke(ctx, "Info", &Empty{}, out)
+	return out, err
+}
+
+// DeployHost asks the plugin to deploy a host
+func (c *Client) DeployHost(ctx context.Context, in *HostRequest) (*Response, error) {
+	out := new(Response)
+	err := c.invoke(ctx, "DeployHost", in, out)
+	return out, err
+}
+
+// DeployNetwork asks the plugin to deploy a network
+func (c *Client) DeployNetwork(ctx context.Context, in *NetworkRequest) (*Response, error) {
+	out := new(Response)
+	err := c.invoke(ctx, "DeployNetwork", in, out)
+	return out, err
+}
+
+// TeardownHost asks the plugin to teardown a host
+func (c *Client) TeardownHost(ctx context.Context, in *HostRequest) (*Response, error) {
+	out := new(Response)
+	err := c.invoke(ctx, "TeardownHost", in, out)
+	return out, err
+}
+
+// TeardownNetwork asks the plugin to teardown a network
+func (c *Client) TeardownNetwork(ctx context.Context, in *NetworkRequest) (*Response, error) {
+	out := new(Response)
+	err := c.invoke(ctx, "TeardownNetwork", in, out)
+	return out, err
+}
@@ -0,0 +1,86 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+
+	"google.golang.org/grpc"
+)
+
+// Builder is implemented by out-of-process builders. It mirrors the
+// builder.Builder interface except that resources are passed as snapshots
+// since the plugin can't query the LaForge database.
+type Builder interface {
+	ID() string
+	Name() string
+	Description() string
+	Author() string
+	Version() string
+	DeployHost(ctx context.Context, request *HostRequest) (*Response, error)
+	DeployNetwork(ctx context.Context, request *NetworkRequest) (*Response, error)
+	TeardownHost(ctx context.Context, request *HostRequest) (*Response, error)
+	TeardownNetwork(ctx context.Context, request *NetworkRequest) (*Response, error)
+}
+
+type server struct {
+	impl Builder
+}
+
+func (s server) Info(ctx context.Context, _ *Empty) (*InfoResponse, error) {
+	return &InfoResponse{
+		ID:          s.impl.ID(),
+		Name:        s.impl.Name(),
+		Description: s.impl.Description(),
+		Author:      s.impl.Author(),
+		Version:     s.impl.Version(),
+	}, nil
+}
+
+func (s server) DeployHost(ctx context.Context, request *HostRequest) (*Response, error) {
+	return orEmpty(s.impl.DeployHost(ctx, request))
+}
+
+func (s server) DeployNetwork(ctx context.Context, request *NetworkRequest) (*Response, error) {
+	return orEmpty(s.impl.DeployNetwork(ctx, request))
+}
+
+func (s server) TeardownHost(ctx context.Context, request *HostRequest) (*Response, error) {
+	return orEmpty(s.impl.TeardownHost(ctx, request))
+}
+
+func (s server) TeardownNetwork(ctx context.Context, request *NetworkRequest) (*Response, error) {
+	return orEmpty(s.impl.TeardownNetwork(ctx, request))
+}
+
+func orEmpty(response *Response, err error) (*Response, error) {
+	if err != nil {
+		return nil, err
+	}
+	if response == nil {
+		response = &Response{}
+	}
+	return response, nil
+}
+
+// Serve runs the plugin gRPC server for the given builder. It is meant to be
+// called from the plugin's main function and blocks until the server stops.
+// Anything the plugin writes to stderr ends up in the LaForge server logs.
+func Serve(impl Builder) error {
+	if os.Getenv(MagicCookieKey) != MagicCookieValue {
+		return fmt.Errorf("this binary is a LaForge builder plugin and must be launched by the LaForge server")
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return fmt.Errorf("error starting plugin listener: %v", err)
+	}
+	grpcServer := grpc.NewServer()
+	grpcServer.RegisterService(&serviceDesc, server{impl: impl})
+
+	// Let the server know where to find us
+	fmt.Fprintf(os.Stdout, "%s|%s|%s|%s\n", HandshakePrefix, ProtocolVersion, listener.Addr().Network(), listener.Addr().String())
+	os.Stdout.Sync()
+
+	return grpcServer.Serve(listener)
+}
@@ -0,0 +1,101 @@
+package builder
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/gen0cide/laforge/ent"
+	"github.com/gen0cide/laforge/logging"
+)
+
+// Factory creates a configured Builder for the given environment
+type Factory func(environment *ent.Environment, logger *logging.Logger) (Builder, error)
+
+// Info describes a registered builder
+type Info struct {
+	ID          string
+	Name        string
+	Description string
+	Author      string
+	Version     string
+	// Config lists the keys the builder reads from the environment config
+	Config []ConfigField
+}
+
+type registration struct {
+	info    Info
+	config  interface{}
+	factory Factory
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]registration)
+)
+
+// Register makes a builder available to environments by its ID. The
+// prototype is only used for its metadata (ID, Name, etc.), config is a
+// pointer to the builder's config struct (see ParseConfig) used to validate
+// environments at load time, and the factory is called every time an
+// environment needs a configured builder. Register panics if the ID is
+// empty or has already been registered.
+func Register(prototype Builder, config interface{}, factory Factory) {
+	if factory == nil {
+		panic("builder: Register factory is nil")
+	}
+	if config != nil && (reflect.TypeOf(config).Kind() != reflect.Ptr || reflect.TypeOf(config).Elem().Kind() != reflect.Struct) {
+		panic("builder: Register config must be a pointer to a struct")
+	}
+	info := infoFromBuilder(prototype)
+	if config != nil {
+		info.Config = ConfigSchema(config)
+	}
+	if info.ID == "" {
+		panic("builder: Register called with an empty builder ID")
+	}
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, dup := registry[info.ID]; dup {
+		panic("builder: Register called twice for builder " + info.ID)
+	}
+	registry[info.ID] = registration{
+		info:    info,
+		config:  config,
+		factory: factory,
+	}
+}
+
+// Registered returns the metadata of all in-process builders sorted by ID
+func Registered() []Info {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	infos := make([]Info, 0, len(registry))
+	for _, reg := range registry {
+		infos = append(infos, reg.info)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].ID < infos[j].ID
+	})
+	return infos
+}
+
+func lookupFactory(id string) (Factory, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	reg, exists := registry[id]
+	if !exists {
+		return nil, false
+	}
+	return reg.factory, true
+}
+
+func infoFromBuilder(b Builder) Info {
+	return Info{
+		ID:          b.ID(),
+		Name:        b.Name(),
+		Description: b.Description(),
+		Author:      b.Author(),
+		Version:     b.Version(),
+	}
+}
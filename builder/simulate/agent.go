@@ -0,0 +1,188 @@
+package simulate
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gen0cide/laforge/ent"
+	"github.com/gen0cide/laforge/ent/agenttask"
+	"github.com/gen0cide/laforge/ent/provisioningstep"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// agentPollInterval is how often the simulated agent asks for new tasks,
+// same as the real agent's task loop
+const agentPollInterval = time.Second
+
+var (
+	agentsMu sync.Mutex
+	agents   = make(map[uuid.UUID]context.CancelFunc)
+)
+
+// startAgent runs a simulated agent for the provisioned host until the host is torn down
+func (builder SimulateBuilder) startAgent(provisionedHost *ent.ProvisionedHost) {
+	agentsMu.Lock()
+	defer agentsMu.Unlock()
+	if _, running := agents[provisionedHost.ID]; running {
+		return
+	}
+	// The agent outlives the deploy call, so it gets its own context
+	ctx, cancel := context.WithCancel(context.Background())
+	agents[provisionedHost.ID] = cancel
+	go builder.runAgent(ctx, provisionedHost)
+}
+
+func stopAgent(provisionedHost *ent.ProvisionedHost) {
+	agentsMu.Lock()
+	defer agentsMu.Unlock()
+	if cancel, running := agents[provisionedHost.ID]; running {
+		cancel()
+		delete(agents, provisionedHost.ID)
+	}
+}
+
+// runAgent completes the provisioned host's agent tasks in order, like the real agent would
+func (builder SimulateBuilder) runAgent(ctx context.Context, provisionedHost *ent.ProvisionedHost) {
+	logEntry := builder.Logger.Log.WithFields(logrus.Fields{
+		"subnetIp":          provisionedHost.SubnetIP,
+		"provisionedHostId": provisionedHost.ID,
+	})
+	logEntry.Debug("Simulate | agent started")
+
+	ticker := time.NewTicker(agentPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			logEntry.Debug("Simulate | agent stopped")
+			return
+		case <-ticker.C:
+		}
+
+		entAgentTask, err := provisionedHost.QueryProvisionedHostToAgentTask().Order(ent.Asc(agenttask.FieldNumber)).Where(
+			agenttask.Or(
+				agenttask.StateEQ(agenttask.StateAWAITING),
+				agenttask.StateEQ(agenttask.StateINPROGRESS),
+			)).First(ctx)
+		if err != nil {
+			if !ent.IsNotFound(err) {
+				logEntry.Errorf("Simulate | agent failed to query tasks: %v", err)
+			}
+			continue
+		}
+
+		err = builder.runTask(ctx, entAgentTask)
+		if err != nil {
+			logEntry.Errorf("Simulate | agent failed to run task %s: %v", entAgentTask.ID, err)
+		}
+	}
+}
+
+func (builder SimulateBuilder) runTask(ctx context.Context, entAgentTask *ent.AgentTask) error {
+	err := entAgentTask.Update().SetState(agenttask.StateINPROGRESS).Exec(ctx)
+	if err != nil {
+		return err
+	}
+	builder.publish(ctx, entAgentTask)
+
+	delay := builder.AgentDelay
+	// Like the real agent, an EXECUTE task running past its timeout is killed and fails
+	timeout := time.Duration(entAgentTask.Timeout) * time.Second
+	timedOut := entAgentTask.Timeout > 0 && delay > timeout
+	if timedOut {
+		delay = timeout
+	}
+	err = builder.sleep(ctx, delay)
+	if err != nil {
+		return err
+	}
+
+	failed := timedOut || builder.roll(builder.AgentFailureRate)
+	reason := "simulated random failure"
+	if timedOut {
+		reason = fmt.Sprintf("timed out after %v", timeout)
+	}
+	if !failed && len(builder.FailSteps) > 0 {
+		stepHclID, err := agentTaskStepHclID(ctx, entAgentTask)
+		if err != nil {
+			return err
+		}
+		if builder.FailSteps[stepHclID] {
+			failed = true
+			reason = fmt.Sprintf("simulated targeted failure for %s", stepHclID)
+		}
+	}
+
+	update := entAgentTask.Update()
+	if failed {
+		update = update.SetState(agenttask.StateFAILED).SetErrorMessage(reason)
+	} else {
+		update = update.SetState(agenttask.StateCOMPLETE).SetOutput(fmt.Sprintf("simulated %s %s", entAgentTask.Command, entAgentTask.Args))
+	}
+	err = update.Exec(ctx)
+	if err != nil {
+		return err
+	}
+	builder.publish(ctx, entAgentTask)
+	return nil
+}
+
+func (builder SimulateBuilder) publish(ctx context.Context, entAgentTask *ent.AgentTask) {
+	if builder.RDB != nil {
+		builder.RDB.Publish(ctx, "updatedAgentTask", entAgentTask.ID.String())
+	}
+}
+
+// agentTaskStepHclID returns the HCL ID of the script/command/file the agent task was created for
+func agentTaskStepHclID(ctx context.Context, entAgentTask *ent.AgentTask) (string, error) {
+	entStep, err := entAgentTask.QueryAgentTaskToProvisioningStep().Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			// Ad-hoc tasks don't belong to a step
+			return "", nil
+		}
+		return "", err
+	}
+	switch entStep.Type {
+	case provisioningstep.TypeScript:
+		entScript, err := entStep.QueryProvisioningStepToScript().Only(ctx)
+		if err != nil {
+			return "", err
+		}
+		return entScript.HclID, nil
+	case provisioningstep.TypeCommand:
+		entCommand, err := entStep.QueryProvisioningStepToCommand().Only(ctx)
+		if err != nil {
+			return "", err
+		}
+		return entCommand.HclID, nil
+	case provisioningstep.TypeFileDownload:
+		entFileDownload, err := entStep.QueryProvisioningStepToFileDownload().Only(ctx)
+		if err != nil {
+			return "", err
+		}
+		return entFileDownload.HclID, nil
+	case provisioningstep.TypeFileExtract:
+		entFileExtract, err := entStep.QueryProvisioningStepToFileExtract().Only(ctx)
+		if err != nil {
+			return "", err
+		}
+		return entFileExtract.HclID, nil
+	case provisioningstep.TypeFileDelete:
+		entFileDelete, err := entStep.QueryProvisioningStepToFileDelete().Only(ctx)
+		if err != nil {
+			return "", err
+		}
+		return entFileDelete.HclID, nil
+	case provisioningstep.TypeDNSRecord:
+		entDNSRecord, err := entStep.QueryProvisioningStepToDNSRecord().Only(ctx)
+		if err != nil {
+			return "", err
+		}
+		return entDNSRecord.HclID, nil
+	}
+	return "", nil
+}
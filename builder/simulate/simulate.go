@@ -0,0 +1,245 @@
+// Package simulate provides a builder that fakes deployments so the planner can be exercised without real infrastructure
+package simulate
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/gen0cide/laforge/ent"
+	"github.com/gen0cide/laforge/logging"
+	"github.com/go-redis/redis/v8"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	ID          = "simulate"
+	Name        = "Simulate"
+	Description = "Builder that simulates deployments with configurable latency and failures"
+	Author      = "LaForge Contributors"
+	Version     = "0.1"
+)
+
+// Config is the environment configuration read by the simulate builder
+type Config struct {
+	DeployDelay        time.Duration `config:"simulate_deploy_delay" default:"5s" min:"0s" description:"How long a host/network deploy takes"`
+	TeardownDelay      time.Duration `config:"simulate_teardown_delay" default:"2s" min:"0s" description:"How long a host/network teardown takes"`
+	Jitter             float64       `config:"simulate_jitter" default:"0" min:"0" max:"1" description:"Fraction delays are randomly stretched or shrunk by"`
+	HostFailureRate    float64       `config:"simulate_host_failure_rate" default:"0" min:"0" max:"1" description:"Chance a host deploy fails"`
+	NetworkFailureRate float64       `config:"simulate_network_failure_rate" default:"0" min:"0" max:"1" description:"Chance a network deploy fails"`
+	FailHosts          []string      `config:"simulate_fail_hosts" description:"Host HCL IDs that always fail to deploy"`
+	FailNetworks       []string      `config:"simulate_fail_networks" description:"Network HCL IDs that always fail to deploy"`
+	FailTeams          []int         `config:"simulate_fail_teams" description:"Team numbers whose hosts and networks always fail to deploy"`
+	Agent              bool          `config:"simulate_agent" default:"true" description:"Run the simulated agent on deployed hosts"`
+	AgentDelay         time.Duration `config:"simulate_agent_delay" default:"1s" min:"0s" description:"How long the simulated agent takes per agent task"`
+	AgentFailureRate   float64       `config:"simulate_agent_failure_rate" default:"0" min:"0" max:"1" description:"Chance an agent task fails"`
+	FailSteps          []string      `config:"simulate_fail_steps" description:"Script/command/file HCL IDs whose agent tasks always fail"`
+	Seed               int64         `config:"simulate_seed" description:"Seed for the random failures and jitter, to replay a run"`
+	MissingHosts       []string      `config:"simulate_missing_hosts" description:"Host HCL IDs reported as deleted when refreshing"`
+	PoweredOffHosts    []string      `config:"simulate_powered_off_hosts" description:"Host HCL IDs reported as powered off when refreshing"`
+	MissingNetworks    []string      `config:"simulate_missing_networks" description:"Network HCL IDs reported as deleted when refreshing"`
+}
+
+type SimulateBuilder struct {
+	// DeployDelay is how long a host/network deploy takes
+	DeployDelay time.Duration
+	// TeardownDelay is how long a host/network teardown takes
+	TeardownDelay time.Duration
+	// Jitter is the fraction (0-1) delays are randomly stretched or shrunk by
+	Jitter float64
+	// HostFailureRate is the chance (0-1) a host deploy fails
+	HostFailureRate float64
+	// NetworkFailureRate is the chance (0-1) a network deploy fails
+	NetworkFailureRate float64
+	// FailHosts are host HCL IDs that always fail to deploy
+	FailHosts map[string]bool
+	// FailNetworks are network HCL IDs that always fail to deploy
+	FailNetworks map[string]bool
+	// FailTeams are team numbers whose hosts and networks always fail to deploy
+	FailTeams map[int]bool
+	// Agent enables the simulated agent on deployed hosts
+	Agent bool
+	// AgentDelay is how long the simulated agent takes to run a task
+	AgentDelay time.Duration
+	// AgentFailureRate is the chance (0-1) an agent task fails
+	AgentFailureRate float64
+	// FailSteps are script/command/file HCL IDs whose agent tasks always fail
+	FailSteps map[string]bool
+	// MissingHosts are host HCL IDs reported as deleted when refreshing
+	MissingHosts map[string]bool
+	// PoweredOffHosts are host HCL IDs reported as powered off when refreshing
+	PoweredOffHosts map[string]bool
+	// MissingNetworks are network HCL IDs reported as deleted when refreshing
+	MissingNetworks map[string]bool
+	// Rand is the source used to roll failures and jitter
+	Rand   *LockedRand
+	RDB    *redis.Client
+	Logger *logging.Logger
+}
+
+// LockedRand is a rand.Rand that is safe to use from multiple build routines
+type LockedRand struct {
+	mu   sync.Mutex
+	rand *rand.Rand
+}
+
+// NewLockedRand creates a random source from the given seed
+func NewLockedRand(seed int64) *LockedRand {
+	return &LockedRand{rand: rand.New(rand.NewSource(seed))}
+}
+
+// Float64 returns a number in [0.0,1.0)
+func (r *LockedRand) Float64() float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.rand.Float64()
+}
+
+func (builder SimulateBuilder) ID() string {
+	return ID
+}
+
+func (builder SimulateBuilder) Name() string {
+	return Name
+}
+
+func (builder SimulateBuilder) Description() string {
+	return Description
+}
+
+func (builder SimulateBuilder) Author() string {
+	return Author
+}
+
+func (builder SimulateBuilder) Version() string {
+	return Version
+}
+
+// roll returns true with the given probability
+func (builder SimulateBuilder) roll(rate float64) bool {
+	if rate <= 0 {
+		return false
+	}
+	return builder.Rand.Float64() < rate
+}
+
+// sleep waits for the jittered delay or until the context is cancelled
+func (builder SimulateBuilder) sleep(ctx context.Context, delay time.Duration) error {
+	if builder.Jitter > 0 {
+		delay = time.Duration(float64(delay) * (1 + builder.Jitter*(2*builder.Rand.Float64()-1)))
+	}
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// DeployHost pretends to deploy a host and starts the simulated agent for it
+func (builder SimulateBuilder) DeployHost(ctx context.Context, provisionedHost *ent.ProvisionedHost) (err error) {
+	entHost, err := provisionedHost.QueryProvisionedHostToHost().Only(ctx)
+	if err != nil {
+		return fmt.Errorf("couldn't query host from provisioned host \"%s\": %v", provisionedHost.SubnetIP, err)
+	}
+	entTeam, err := provisionedHost.QueryProvisionedHostToProvisionedNetwork().QueryProvisionedNetworkToTeam().Only(ctx)
+	if err != nil {
+		return fmt.Errorf("couldn't query team from provisioned host \"%s\": %v", provisionedHost.SubnetIP, err)
+	}
+	logEntry := builder.Logger.Log.WithFields(logrus.Fields{
+		"host": entHost.HclID,
+		"team": entTeam.TeamNumber,
+	})
+	logEntry.Debug("Simulate | DeployHost")
+
+	err = builder.sleep(ctx, builder.DeployDelay)
+	if err != nil {
+		return
+	}
+	if builder.FailHosts[entHost.HclID] || builder.FailTeams[entTeam.TeamNumber] {
+		return fmt.Errorf("simulated targeted failure deploying host %s for team %d", entHost.HclID, entTeam.TeamNumber)
+	}
+	if builder.roll(builder.HostFailureRate) {
+		return fmt.Errorf("simulated random failure deploying host %s for team %d", entHost.HclID, entTeam.TeamNumber)
+	}
+	if builder.Agent {
+		builder.startAgent(provisionedHost)
+	}
+	logEntry.Info("Simulate | deployed host")
+	return nil
+}
+
+// DeployNetwork pretends to deploy a network
+func (builder SimulateBuilder) DeployNetwork(ctx context.Context, provisionedNetwork *ent.ProvisionedNetwork) (err error) {
+	entNetwork, err := provisionedNetwork.QueryProvisionedNetworkToNetwork().Only(ctx)
+	if err != nil {
+		return fmt.Errorf("couldn't query network from provisioned network \"%s\": %v", provisionedNetwork.Name, err)
+	}
+	entTeam, err := provisionedNetwork.QueryProvisionedNetworkToTeam().Only(ctx)
+	if err != nil {
+		return fmt.Errorf("couldn't query team from provisioned network \"%s\": %v", provisionedNetwork.Name, err)
+	}
+	logEntry := builder.Logger.Log.WithFields(logrus.Fields{
+		"network": entNetwork.HclID,
+		"team":    entTeam.TeamNumber,
+	})
+	logEntry.Debug("Simulate | DeployNetwork")
+
+	err = builder.sleep(ctx, builder.DeployDelay)
+	if err != nil {
+		return
+	}
+	if builder.FailNetworks[entNetwork.HclID] || builder.FailTeams[entTeam.TeamNumber] {
+		return fmt.Errorf("simulated targeted failure deploying network %s for team %d", entNetwork.HclID, entTeam.TeamNumber)
+	}
+	if builder.roll(builder.NetworkFailureRate) {
+		return fmt.Errorf("simulated random failure deploying network %s for team %d", entNetwork.HclID, entTeam.TeamNumber)
+	}
+	logEntry.Info("Simulate | deployed network")
+	return nil
+}
+
+// TeardownHost stops the simulated agent and pretends to destroy the host
+func (builder SimulateBuilder) TeardownHost(ctx context.Context, provisionedHost *ent.ProvisionedHost) (err error) {
+	builder.Logger.Log.WithFields(logrus.Fields{
+		"subnetIp": provisionedHost.SubnetIP,
+	}).Debug("Simulate | TeardownHost")
+	stopAgent(provisionedHost)
+	return builder.sleep(ctx, builder.TeardownDelay)
+}
+
+// TeardownNetwork pretends to destroy the network
+func (builder SimulateBuilder) TeardownNetwork(ctx context.Context, provisionedNetwork *ent.ProvisionedNetwork) (err error) {
+	builder.Logger.Log.WithFields(logrus.Fields{
+		"network": provisionedNetwork.Name,
+	}).Debug("Simulate | TeardownNetwork")
+	return builder.sleep(ctx, builder.TeardownDelay)
+}
+
+// RefreshHost reports the host as deployed and running unless it is listed in the simulated drift
+func (builder SimulateBuilder) RefreshHost(ctx context.Context, provisionedHost *ent.ProvisionedHost) (exists bool, poweredOn bool, err error) {
+	entHost, err := provisionedHost.QueryProvisionedHostToHost().Only(ctx)
+	if err != nil {
+		return false, false, fmt.Errorf("couldn't query host from provisioned host \"%s\": %v", provisionedHost.SubnetIP, err)
+	}
+	if builder.MissingHosts[entHost.HclID] {
+		return false, false, nil
+	}
+	return true, !builder.PoweredOffHosts[entHost.HclID], nil
+}
+
+// RefreshNetwork reports the network as deployed unless it is listed in the simulated drift
+func (builder SimulateBuilder) RefreshNetwork(ctx context.Context, provisionedNetwork *ent.ProvisionedNetwork) (exists bool, err error) {
+	entNetwork, err := provisionedNetwork.QueryProvisionedNetworkToNetwork().Only(ctx)
+	if err != nil {
+		return false, fmt.Errorf("couldn't query network from provisioned network \"%s\": %v", provisionedNetwork.Name, err)
+	}
+	return !builder.MissingNetworks[entNetwork.HclID], nil
+}
@@ -0,0 +1,101 @@
+package vspherensxt
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gen0cide/laforge/ent"
+)
+
+// InstanceSize is the hardware a host with a given instance_size is deployed with
+type InstanceSize struct {
+	Cpus     int32
+	MemoryMB int64
+}
+
+// ParseInstanceSizes parses an instance size table in the form "name=cpus/memory_mb,..."
+// (ex. "small=2/2048,large=4/8192")
+func ParseInstanceSizes(raw string) (map[string]InstanceSize, error) {
+	sizes := map[string]InstanceSize{}
+	for _, entry := range strings.Split(raw, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, fmt.Errorf("\"%s\" must be in the form name=cpus/memory_mb", entry)
+		}
+		name := strings.TrimSpace(parts[0])
+		hardware := strings.SplitN(parts[1], "/", 2)
+		if len(hardware) != 2 {
+			return nil, fmt.Errorf("\"%s\" must be in the form name=cpus/memory_mb", entry)
+		}
+		cpus, err := strconv.ParseInt(strings.TrimSpace(hardware[0]), 10, 32)
+		if err != nil || cpus < 1 {
+			return nil, fmt.Errorf("instance size \"%s\" has an invalid cpu count \"%s\"", name, hardware[0])
+		}
+		memory, err := strconv.ParseInt(strings.TrimSpace(hardware[1]), 10, 64)
+		if err != nil || memory < 1 {
+			return nil, fmt.Errorf("instance size \"%s\" has an invalid memory size \"%s\"", name, hardware[1])
+		}
+		if _, exists := sizes[name]; exists {
+			return nil, fmt.Errorf("instance size \"%s\" is defined more than once", name)
+		}
+		sizes[name] = InstanceSize{
+			Cpus:     int32(cpus),
+			MemoryMB: memory,
+		}
+	}
+	if len(sizes) == 0 {
+		return nil, fmt.Errorf("no instance sizes defined")
+	}
+	return sizes, nil
+}
+
+// Validate checks the parts of the config that can't be described with struct tags. Errors are
+// prefixed with the key they are about so they can be reported against it.
+func (config *Config) Validate() error {
+	_, err := ParseInstanceSizes(config.InstanceSizes)
+	if err != nil {
+		return fmt.Errorf("vsphere_instance_sizes: %v", err)
+	}
+	return nil
+}
+
+// resolveHardware returns the cpu count and memory (MB) for a host. Explicit cpus/memory_mb on
+// the host take precedence over its instance_size.
+func (builder VSphereNSXTBuilder) resolveHardware(host *ent.Host) (cpuCount int32, memorySize int64, err error) {
+	size, exists := builder.InstanceSizes[host.InstanceSize]
+	if exists {
+		cpuCount = size.Cpus
+		memorySize = size.MemoryMB
+	}
+	if host.Cpus > 0 {
+		cpuCount = int32(host.Cpus)
+	}
+	if host.MemoryMB > 0 {
+		memorySize = int64(host.MemoryMB)
+	}
+	if cpuCount == 0 || memorySize == 0 {
+		names := make([]string, 0, len(builder.InstanceSizes))
+		for name := range builder.InstanceSizes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		err = fmt.Errorf("couldn't resolve host instance size \"%s\" (available: %s) and cpus/memory_mb aren't both set on host %s", host.InstanceSize, strings.Join(names, ", "), host.HclID)
+	}
+	return
+}
+
+// resolveDisks returns the disk sizes (GB) for a host, the primary disk first. A primary size
+// of 0 keeps the size of the template's disk.
+func resolveDisks(host *ent.Host, disk *ent.Disk) []int {
+	diskSizes := []int{0}
+	if disk != nil {
+		diskSizes[0] = disk.Size
+	}
+	return append(diskSizes, host.AdditionalDisks...)
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/gen0cide/laforge/logging"
@@ -285,6 +286,32 @@ type NSXTGetIpPoolsResponse struct {
 	SortAscending bool         `json:"sort_ascending"`
 }
 
+// NSXTPolicyResource holds the fields shared by every object returned from a policy API list
+type NSXTPolicyResource struct {
+	ResourceType NSXTResourceType `json:"resource_type"`
+	Id           string           `json:"id"`
+	DisplayName  string           `json:"display_name"`
+	Path         string           `json:"path"`
+	ParentPath   string           `json:"parent_path"`
+}
+
+type NSXTListPolicyResourcesResponse struct {
+	Results     []NSXTPolicyResource `json:"results"`
+	ResultCount int                  `json:"result_count"`
+	Cursor      string               `json:"cursor"`
+}
+
+type NSXTListNATRulesResponse struct {
+	Results     []NSXTNATRule `json:"results"`
+	ResultCount int           `json:"result_count"`
+	Cursor      string        `json:"cursor"`
+}
+
+type NSXTListIpAllocationsResponse struct {
+	Results     []NSXTIpAllocationResult `json:"results"`
+	ResultCount int                      `json:"result_count"`
+}
+
 type NSXTIpPoolAction string
 
 const (
@@ -474,12 +501,18 @@ func (nsxt *NSXTClient) DeleteTier1(name string) (nsxtError *NSXTErrorResponse,
 	return
 }
 
-func (nsxt *NSXTClient) CreateSegment(name string, tier1path string, gatewayAddress string) (nsxtError *NSXTErrorResponse, err error) {
+func (nsxt *NSXTClient) CreateSegment(name string, tier1path string, gatewayAddresses ...string) (nsxtError *NSXTErrorResponse, err error) {
 	nsxt.Logger.Log.WithFields(log.Fields{
-		"name":           name,
-		"tier1path":      tier1path,
-		"gatewayAddress": gatewayAddress,
+		"name":             name,
+		"tier1path":        tier1path,
+		"gatewayAddresses": gatewayAddresses,
 	}).Debug("NSX-T | CreateSegment")
+	subnets := []NSXTSubnet{}
+	for _, gatewayAddress := range gatewayAddresses {
+		subnets = append(subnets, NSXTSubnet{
+			GatewayAddress: gatewayAddress,
+		})
+	}
 	payload := NSXTCreateSegmentPayload{
 		ResourceType: "Infra",
 		Children: []NSXTChildSegment{
@@ -489,11 +522,7 @@ func (nsxt *NSXTClient) CreateSegment(name string, tier1path string, gatewayAddr
 					ResourceType:     "Segment",
 					ID:               name,
 					ConnectivityPath: tier1path,
-					Subnets: []NSXTSubnet{
-						{
-							GatewayAddress: gatewayAddress,
-						},
-					},
+					Subnets:          subnets,
 				},
 			},
 		},
@@ -556,6 +585,28 @@ func (nsxt *NSXTClient) CheckExistsTier1(name string) (exists bool, nsxtError *N
 	return
 }
 
+func (nsxt *NSXTClient) CheckExistsSegment(name string) (exists bool, nsxtError *NSXTErrorResponse, err error) {
+	nsxt.Logger.Log.WithFields(log.Fields{
+		"name": name,
+	}).Debug("NSX-T | CheckExistsSegment")
+	request, err := nsxt.generateAuthorizedRequest(http.MethodGet, ("/policy/api/v1/infra/segments/" + name))
+	if err != nil {
+		return
+	}
+	response, nsxtError, err := nsxt.executeRequestWithRetry(request, http.StatusOK, http.StatusNotFound)
+	if err != nil {
+		return
+	}
+	if response.StatusCode == http.StatusOK {
+		exists = true
+	} else if response.StatusCode == http.StatusNotFound {
+		exists = false
+	} else if nsxtError != nil {
+		nsxt.Logger.Log.Errorf("error while checking if segment exists: %v", nsxtError)
+	}
+	return
+}
+
 func (nsxt *NSXTClient) GetTier0s() (tier0s []NSXTTier0, nsxtError *NSXTErrorResponse, err error) {
 	nsxt.Logger.Log.Debug("NSX-T | GetTier0s")
 	// Cache these results as they don't usually change
@@ -846,3 +897,132 @@ func (nsxt *NSXTClient) ManageIpAllocation(ip string, action NSXTIpPoolAction) (
 	}
 	return ipAllocation, nil, nil
 }
+
+// listPolicyResources follows the cursor of a policy API list endpoint until every page has been read
+func (nsxt *NSXTClient) listPolicyResources(path string) (resources []NSXTPolicyResource, nsxtError *NSXTErrorResponse, err error) {
+	resources = make([]NSXTPolicyResource, 0)
+	cursor := ""
+	for {
+		requestPath := path
+		if cursor != "" {
+			requestPath = path + "?cursor=" + url.QueryEscape(cursor)
+		}
+		request, err := nsxt.generateAuthorizedRequest(http.MethodGet, requestPath)
+		if err != nil {
+			return nil, nil, err
+		}
+		response, nsxtError, err := nsxt.executeRequestWithRetry(request, http.StatusOK)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error while listing %s: %v", path, err)
+		}
+		if nsxtError != nil {
+			nsxt.Logger.Log.Errorf("error while listing %s: %v", path, nsxtError)
+			return nil, nsxtError, nil
+		}
+		var listResponse NSXTListPolicyResourcesResponse
+		err = json.NewDecoder(response.Body).Decode(&listResponse)
+		response.Body.Close()
+		if err != nil {
+			return nil, nil, fmt.Errorf("error while decoding %s list response: %v", path, err)
+		}
+		resources = append(resources, listResponse.Results...)
+		if listResponse.Cursor == "" || len(listResponse.Results) == 0 {
+			return resources, nil, nil
+		}
+		cursor = listResponse.Cursor
+	}
+}
+
+// ListTier1s returns every Tier-1 gateway known to the policy API
+func (nsxt *NSXTClient) ListTier1s() (tier1s []NSXTPolicyResource, nsxtError *NSXTErrorResponse, err error) {
+	nsxt.Logger.Log.Debug("NSX-T | ListTier1s")
+	return nsxt.listPolicyResources("/policy/api/v1/infra/tier-1s")
+}
+
+// ListSegments returns every segment known to the policy API
+func (nsxt *NSXTClient) ListSegments() (segments []NSXTPolicyResource, nsxtError *NSXTErrorResponse, err error) {
+	nsxt.Logger.Log.Debug("NSX-T | ListSegments")
+	return nsxt.listPolicyResources("/policy/api/v1/infra/segments")
+}
+
+// ListSegmentPorts returns the ports attached to a segment
+func (nsxt *NSXTClient) ListSegmentPorts(segmentName string) (ports []NSXTPolicyResource, nsxtError *NSXTErrorResponse, err error) {
+	nsxt.Logger.Log.WithFields(log.Fields{
+		"segmentName": segmentName,
+	}).Debug("NSX-T | ListSegmentPorts")
+	return nsxt.listPolicyResources("/policy/api/v1/infra/segments/" + segmentName + "/ports")
+}
+
+// ListNATRules returns the user NAT rules of a Tier-1
+func (nsxt *NSXTClient) ListNATRules(tier1Name string) (natRules []NSXTNATRule, nsxtError *NSXTErrorResponse, err error) {
+	nsxt.Logger.Log.WithFields(log.Fields{
+		"tier1Name": tier1Name,
+	}).Debug("NSX-T | ListNATRules")
+	request, err := nsxt.generateAuthorizedRequest(http.MethodGet, ("/policy/api/v1/infra/tier-1s/" + tier1Name + "/nat/USER/nat-rules"))
+	if err != nil {
+		return
+	}
+	response, nsxtError, err := nsxt.executeRequestWithRetry(request, http.StatusOK)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error while listing NAT rules for tier-1 %s: %v", tier1Name, err)
+	}
+	if nsxtError != nil {
+		nsxt.Logger.Log.Errorf("error while listing NAT rules: %v", nsxtError)
+		return
+	}
+	defer response.Body.Close()
+	var listResponse NSXTListNATRulesResponse
+	err = json.NewDecoder(response.Body).Decode(&listResponse)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error while decoding NAT rules json response: %v", err)
+	}
+	return listResponse.Results, nil, nil
+}
+
+// ListIpAllocations returns the addresses currently allocated from the NAT ip pool
+func (nsxt *NSXTClient) ListIpAllocations() (ips []string, nsxtError *NSXTErrorResponse, err error) {
+	if nsxt.cachedIpPoolId == "" {
+		ipPoolsResponse, nsxtError, err := nsxt.GetIpPools()
+		if err != nil {
+			return nil, nil, fmt.Errorf("error while listing ip allocations: %v", err)
+		}
+		if nsxtError != nil {
+			return nil, nsxtError, nil
+		}
+		for _, ipPool := range ipPoolsResponse.Results {
+			if ipPool.DisplayName == nsxt.IpPoolName {
+				nsxt.cachedIpPoolId = ipPool.Id
+				break
+			}
+		}
+	}
+	nsxt.Logger.Log.WithFields(log.Fields{
+		"ipPoolId": nsxt.cachedIpPoolId,
+	}).Debug("NSX-T | ListIpAllocations")
+
+	request, err := nsxt.generateAuthorizedRequest(http.MethodGet, ("/api/v1/pools/ip-pools/" + nsxt.cachedIpPoolId + "/allocations"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("error while making the GET request for ip allocations: %v", err)
+	}
+	response, nsxtError, err := nsxt.executeRequestWithRetry(request, http.StatusOK)
+	if err != nil {
+		return
+	}
+	if nsxtError != nil {
+		nsxt.Logger.Log.Errorf("error while listing ip allocations: %+v", nsxtError)
+		return
+	}
+	defer response.Body.Close()
+	var listResponse NSXTListIpAllocationsResponse
+	err = json.NewDecoder(response.Body).Decode(&listResponse)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error while decoding NSX-T ip allocations json response: %v", err)
+	}
+	ips = make([]string, 0, len(listResponse.Results))
+	for _, allocation := range listResponse.Results {
+		if allocation.IpAddress != nil {
+			ips = append(ips, *allocation.IpAddress)
+		}
+	}
+	return ips, nil, nil
+}
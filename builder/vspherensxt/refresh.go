@@ -0,0 +1,85 @@
+package vspherensxt
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gen0cide/laforge/ent"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// RefreshHost checks whether the VM of a provisioned host still exists and is powered on
+func (builder VSphereNSXTBuilder) RefreshHost(ctx context.Context, provisionedHost *ent.ProvisionedHost) (exists bool, poweredOn bool, err error) {
+	host, err := provisionedHost.QueryProvisionedHostToHost().Only(ctx)
+	if err != nil {
+		return false, false, fmt.Errorf("couldn't query host from provisioned host \"%s\": %v", provisionedHost.ID, err)
+	}
+	entBuild, err := provisionedHost.QueryProvisionedHostToProvisionedNetwork().QueryProvisionedNetworkToBuild().Only(ctx)
+	if err != nil {
+		return false, false, fmt.Errorf("couldn't query build from provisioned host \"%s\": %v", provisionedHost.ID, err)
+	}
+	entCompetition, err := entBuild.QueryBuildToCompetition().Only(ctx)
+	if err != nil {
+		return false, false, fmt.Errorf("couldn't query competition from build \"%s\": %v", entBuild.ID, err)
+	}
+	entTeam, err := provisionedHost.QueryProvisionedHostToProvisionedNetwork().QueryProvisionedNetworkToTeam().Only(ctx)
+	if err != nil {
+		return false, false, fmt.Errorf("couldn't query team from provisioned host \"%s\": %v", provisionedHost.ID, err)
+	}
+
+	vmName := builder.generateVmName(entCompetition, entTeam, host, entBuild)
+	_, exists, err = builder.VSphereClient.GetVmSummary(ctx, vmName)
+	if err != nil {
+		return false, false, fmt.Errorf("error while checking if vm \"%s\" exists: %v", vmName, err)
+	}
+	if !exists {
+		return false, false, nil
+	}
+	powerState, err := builder.VSphereClient.GetVMPowerState(ctx, vmName)
+	if err != nil {
+		return true, false, fmt.Errorf("error while getting power state of vm \"%s\": %v", vmName, err)
+	}
+	return true, *powerState == types.VirtualMachinePowerStatePoweredOn, nil
+}
+
+// RefreshNetwork checks whether the segment of a provisioned network and its team's tier-1 still exist
+func (builder VSphereNSXTBuilder) RefreshNetwork(ctx context.Context, provisionedNetwork *ent.ProvisionedNetwork) (exists bool, err error) {
+	entBuild, err := provisionedNetwork.QueryProvisionedNetworkToBuild().Only(ctx)
+	if err != nil {
+		return false, fmt.Errorf("couldn't query build from network \"%s\": %v", provisionedNetwork.Name, err)
+	}
+	entCompetition, err := entBuild.QueryBuildToCompetition().Only(ctx)
+	if err != nil {
+		return false, fmt.Errorf("couldn't query competition from build \"%s\": %v", entBuild.ID, err)
+	}
+	entNetwork, err := provisionedNetwork.QueryProvisionedNetworkToNetwork().Only(ctx)
+	if err != nil {
+		return false, fmt.Errorf("couldn't query network from provisioned network \"%s\": %v", provisionedNetwork.Name, err)
+	}
+	entTeam, err := provisionedNetwork.QueryProvisionedNetworkToTeam().Only(ctx)
+	if err != nil {
+		return false, fmt.Errorf("couldn't query team from provisioned network \"%s\": %v", provisionedNetwork.Name, err)
+	}
+
+	tier1Name := builder.generateRouterName(entCompetition, entTeam, entBuild)
+	tier1Exists, nsxtError, err := builder.NsxtClient.CheckExistsTier1(tier1Name)
+	if err != nil {
+		return false, err
+	}
+	if nsxtError != nil {
+		return false, fmt.Errorf("nsx-t error %s (%d): %s", nsxtError.HttpStatus, nsxtError.ErrorCode, nsxtError.Message)
+	}
+	if !tier1Exists {
+		return false, nil
+	}
+
+	networkName := builder.generateNetworkName(entCompetition, entTeam, entNetwork, entBuild)
+	segmentExists, nsxtError, err := builder.NsxtClient.CheckExistsSegment(networkName)
+	if err != nil {
+		return false, err
+	}
+	if nsxtError != nil {
+		return false, fmt.Errorf("nsx-t error %s (%d): %s", nsxtError.HttpStatus, nsxtError.ErrorCode, nsxtError.Message)
+	}
+	return segmentExists, nil
+}
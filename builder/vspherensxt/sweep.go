@@ -0,0 +1,272 @@
+package vspherensxt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gen0cide/laforge/builder/vspherensxt/nsxt"
+	"github.com/google/uuid"
+)
+
+// OrphanKind is the type of infrastructure left behind by a build
+type OrphanKind string
+
+const (
+	OrphanVM                OrphanKind = "VM"
+	OrphanCustomizationSpec OrphanKind = "CUSTOMIZATION_SPEC"
+	OrphanNATRule           OrphanKind = "NAT_RULE"
+	OrphanIpAllocation      OrphanKind = "IP_ALLOCATION"
+	OrphanSegment           OrphanKind = "SEGMENT"
+	OrphanTier1             OrphanKind = "TIER1"
+)
+
+// segmentPortsTimeout is how long a sweep waits for vSphere to detach the deleted VMs from a segment
+const segmentPortsTimeout = 5 * time.Minute
+
+// customizationSpecSuffix is appended to the VM name to name its guest customization spec
+const customizationSpecSuffix = "-Customization-Spec"
+
+// Orphan is a vSphere or NSX-T object that follows the builder's naming scheme but doesn't belong to a live build
+type Orphan struct {
+	Kind OrphanKind
+	Name string
+	// Tier1 is the Tier-1 a NAT rule lives on
+	Tier1 string
+	// BuildID is the build the name points to, empty for ip allocations
+	BuildID string
+}
+
+func (orphan Orphan) String() string {
+	if orphan.Kind == OrphanNATRule {
+		return fmt.Sprintf("%s %s (tier-1 %s)", orphan.Kind, orphan.Name, orphan.Tier1)
+	}
+	if orphan.BuildID == "" {
+		return fmt.Sprintf("%s %s", orphan.Kind, orphan.Name)
+	}
+	return fmt.Sprintf("%s %s (build %s)", orphan.Kind, orphan.Name, orphan.BuildID)
+}
+
+// ParseBuildID returns the build ID at the end of a name made by generateVmName, generateRouterName
+// or generateNetworkName. Names that don't follow that scheme aren't LaForge's and return false.
+func ParseBuildID(name string) (buildID string, ok bool) {
+	name = strings.TrimSuffix(name, customizationSpecSuffix)
+	// "<competition>-Team-NN-...-<uuid>"
+	if !strings.Contains(name, "-Team-") || len(name) < 37 || name[len(name)-37] != '-' {
+		return "", false
+	}
+	id, err := uuid.Parse(name[len(name)-36:])
+	if err != nil {
+		return "", false
+	}
+	return id.String(), true
+}
+
+// FindOrphans lists the VMs, customization specs, Tier-1s (with their NAT rules) and segments named after a build
+// that isn't in liveBuilds, and the NAT pool allocations used by the NAT rules of those orphaned Tier-1s that
+// aren't in liveIps or used by a live Tier-1. Allocations no LaForge Tier-1 points to are left alone, they may
+// belong to something else using the pool. The orphans are returned in the order they have to be deleted in.
+func (builder VSphereNSXTBuilder) FindOrphans(ctx context.Context, liveBuilds map[string]bool, liveIps map[string]bool) (orphans []Orphan, err error) {
+	isOrphan := func(name string) (string, bool) {
+		buildID, ok := ParseBuildID(name)
+		if !ok {
+			return "", false
+		}
+		return buildID, !liveBuilds[buildID]
+	}
+
+	vms, err := builder.VSphereClient.ListVms(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while listing vms: %v", err)
+	}
+	vmOrphans := []Orphan{}
+	for _, vm := range vms {
+		if vm.Config.Template {
+			continue
+		}
+		if buildID, orphaned := isOrphan(vm.Config.Name); orphaned {
+			vmOrphans = append(vmOrphans, Orphan{Kind: OrphanVM, Name: vm.Config.Name, BuildID: buildID})
+		}
+	}
+
+	specNames, err := builder.VSphereClient.ListGuestCustomizations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while listing guest customization specs: %v", err)
+	}
+	specOrphans := []Orphan{}
+	for _, specName := range specNames {
+		if !strings.HasSuffix(specName, customizationSpecSuffix) {
+			continue
+		}
+		if buildID, orphaned := isOrphan(specName); orphaned {
+			specOrphans = append(specOrphans, Orphan{Kind: OrphanCustomizationSpec, Name: specName, BuildID: buildID})
+		}
+	}
+
+	tier1s, nsxtError, err := builder.NsxtClient.ListTier1s()
+	if err != nil {
+		return nil, fmt.Errorf("error while listing tier-1s: %v", err)
+	}
+	if nsxtError != nil {
+		return nil, fmt.Errorf("nsx-t error %s (%d): %s", nsxtError.HttpStatus, nsxtError.ErrorCode, nsxtError.Message)
+	}
+	natOrphans := []Orphan{}
+	tier1Orphans := []Orphan{}
+	usedIps := map[string]bool{}
+	for ip := range liveIps {
+		usedIps[ip] = true
+	}
+	orphanedIps := map[string]bool{}
+	for _, tier1 := range tier1s {
+		buildID, ok := ParseBuildID(tier1.Id)
+		if !ok {
+			continue
+		}
+		natRules, nsxtError, err := builder.NsxtClient.ListNATRules(tier1.Id)
+		if err != nil {
+			return nil, err
+		}
+		if nsxtError != nil {
+			return nil, fmt.Errorf("nsx-t error %s (%d): %s", nsxtError.HttpStatus, nsxtError.ErrorCode, nsxtError.Message)
+		}
+		if liveBuilds[buildID] {
+			// Keep the addresses used by live builds even if the database lost track of them
+			for _, ip := range natRuleIps(natRules) {
+				usedIps[ip] = true
+			}
+			continue
+		}
+		for _, ip := range natRuleIps(natRules) {
+			orphanedIps[ip] = true
+		}
+		for _, natRule := range natRules {
+			natOrphans = append(natOrphans, Orphan{Kind: OrphanNATRule, Name: natRule.Id, Tier1: tier1.Id, BuildID: buildID})
+		}
+		tier1Orphans = append(tier1Orphans, Orphan{Kind: OrphanTier1, Name: tier1.Id, BuildID: buildID})
+	}
+
+	segments, nsxtError, err := builder.NsxtClient.ListSegments()
+	if err != nil {
+		return nil, fmt.Errorf("error while listing segments: %v", err)
+	}
+	if nsxtError != nil {
+		return nil, fmt.Errorf("nsx-t error %s (%d): %s", nsxtError.HttpStatus, nsxtError.ErrorCode, nsxtError.Message)
+	}
+	segmentOrphans := []Orphan{}
+	for _, segment := range segments {
+		if buildID, orphaned := isOrphan(segment.Id); orphaned {
+			segmentOrphans = append(segmentOrphans, Orphan{Kind: OrphanSegment, Name: segment.Id, BuildID: buildID})
+		}
+	}
+
+	allocatedIps, nsxtError, err := builder.NsxtClient.ListIpAllocations()
+	if err != nil {
+		return nil, err
+	}
+	if nsxtError != nil {
+		return nil, fmt.Errorf("nsx-t error %s (%d): %s", nsxtError.HttpStatus, nsxtError.ErrorCode, nsxtError.Message)
+	}
+	ipOrphans := []Orphan{}
+	for _, ip := range allocatedIps {
+		if orphanedIps[ip] && !usedIps[ip] {
+			ipOrphans = append(ipOrphans, Orphan{Kind: OrphanIpAllocation, Name: ip})
+		}
+	}
+
+	orphans = append(orphans, vmOrphans...)
+	orphans = append(orphans, specOrphans...)
+	orphans = append(orphans, natOrphans...)
+	orphans = append(orphans, ipOrphans...)
+	orphans = append(orphans, segmentOrphans...)
+	orphans = append(orphans, tier1Orphans...)
+	return orphans, nil
+}
+
+// natRuleIps returns the NAT pool addresses a Tier-1's NAT rules translate to or from
+func natRuleIps(natRules []nsxt.NSXTNATRule) (ips []string) {
+	for _, natRule := range natRules {
+		if natRule.Action == nsxt.NSXT_NAT_SNAT {
+			ips = append(ips, string(natRule.TranslatedNetwork))
+		} else if natRule.Action == nsxt.NSXT_NAT_DNAT && natRule.DestinationNetwork != nil {
+			ips = append(ips, string(*natRule.DestinationNetwork))
+		}
+	}
+	return
+}
+
+// DeleteOrphans deletes orphans in the order returned by FindOrphans. Failures don't stop the sweep,
+// every one of them is returned.
+func (builder VSphereNSXTBuilder) DeleteOrphans(ctx context.Context, orphans []Orphan) (errs []error) {
+	for _, orphan := range orphans {
+		err := builder.deleteOrphan(ctx, orphan)
+		if err != nil {
+			builder.Logger.Log.Errorf("error deleting %s: %v", orphan, err)
+			errs = append(errs, fmt.Errorf("error deleting %s: %v", orphan, err))
+			continue
+		}
+		builder.Logger.Log.Infof("deleted %s", orphan)
+	}
+	return
+}
+
+func (builder VSphereNSXTBuilder) deleteOrphan(ctx context.Context, orphan Orphan) (err error) {
+	var nsxtError *nsxt.NSXTErrorResponse
+	switch orphan.Kind {
+	case OrphanVM:
+		return builder.VSphereClient.DeleteVM(ctx, orphan.Name)
+	case OrphanCustomizationSpec:
+		return builder.VSphereClient.DeleteGuestCustomization(ctx, orphan.Name)
+	case OrphanNATRule:
+		if strings.HasSuffix(orphan.Name, "-SNAT") {
+			nsxtError, err = builder.NsxtClient.DeleteSNATRule(orphan.Tier1)
+		} else {
+			nsxtError, err = builder.NsxtClient.DeleteDNATRule(orphan.Tier1)
+		}
+	case OrphanIpAllocation:
+		_, nsxtError, err = builder.NsxtClient.ManageIpAllocation(orphan.Name, nsxt.NSXT_IP_POOL_RELEASE)
+	case OrphanSegment:
+		// The VMs deleted earlier in the sweep are detached from the segment asynchronously
+		err = builder.waitForSegmentPorts(ctx, orphan.Name)
+		if err != nil {
+			return
+		}
+		nsxtError, err = builder.NsxtClient.DeleteSegment(orphan.Name)
+	case OrphanTier1:
+		nsxtError, err = builder.NsxtClient.DeleteTier1(orphan.Name)
+	default:
+		return fmt.Errorf("unknown orphan kind \"%s\"", orphan.Kind)
+	}
+	if err != nil {
+		return
+	}
+	if nsxtError != nil {
+		return fmt.Errorf("nsx-t error %s (%d): %s", nsxtError.HttpStatus, nsxtError.ErrorCode, nsxtError.Message)
+	}
+	return
+}
+
+// waitForSegmentPorts waits until NSX-T doesn't have any port left on the segment, it can't be deleted before that
+func (builder VSphereNSXTBuilder) waitForSegmentPorts(ctx context.Context, segmentName string) error {
+	deadline := time.Now().Add(segmentPortsTimeout)
+	for {
+		ports, nsxtError, err := builder.NsxtClient.ListSegmentPorts(segmentName)
+		if err != nil {
+			return err
+		}
+		if nsxtError != nil {
+			return fmt.Errorf("nsx-t error %s (%d): %s", nsxtError.HttpStatus, nsxtError.ErrorCode, nsxtError.Message)
+		}
+		if len(ports) == 0 {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("segment %s still has %d ports after %s", segmentName, len(ports), segmentPortsTimeout)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
@@ -4,14 +4,13 @@ package vsphere
 import (
 	"context"
 	"fmt"
-	"net"
 	"net/http"
 	"net/url"
-	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gen0cide/laforge/ent"
+	"github.com/gen0cide/laforge/ipam"
 	"github.com/gen0cide/laforge/logging"
 	log "github.com/sirupsen/logrus"
 	"github.com/vmware/govmomi"
@@ -1331,12 +1330,29 @@ func (vs *VSphere) DeleteVM(ctx context.Context, name string) (err error) {
 // 	return
 // }
 
-func (vs *VSphere) DeployLinkedClone(ctx context.Context, sourceVmName, destVmName, networkName string, cpuCount int32, memory int64, folder *object.Folder, resourcePool *object.ResourcePool, guestCustomization *types.CustomizationSpecItem) (err error) {
+// DeployLinkedClone clones the template into a new VM. diskSizes are in GB with the primary disk
+// first (0 keeps the template's size) and every following size added as a new disk. Linked
+// clones can't grow the disk they share with the template, so a full clone is made instead
+// when the primary disk needs to be bigger than the template's.
+func (vs *VSphere) DeployLinkedClone(ctx context.Context, sourceVmName, destVmName, networkName string, cpuCount int32, memory int64, diskSizes []int, folder *object.Folder, resourcePool *object.ResourcePool, guestCustomization *types.CustomizationSpecItem) (err error) {
 	vs.Logger.Log.WithFields(log.Fields{
 		"sourceVmName": sourceVmName,
 		"spec.Name":    guestCustomization.Info.Name,
 	}).Debug("vSphere | DeployLinkedClone")
 
+	// The host is deployed again when the server restarted in the middle of its deploy, don't clone it twice
+	_, exists, err := vs.GetVmSummary(ctx, destVmName)
+	if err != nil {
+		return
+	}
+	if exists {
+		vs.Logger.Log.WithFields(log.Fields{
+			"destVmName": destVmName,
+		}).Info("vSphere | vm already exists, skipping the clone")
+		vs.ensurePoweredOn(ctx, destVmName)
+		return
+	}
+
 	sourceVm, err := vs.Finder.VirtualMachine(ctx, sourceVmName)
 	if err != nil {
 		return
@@ -1379,9 +1395,21 @@ func (vs *VSphere) DeployLinkedClone(ctx context.Context, sourceVmName, destVmNa
 
 	ethernetCard.Backing = netdev.(types.BaseVirtualEthernetCard).GetVirtualEthernetCard().Backing
 
+	diskChanges, fullClone, err := vs.generateDiskChanges(devices, diskSizes)
+	if err != nil {
+		return fmt.Errorf("error configuring disks for %s: %v", destVmName, err)
+	}
+
 	cloneSpec := types.VirtualMachineCloneSpec{}
 	relocateSpec := types.VirtualMachineRelocateSpec{}
 	relocateSpec.DiskMoveType = "createNewChildDiskBacking"
+	if fullClone {
+		vs.Logger.Log.WithFields(log.Fields{
+			"sourceVmName": sourceVmName,
+			"destVmName":   destVmName,
+		}).Info("vSphere | primary disk is bigger than the template's, deploying a full clone")
+		relocateSpec.DiskMoveType = "moveAllDiskBackingsAndDisallowSharing"
+	}
 	relocateSpec.Pool = &rpReference
 	relocateSpec.DeviceChange = []types.BaseVirtualDeviceConfigSpec{
 		&types.VirtualDeviceConfigSpec{
@@ -1398,20 +1426,32 @@ func (vs *VSphere) DeployLinkedClone(ctx context.Context, sourceVmName, destVmNa
 		NumCPUs:           cpuCount,
 		NumCoresPerSocket: 2,
 		MemoryMB:          memory,
+		DeviceChange:      diskChanges,
 	}
 
 	task, err := sourceVm.Clone(ctx, folder, destVmName, cloneSpec)
+	if err != nil {
+		return
+	}
 	err = task.Wait(ctx)
+	if err != nil {
+		return
+	}
 
-	// Make sure VM actually gets powered on so we can kickoff the customizations
+	vs.ensurePoweredOn(ctx, destVmName)
+	return
+}
+
+// ensurePoweredOn makes sure the VM actually gets powered on so we can kickoff the customizations
+func (vs *VSphere) ensurePoweredOn(ctx context.Context, vmName string) {
 	timeout := 10
 	for i := 0; i < vs.MaxRetries; i++ {
-		powerstate, err := vs.GetVMPowerState(ctx, destVmName)
+		powerstate, err := vs.GetVMPowerState(ctx, vmName)
 		if err == nil {
 			if *powerstate == types.VirtualMachinePowerStatePoweredOn {
 				break
 			} else {
-				err = vs.PowerOnVM(ctx, destVmName)
+				err = vs.PowerOnVM(ctx, vmName)
 				if err != nil {
 					vs.Logger.Log.Debugf("error while powering on VM: %v", err)
 				}
@@ -1420,6 +1460,62 @@ func (vs *VSphere) DeployLinkedClone(ctx context.Context, sourceVmName, destVmNa
 		time.Sleep(time.Duration(timeout) * time.Second)
 		timeout = timeout * 2
 	}
+}
+
+// generateDiskChanges builds the device changes that resize the template's primary disk and add
+// the additional disks. fullClone is true when the primary disk has to grow.
+func (vs *VSphere) generateDiskChanges(devices object.VirtualDeviceList, diskSizes []int) (changes []types.BaseVirtualDeviceConfigSpec, fullClone bool, err error) {
+	if len(diskSizes) == 0 {
+		return
+	}
+	disks := devices.SelectByType((*types.VirtualDisk)(nil))
+	if len(disks) == 0 {
+		return nil, false, fmt.Errorf("template has no virtual disk")
+	}
+	primaryDisk := disks[0].(*types.VirtualDisk)
+	primarySizeKB := int64(diskSizes[0]) * 1024 * 1024
+	if primarySizeKB > primaryDisk.CapacityInKB {
+		primaryDisk.CapacityInKB = primarySizeKB
+		primaryDisk.CapacityInBytes = primarySizeKB * 1024
+		changes = append(changes, &types.VirtualDeviceConfigSpec{
+			Operation: types.VirtualDeviceConfigSpecOperationEdit,
+			Device:    primaryDisk,
+		})
+		fullClone = true
+	} else if primarySizeKB > 0 && primarySizeKB < primaryDisk.CapacityInKB {
+		vs.Logger.Log.Warnf("vSphere | requested disk size of %dGB is smaller than the template's disk (%dGB), keeping the template's size", diskSizes[0], primaryDisk.CapacityInKB/1024/1024)
+	}
+	if len(diskSizes) == 1 {
+		return
+	}
+	controller, ok := devices.FindByKey(primaryDisk.ControllerKey).(types.BaseVirtualController)
+	if !ok {
+		return nil, false, fmt.Errorf("couldn't find the controller of the template's disk")
+	}
+	for _, size := range diskSizes[1:] {
+		if size <= 0 {
+			return nil, false, fmt.Errorf("additional disk size must be positive, got %d", size)
+		}
+		disk := &types.VirtualDisk{
+			VirtualDevice: types.VirtualDevice{
+				Backing: &types.VirtualDiskFlatVer2BackingInfo{
+					DiskMode:        string(types.VirtualDiskModePersistent),
+					ThinProvisioned: types.NewBool(true),
+				},
+			},
+			CapacityInKB:    int64(size) * 1024 * 1024,
+			CapacityInBytes: int64(size) * 1024 * 1024 * 1024,
+		}
+		// Assigning the controller picks the next free unit number and a unique key, so the new
+		// disk has to be in the list before the next one is assigned
+		devices.AssignController(disk, controller)
+		devices = append(devices, disk)
+		changes = append(changes, &types.VirtualDeviceConfigSpec{
+			Operation:     types.VirtualDeviceConfigSpecOperationAdd,
+			FileOperation: types.VirtualDeviceConfigSpecFileOperationCreate,
+			Device:        disk,
+		})
+	}
 	return
 }
 
@@ -1437,6 +1533,22 @@ func (vs *VSphere) GuestCustomizationExists(ctx context.Context, specName string
 	return
 }
 
+// ListGuestCustomizations returns the names of every guest customization spec on the vCenter
+func (vs *VSphere) ListGuestCustomizations(ctx context.Context) (specNames []string, err error) {
+	vs.Logger.Log.Debug("vSphere | ListGuestCustomizations")
+
+	var specManager mo.CustomizationSpecManager
+	err = vs.GCManager.Properties(ctx, vs.GCManager.Reference(), []string{"info"}, &specManager)
+	if err != nil {
+		return nil, fmt.Errorf("error while retrieving guest customization specs: %v", err)
+	}
+	specNames = make([]string, len(specManager.Info))
+	for i, info := range specManager.Info {
+		specNames[i] = info.Name
+	}
+	return
+}
+
 func (vs *VSphere) GenerateGuestCustomization(ctx context.Context, specName string, templateName string, provisionedHost *ent.ProvisionedHost) (spec *types.CustomizationSpecItem, err error) {
 	vs.Logger.Log.WithFields(log.Fields{
 		"templateName":       templateName,
@@ -1492,6 +1604,10 @@ func (vs *VSphere) GenerateGuestCustomization(ctx context.Context, specName stri
 	if err != nil {
 		return nil, fmt.Errorf("error while querying network from provisioned network: %v", err)
 	}
+	team, err := provisionedNetwork.QueryProvisionedNetworkToTeam().Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while querying team from provisioned network: %v", err)
+	}
 
 	template, err := vs.Finder.VirtualMachine(ctx, templateName)
 	if err != nil {
@@ -1643,28 +1759,39 @@ fi`, agentUrl, linuxPassword)
 		linuxOptions = &types.CustomizationLinuxOptions{}
 	}
 
-	networkAddrParts := strings.Split(provisionedNetwork.Cidr, "/")
-	networkAddr := networkAddrParts[0]
-	networkOctetStrings := strings.Split(networkAddr, ".")
-	networkOctets := []byte{0, 0, 0, 0}
-	for i, octetString := range networkOctetStrings {
-		octet, err := strconv.Atoi(octetString)
-		if err != nil {
-			return nil, fmt.Errorf("error while parsing IPv4 Address %s: %v", provisionedNetwork.Cidr, err)
-		}
-		networkOctets[i] = byte(octet)
+	prefix, err := ipam.ParsePrefix(provisionedNetwork.Cidr)
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing cidr: %v", err)
 	}
-
-	_, ipv4Net, err := net.ParseCIDR(provisionedNetwork.Cidr)
+	gatewayAddress, err := prefix.Gateway(ipam.ForTeam(network.Vars[ipam.GatewayVar], team.TeamNumber))
 	if err != nil {
-		vs.Logger.Log.Fatalf("error while parsing cidr: %v", err)
+		return nil, fmt.Errorf("error while finding gateway: %v", err)
 	}
-	if len(ipv4Net.Mask) != 4 {
-		vs.Logger.Log.Fatalf("mask is not correct length")
+
+	var ipv6Spec *types.CustomizationIPSettingsIpV6AddressSpec
+	if provisionedHost.SubnetIpv6 != "" && provisionedNetwork.Ipv6Cidr != "" {
+		ipv6Prefix, err := ipam.ParsePrefix(provisionedNetwork.Ipv6Cidr)
+		if err != nil {
+			return nil, fmt.Errorf("error while parsing ipv6 cidr: %v", err)
+		}
+		ipv6Gateway, err := ipv6Prefix.Gateway("")
+		if err != nil {
+			return nil, fmt.Errorf("error while finding ipv6 gateway: %v", err)
+		}
+		ipv6Spec = &types.CustomizationIPSettingsIpV6AddressSpec{
+			DynamicData: types.DynamicData{},
+			Ip: []types.BaseCustomizationIpV6Generator{
+				&types.CustomizationFixedIpV6{
+					CustomizationIpV6Generator: types.CustomizationIpV6Generator{},
+					IpAddress:                  provisionedHost.SubnetIpv6,
+					SubnetMask:                 int32(ipv6Prefix.PrefixLength()),
+				},
+			},
+			Gateway: []string{
+				ipv6Gateway.String(),
+			},
+		}
 	}
-	subnetMask := fmt.Sprintf("%d.%d.%d.%d", ipv4Net.Mask[0], ipv4Net.Mask[1], ipv4Net.Mask[2], ipv4Net.Mask[3])
-	hostAddress := strings.Join(append(networkOctetStrings[:3], fmt.Sprint(host.LastOctet)), ".")
-	gatewayAddress := strings.Join(append(networkOctetStrings[:3], "254"), ".")
 
 	nicSettings := []types.CustomizationAdapterMapping{
 		{
@@ -1674,13 +1801,13 @@ fi`, agentUrl, linuxPassword)
 				DynamicData: types.DynamicData{},
 				Ip: &types.CustomizationFixedIp{
 					CustomizationIpGenerator: types.CustomizationIpGenerator{},
-					IpAddress:                hostAddress,
+					IpAddress:                provisionedHost.SubnetIP,
 				},
-				SubnetMask: subnetMask,
+				SubnetMask: prefix.Netmask(),
 				Gateway: []string{
-					gatewayAddress,
+					gatewayAddress.String(),
 				},
-				IpV6Spec: nil,
+				IpV6Spec: ipv6Spec,
 			},
 		},
 	}
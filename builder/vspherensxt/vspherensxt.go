@@ -12,6 +12,7 @@ import (
 	"github.com/gen0cide/laforge/builder/vspherensxt/nsxt"
 	"github.com/gen0cide/laforge/builder/vspherensxt/vsphere"
 	"github.com/gen0cide/laforge/ent"
+	"github.com/gen0cide/laforge/ipam"
 	"github.com/gen0cide/laforge/logging"
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/vim25/types"
@@ -26,6 +27,28 @@ const (
 	Version     = "0.1"
 )
 
+// Config is the environment configuration read by the vSphere + NSX-T builder
+type Config struct {
+	LaforgeServerUrl    string `config:"laforge_server_url,required" description:"The FQDN of the main LaForge server"`
+	VSphereUsername     string `config:"vsphere_username,required" description:"Username of the vSphere user used for LaForge"`
+	VSpherePassword     string `config:"vsphere_password,required" description:"Password of the LaForge user"`
+	VSphereBaseUrl      string `config:"vsphere_base_url,required" description:"The URL of the vSphere server"`
+	NsxtCertPath        string `config:"nsxt_cert_path,required" description:"The absolute path to the certificate of the Principal Identity User for NSX-T"`
+	NsxtCACertPath      string `config:"nsxt_ca_cert_path,required" description:"The absolute path to the CA Cert for the Pricipal Identity User"`
+	NsxtKeyPath         string `config:"nsxt_key_path,required" description:"The absolute path to the Private Key for the Principal Identity User"`
+	NsxtBaseUrl         string `config:"nsxt_base_url,required" description:"The URL of the NSX-T server"`
+	NsxtIpPoolName      string `config:"nsxt_ip_pool_name,required" description:"The name of the IP Pool to be used for NAT"`
+	NsxtEdgeClusterPath string `config:"nsxt_edge_cluster_path,required" description:"The policy path of the Edge Cluster used by the Tier-1s"`
+	ContentLibraryName  string `config:"vsphere_content_library,required" description:"The name of the Content Library containing the VM Templates for LaForge"`
+	DatastoreName       string `config:"vsphere_datastore,required" description:"The name of the Datastore to place the VMs on"`
+	ResourcePoolName    string `config:"vsphere_resource_pool,required" description:"The name of the Resource Pool to assign the VMs to"`
+	FolderName          string `config:"vsphere_folder,required" description:"The name of the Folder to put the VMs in"`
+	TemplatePrefix      string `config:"vsphere_template_prefix,required" description:"The prefix given to each of the LaForge VM templates"`
+	MaxBuildWorkers     int    `config:"vsphere_max_build_workers" default:"8" min:"1" description:"Max number of hosts deployed at once"`
+	MaxTeardownWorkers  int    `config:"vsphere_max_teardown_workers" default:"16" min:"1" description:"Max number of hosts torn down at once"`
+	InstanceSizes       string `config:"vsphere_instance_sizes" default:"nano=1/1024,micro=1/2048,small=2/2048,medium=2/4096,large=4/4096,xlarge=4/8192" description:"Instance size table in the form name=cpus/memory_mb,..."`
+}
+
 type VSphereNSXTBuilder struct {
 	HttpClient                http.Client
 	Username                  string
@@ -41,6 +64,7 @@ type VSphereNSXTBuilder struct {
 	MaxWorkers                int
 	DeployWorkerPool          *semaphore.Weighted
 	TeardownWorkerPool        *semaphore.Weighted
+	InstanceSizes             map[string]InstanceSize
 }
 
 func (builder VSphereNSXTBuilder) ID() string {
@@ -89,32 +113,15 @@ func (builder VSphereNSXTBuilder) DeployHost(ctx context.Context, provisionedHos
 	if err != nil {
 		return
 	}
-	cpuCount := int32(0)
-	memorySize := int64(0)
-	switch host.InstanceSize {
-	case "nano":
-		cpuCount = 1
-		memorySize = 1024
-	case "micro":
-		cpuCount = 1
-		memorySize = 2048
-	case "small":
-		cpuCount = 2
-		memorySize = 2048
-	case "medium":
-		cpuCount = 2
-		memorySize = 4096
-	case "large":
-		cpuCount = 4
-		memorySize = 4096
-	case "xlarge":
-		cpuCount = 4
-		memorySize = 8192
-	}
-	if cpuCount == 0 || memorySize == 0 {
-		err = errors.New("couldn't resolve host instance size: " + host.InstanceSize)
+	cpuCount, memorySize, err := builder.resolveHardware(host)
+	if err != nil {
 		return
 	}
+	disk, err := host.QueryHostToDisk().Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("couldn't query disk from host %s: %v", host.HclID, err)
+	}
+	diskSizes := resolveDisks(host, disk)
 
 	build, err := provisionedHost.QueryProvisionedHostToPlan().QueryPlanToBuild().Only(ctx)
 	if err != nil {
@@ -158,7 +165,7 @@ func (builder VSphereNSXTBuilder) DeployHost(ctx context.Context, provisionedHos
 		return err
 	}
 
-	err = builder.VSphereClient.DeployLinkedClone(ctx, templateName, vmName, networkName, cpuCount, memorySize, builder.VSphereFolder, builder.VSphereResourcePool, guestCustomizationSpec)
+	err = builder.VSphereClient.DeployLinkedClone(ctx, templateName, vmName, networkName, cpuCount, memorySize, diskSizes, builder.VSphereFolder, builder.VSphereResourcePool, guestCustomizationSpec)
 	if err != nil {
 		return
 	}
@@ -243,7 +250,7 @@ func (builder VSphereNSXTBuilder) DeployNetwork(ctx context.Context, provisioned
 			return fmt.Errorf("nsx-t error %s (%d): %s", nsxtError.HttpStatus, nsxtError.ErrorCode, nsxtError.Message)
 		}
 
-		addressParts := strings.Split(entNetwork.Cidr, "/")
+		addressParts := strings.Split(provisionedNetwork.Cidr, "/")
 		var natSourceAddress string
 
 		switch addressParts[1] {
@@ -309,6 +316,7 @@ func (builder VSphereNSXTBuilder) DeployNetwork(ctx context.Context, provisioned
 		}
 
 		vpnIp, vpnIpExists := entNetwork.Vars["vpn_ip"]
+		vpnIp = ipam.ForTeam(vpnIp, entTeam.TeamNumber)
 		vpnPort, vpnPortExists := entNetwork.Vars["vpn_port"]
 		// gatewayIp, gatewayIpExists := entTeam.Vars["gateway_public_ip"]
 		// if vpnIpExists && vpnPortExists && gatewayIpExists {
@@ -324,24 +332,28 @@ func (builder VSphereNSXTBuilder) DeployNetwork(ctx context.Context, provisioned
 	}
 
 	networkName := builder.generateNetworkName(entCompetition[0], entTeam, entNetwork, entBuild)
-	cidrParts := strings.Split(entNetwork.Cidr, "/")
-	gatewayAddress, gatewayAddressExists := entNetwork.Vars["gateway_address"]
-
-	var octets []string
-	if gatewayAddressExists {
-		octets = strings.Split(gatewayAddress, ".")
-	} else {
-		octets = strings.Split(cidrParts[0], ".")
+	prefix, err := ipam.ParsePrefix(provisionedNetwork.Cidr)
+	if err != nil {
+		return err
 	}
-
-	switch cidrParts[1] {
-	case "24":
-		octets[3] = "254"
-		gatewayAddress = (strings.Join(octets, ".") + "/" + cidrParts[1])
+	gateway, err := prefix.Gateway(ipam.ForTeam(entNetwork.Vars[ipam.GatewayVar], entTeam.TeamNumber))
+	if err != nil {
+		return err
+	}
+	gatewayAddresses := []string{fmt.Sprintf("%s/%d", gateway, prefix.PrefixLength())}
+	if provisionedNetwork.Ipv6Cidr != "" {
+		ipv6Prefix, err := ipam.ParsePrefix(provisionedNetwork.Ipv6Cidr)
+		if err != nil {
+			return err
+		}
+		ipv6Gateway, err := ipv6Prefix.Gateway("")
+		if err != nil {
+			return err
+		}
+		gatewayAddresses = append(gatewayAddresses, fmt.Sprintf("%s/%d", ipv6Gateway, ipv6Prefix.PrefixLength()))
 	}
 
-	// fmt.Println("deploying segment \"" + networkName + "\" w/ gateway_addr = " + gatewayAddress)
-	nsxtError, err = builder.NsxtClient.CreateSegment(networkName, ("/infra/tier-1s/" + tier1Name), gatewayAddress)
+	nsxtError, err = builder.NsxtClient.CreateSegment(networkName, ("/infra/tier-1s/" + tier1Name), gatewayAddresses...)
 	if nsxtError != nil {
 		return fmt.Errorf("nsx-t error %s (%d): %s", nsxtError.HttpStatus, nsxtError.ErrorCode, nsxtError.Message)
 	}
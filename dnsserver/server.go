@@ -0,0 +1,153 @@
+// Package dnsserver is the authoritative DNS server the Laforge server can run for the root domain of
+// the competitions whose dns block has type "laforge", so environments don't need a root-dns host.
+// It answers from the provisioned hosts and the completed DNS record steps of the latest build of the
+// competition. Every team has its own view of the zone, picked by the address its queries come from
+// or by a team label in the name (ex. web.team3.comp.local).
+package dnsserver
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/gen0cide/laforge/ent"
+	"github.com/gen0cide/laforge/planner"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/miekg/dns"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// refreshDelay groups the updates of a running build into a single reload of the zones
+	refreshDelay = 2 * time.Second
+	// refreshInterval reloads the zones even when no update came through redis
+	refreshInterval = time.Minute
+)
+
+// Server answers DNS queries for the root domains of the competitions served by Laforge
+type Server struct {
+	client *ent.Client
+	rdb    *redis.Client
+
+	zonesMu sync.RWMutex
+	zones   zoneSet
+
+	// rendered keeps the DNS record steps rendered by the last load, only the refresh loop uses it
+	rendered     map[uuid.UUID]*planner.RenderedDNSRecord
+	nextRendered map[uuid.UUID]*planner.RenderedDNSRecord
+}
+
+// New creates a DNS server for the competitions in the database, rdb brings it the updates of builds
+func New(client *ent.Client, rdb *redis.Client) *Server {
+	return &Server{
+		client:   client,
+		rdb:      rdb,
+		zones:    zoneSet{},
+		rendered: map[uuid.UUID]*planner.RenderedDNSRecord{},
+	}
+}
+
+// ListenAndServe serves DNS over UDP and TCP on address (ex. ":53") until ctx is done
+func (s *Server) ListenAndServe(ctx context.Context, address string) error {
+	err := s.refresh(ctx)
+	if err != nil {
+		logrus.Errorf("error loading DNS zones: %v", err)
+	}
+	go s.refreshLoop(ctx)
+
+	servers := []*dns.Server{
+		{Addr: address, Net: "udp", Handler: s},
+		{Addr: address, Net: "tcp", Handler: s},
+	}
+	errs := make(chan error, len(servers))
+	for _, server := range servers {
+		go func(server *dns.Server) {
+			errs <- server.ListenAndServe()
+		}(server)
+	}
+	select {
+	case err = <-errs:
+		err = fmt.Errorf("error serving DNS on %s: %v", address, err)
+	case <-ctx.Done():
+	}
+	for _, server := range servers {
+		server.Shutdown()
+	}
+	return err
+}
+
+// ServeDNS answers a query from the zones of the last load
+func (s *Server) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	s.zonesMu.RLock()
+	zones := s.zones
+	s.zonesMu.RUnlock()
+	var resp *dns.Msg
+	switch addr := w.RemoteAddr().(type) {
+	case *net.UDPAddr:
+		resp = zones.answer(req, addr.IP)
+		size := dns.MinMsgSize
+		if opt := req.IsEdns0(); opt != nil && int(opt.UDPSize()) > size {
+			size = int(opt.UDPSize())
+		}
+		resp.Truncate(size)
+	case *net.TCPAddr:
+		resp = zones.answer(req, addr.IP)
+	default:
+		resp = zones.answer(req, nil)
+	}
+	err := w.WriteMsg(resp)
+	if err != nil {
+		logrus.Debugf("error answering DNS query from %v: %v", w.RemoteAddr(), err)
+	}
+}
+
+// refresh reloads the zones from the database
+func (s *Server) refresh(ctx context.Context) error {
+	zones, err := s.loadZones(ctx)
+	if err != nil {
+		return err
+	}
+	s.zonesMu.Lock()
+	s.zones = zones
+	s.zonesMu.Unlock()
+	logrus.Debugf("loaded DNS zones %v", zones.origins())
+	return nil
+}
+
+// refreshLoop reloads the zones when builds, hosts and steps change (and every refreshInterval in case
+// an update was missed)
+func (s *Server) refreshLoop(ctx context.Context) {
+	sub := s.rdb.Subscribe(ctx, "updatedStatus", "updatedBuild", "updatedBuildCommit")
+	defer sub.Close()
+	updates := sub.Channel()
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-updates:
+			// Let the rest of the burst of updates in before reloading
+			timer := time.NewTimer(refreshDelay)
+		drain:
+			for {
+				select {
+				case <-updates:
+				case <-timer.C:
+					break drain
+				case <-ctx.Done():
+					timer.Stop()
+					return
+				}
+			}
+		case <-ticker.C:
+		}
+		err := s.refresh(ctx)
+		if err != nil {
+			logrus.Errorf("error reloading DNS zones: %v", err)
+		}
+	}
+}
@@ -0,0 +1,254 @@
+package dnsserver
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gen0cide/laforge/dnsupdate"
+	"github.com/gen0cide/laforge/ent"
+	"github.com/gen0cide/laforge/ent/build"
+	"github.com/gen0cide/laforge/ent/competition"
+	entdns "github.com/gen0cide/laforge/ent/dns"
+	"github.com/gen0cide/laforge/ent/provisionedhost"
+	"github.com/gen0cide/laforge/ent/provisionednetwork"
+	"github.com/gen0cide/laforge/ent/provisioningstep"
+	"github.com/gen0cide/laforge/ent/status"
+	"github.com/gen0cide/laforge/ipam"
+	"github.com/gen0cide/laforge/planner"
+	"github.com/google/uuid"
+	"github.com/miekg/dns"
+	"github.com/sirupsen/logrus"
+)
+
+// loadZones builds the zones of every DNS block of type planner.BuiltinDNSType from the latest build of
+// its competition that hasn't been deleted. Problems with single records are logged and skip the record,
+// the rest of the zone is still served.
+func (s *Server) loadZones(ctx context.Context) (zoneSet, error) {
+	entDNSs, err := s.client.DNS.Query().
+		Where(entdns.TypeEQ(planner.BuiltinDNSType)).
+		WithDNSToCompetition().
+		Order(ent.Asc(entdns.FieldHclID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error querying DNS: %v", err)
+	}
+	// Only the renders of the steps still served are kept for the next load
+	s.nextRendered = map[uuid.UUID]*planner.RenderedDNSRecord{}
+	defer func() { s.rendered, s.nextRendered = s.nextRendered, nil }()
+	serial := uint32(time.Now().Unix())
+	zones := zoneSet{}
+	for _, entDNS := range entDNSs {
+		origin := strings.ToLower(dns.Fqdn(entDNS.RootDomain))
+		if _, taken := zones[origin]; taken {
+			logrus.Warnf("DNS %s: root domain %s is already served for another competition", entDNS.HclID, origin)
+			continue
+		}
+		for _, entCompetition := range entDNS.Edges.DNSToCompetition {
+			entBuild, err := s.client.Build.Query().
+				Where(
+					build.HasBuildToCompetitionWith(competition.IDEQ(entCompetition.ID)),
+					build.Not(build.HasBuildToStatusWith(status.StateEQ(status.StateDELETED))),
+				).
+				Order(ent.Desc(build.FieldRevision)).
+				First(ctx)
+			if ent.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("error querying build of competition %s: %v", entCompetition.HclID, err)
+			}
+			z, err := s.loadZone(ctx, entDNS, entBuild, serial)
+			if err != nil {
+				return nil, err
+			}
+			zones[origin] = z
+			break
+		}
+	}
+	return zones, nil
+}
+
+// loadZone builds the zone of a DNS block from a build. Every team sees its hosts as
+// <hostname>.<root domain> and <hostname>.<network>.<root domain> along with its completed DNS record
+// steps, the team label (<name>.team<number>.<root domain>) gets to the names of any team.
+func (s *Server) loadZone(ctx context.Context, entDNS *ent.DNS, entBuild *ent.Build, serial uint32) (*zone, error) {
+	ttl, err := planner.DNSRecordTTL(entDNS, nil)
+	if err != nil {
+		return nil, err
+	}
+	if ttl == 0 {
+		ttl = dnsupdate.DefaultTTL
+	}
+	z := newZone(entDNS.RootDomain, ttl, serial, entDNS.Config["soa_mname"])
+
+	entProNetworks, err := s.client.ProvisionedNetwork.Query().
+		Where(provisionednetwork.HasProvisionedNetworkToBuildWith(build.IDEQ(entBuild.ID))).
+		WithProvisionedNetworkToTeam().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error querying provisioned networks of build %s: %v", entBuild.ID, err)
+	}
+	for _, entProNetwork := range entProNetworks {
+		entTeam := entProNetwork.Edges.ProvisionedNetworkToTeam
+		if entTeam == nil {
+			continue
+		}
+		z.team(entTeam.TeamNumber)
+		for _, cidr := range viewSources(entDNS, entTeam, entProNetwork) {
+			err = z.addSource(cidr, entTeam.TeamNumber)
+			if err != nil {
+				logrus.Warnf("DNS %s: %v", entDNS.HclID, err)
+			}
+		}
+	}
+
+	entProHosts, err := s.client.ProvisionedHost.Query().
+		Where(provisionedhost.HasProvisionedHostToBuildWith(build.IDEQ(entBuild.ID))).
+		WithProvisionedHostToHost().
+		WithProvisionedHostToStatus().
+		WithProvisionedHostToProvisionedNetwork(func(q *ent.ProvisionedNetworkQuery) {
+			q.WithProvisionedNetworkToTeam()
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error querying provisioned hosts of build %s: %v", entBuild.ID, err)
+	}
+	hostTeams := make(map[uuid.UUID]int, len(entProHosts))
+	for _, entProHost := range entProHosts {
+		entHost := entProHost.Edges.ProvisionedHostToHost
+		entProNetwork := entProHost.Edges.ProvisionedHostToProvisionedNetwork
+		if entHost == nil || entProNetwork == nil || entProNetwork.Edges.ProvisionedNetworkToTeam == nil {
+			continue
+		}
+		teamNumber := entProNetwork.Edges.ProvisionedNetworkToTeam.TeamNumber
+		hostTeams[entProHost.ID] = teamNumber
+		if entStatus := entProHost.Edges.ProvisionedHostToStatus; entStatus != nil && entStatus.State == status.StateDELETED {
+			continue
+		}
+		v := z.team(teamNumber)
+		hostname := strings.ToLower(entHost.Hostname)
+		for _, name := range []string{hostname, hostname + "." + strings.ToLower(entProNetwork.Name)} {
+			var addErr error
+			if entProHost.SubnetIP != "" {
+				addErr = v.add(z.origin, name, ttl, "A", entProHost.SubnetIP)
+			}
+			if addErr == nil && entProHost.SubnetIpv6 != "" {
+				addErr = v.add(z.origin, name, ttl, "AAAA", entProHost.SubnetIpv6)
+			}
+			if addErr != nil {
+				logrus.Warnf("DNS %s: host %s of team %d: %v", entDNS.HclID, entHost.HclID, teamNumber, addErr)
+				break
+			}
+		}
+	}
+
+	entSteps, err := s.client.ProvisioningStep.Query().
+		Where(
+			provisioningstep.TypeEQ(provisioningstep.TypeDNSRecord),
+			provisioningstep.HasProvisioningStepToStatusWith(status.StateEQ(status.StateCOMPLETE)),
+			provisioningstep.HasProvisioningStepToProvisionedHostWith(provisionedhost.HasProvisionedHostToBuildWith(build.IDEQ(entBuild.ID))),
+		).
+		WithProvisioningStepToDNSRecord().
+		WithProvisioningStepToProvisionedHost().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error querying DNS record steps of build %s: %v", entBuild.ID, err)
+	}
+	sort.Slice(entSteps, func(i, j int) bool { return entSteps[i].StepNumber < entSteps[j].StepNumber })
+	for _, entStep := range entSteps {
+		entDNSRecord := entStep.Edges.ProvisioningStepToDNSRecord
+		entProHost := entStep.Edges.ProvisioningStepToProvisionedHost
+		if entDNSRecord == nil || entProHost == nil || entDNSRecord.Disabled {
+			continue
+		}
+		teamNumber, exists := hostTeams[entProHost.ID]
+		if !exists {
+			continue
+		}
+		err = s.addDNSRecord(ctx, z, z.team(teamNumber), entDNS, entStep, entDNSRecord)
+		if err != nil {
+			logrus.Warnf("DNS %s: DNS record %s of team %d: %v", entDNS.HclID, entDNSRecord.HclID, teamNumber, err)
+		}
+	}
+	return z, nil
+}
+
+// viewSources are the prefixes the queries of a team's network come from. By default that is the address
+// the team is NATed to (the gateway_public_ip the builder allocates for it), which tells teams with the same
+// networks apart, and the network's own cidrs for the queries that aren't NATed. The view_cidrs config
+// (templated over ${team}) replaces them.
+func viewSources(entDNS *ent.DNS, entTeam *ent.Team, entProNetwork *ent.ProvisionedNetwork) []string {
+	cidrs := []string{entProNetwork.Cidr, entProNetwork.Ipv6Cidr}
+	if viewCidrs := entDNS.Config["view_cidrs"]; viewCidrs != "" {
+		cidrs = strings.Split(viewCidrs, ",")
+	} else if publicIP := net.ParseIP(entTeam.Vars["gateway_public_ip"]); publicIP != nil {
+		bits := 128
+		if publicIP.To4() != nil {
+			bits = 32
+		}
+		cidrs = append(cidrs, fmt.Sprintf("%s/%d", publicIP, bits))
+	}
+	sources := make([]string, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		if strings.TrimSpace(cidr) == "" {
+			continue
+		}
+		sources = append(sources, ipam.ForTeam(strings.TrimSpace(cidr), entTeam.TeamNumber))
+	}
+	return sources
+}
+
+// addDNSRecord adds the records of a completed DNS record step to the view of its team
+func (s *Server) addDNSRecord(ctx context.Context, z *zone, v *view, entDNS *ent.DNS, entStep *ent.ProvisioningStep, entDNSRecord *ent.DNSRecord) error {
+	rendered, err := s.renderDNSRecord(ctx, entStep, entDNSRecord)
+	if err != nil {
+		return err
+	}
+	ttl, err := planner.DNSRecordTTL(entDNS, entDNSRecord)
+	if err != nil {
+		return err
+	}
+	if ttl == 0 {
+		ttl = z.soa.Minttl
+	}
+	recordZone := strings.ToLower(dns.Fqdn(rendered.Zone))
+	if rendered.Zone == "" {
+		recordZone = z.origin
+	}
+	fqdn := recordZone
+	if rendered.Name != "" && rendered.Name != "@" {
+		fqdn = strings.ToLower(rendered.Name)
+		if !dns.IsFqdn(fqdn) {
+			fqdn += "." + recordZone
+		}
+	}
+	if !dns.IsSubDomain(z.origin, fqdn) {
+		return fmt.Errorf("%s is outside of %s", fqdn, z.origin)
+	}
+	name := strings.TrimSuffix(strings.TrimSuffix(fqdn, z.origin), ".")
+	for _, value := range rendered.Values {
+		err = v.add(z.origin, name, ttl, entDNSRecord.Type, value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renderDNSRecord renders a DNS record step once, the steps of a build don't change after planning
+func (s *Server) renderDNSRecord(ctx context.Context, entStep *ent.ProvisioningStep, entDNSRecord *ent.DNSRecord) (*planner.RenderedDNSRecord, error) {
+	rendered, exists := s.rendered[entStep.ID]
+	if !exists {
+		var err error
+		rendered, err = planner.RenderDNSRecord(ctx, entStep, entDNSRecord)
+		if err != nil {
+			return nil, err
+		}
+	}
+	s.nextRendered[entStep.ID] = rendered
+	return rendered, nil
+}
@@ -0,0 +1,268 @@
+package dnsserver
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/miekg/dns"
+)
+
+// teamLabel is the label that picks the view of a team in a name, ex. web.team3.comp.local
+var teamLabel = regexp.MustCompile(`^team(\d+)$`)
+
+// maxCNAMEChain bounds how many CNAMEs of a view are followed for a single answer
+const maxCNAMEChain = 8
+
+// zone is a root domain with the records of every team of the build it is served from
+type zone struct {
+	origin string
+	soa    *dns.SOA
+	ns     []dns.RR
+	teams  map[int]*view
+	// sources picks the view of a query without a team label by the address it comes from
+	sources []viewSource
+}
+
+// view is what a team sees of a zone, its names are relative to the zone's origin
+type view struct {
+	team    int
+	records map[string][]dns.RR
+}
+
+// viewSource is a prefix the queries of a team come from
+type viewSource struct {
+	prefix *net.IPNet
+	team   int
+}
+
+func newZone(origin string, ttl uint32, serial uint32, mname string) *zone {
+	origin = strings.ToLower(dns.Fqdn(origin))
+	if mname == "" {
+		mname = "ns." + origin
+	}
+	mname = strings.ToLower(dns.Fqdn(mname))
+	z := &zone{
+		origin: origin,
+		soa: &dns.SOA{
+			Hdr:     dns.RR_Header{Name: origin, Rrtype: dns.TypeSOA, Class: dns.ClassINET, Ttl: ttl},
+			Ns:      mname,
+			Mbox:    "hostmaster." + origin,
+			Serial:  serial,
+			Refresh: 3600,
+			Retry:   600,
+			Expire:  86400,
+			Minttl:  ttl,
+		},
+		ns: []dns.RR{
+			&dns.NS{Hdr: dns.RR_Header{Name: origin, Rrtype: dns.TypeNS, Class: dns.ClassINET, Ttl: ttl}, Ns: mname},
+		},
+		teams: map[int]*view{},
+	}
+	return z
+}
+
+// team returns the view of a team, creating it on first use
+func (z *zone) team(number int) *view {
+	v, exists := z.teams[number]
+	if !exists {
+		v = &view{team: number, records: map[string][]dns.RR{}}
+		z.teams[number] = v
+	}
+	return v
+}
+
+// addSource makes the queries coming from a prefix resolve in the view of a team
+func (z *zone) addSource(cidr string, team int) error {
+	_, prefix, err := net.ParseCIDR(strings.TrimSpace(cidr))
+	if err != nil {
+		return fmt.Errorf("invalid view prefix %s: %v", cidr, err)
+	}
+	z.sources = append(z.sources, viewSource{prefix: prefix, team: team})
+	return nil
+}
+
+// viewFor returns the view of the team the most specific prefix containing the source belongs to. Teams
+// with overlapping networks have no view unless they are told apart by more specific prefixes.
+func (z *zone) viewFor(source net.IP) *view {
+	best, bestOnes, ambiguous := -1, -1, false
+	for _, s := range z.sources {
+		if !s.prefix.Contains(source) {
+			continue
+		}
+		ones, _ := s.prefix.Mask.Size()
+		switch {
+		case ones > bestOnes:
+			best, bestOnes, ambiguous = s.team, ones, false
+		case ones == bestOnes && s.team != best:
+			ambiguous = true
+		}
+	}
+	if best < 0 || ambiguous {
+		return nil
+	}
+	return z.teams[best]
+}
+
+// add parses a record of a view, name is relative to the zone's origin ("" for the origin itself)
+func (v *view) add(origin string, name string, ttl uint32, rrType string, value string) error {
+	owner := origin
+	if name != "" {
+		owner = name + "." + origin
+	}
+	rr, err := dns.NewRR(fmt.Sprintf("%s %d IN %s %s", owner, ttl, strings.ToUpper(rrType), value))
+	if err != nil {
+		return fmt.Errorf("invalid %s record %s \"%s\": %v", rrType, owner, value, err)
+	}
+	if rr == nil {
+		return fmt.Errorf("invalid %s record %s: empty value", rrType, owner)
+	}
+	name = strings.ToLower(name)
+	for _, existing := range v.records[name] {
+		if dns.IsDuplicate(existing, rr) {
+			return nil
+		}
+	}
+	v.records[name] = append(v.records[name], rr)
+	return nil
+}
+
+// exists returns true if a name has records in the view or is an empty non-terminal of one that does
+func (v *view) exists(name string) bool {
+	if _, exists := v.records[name]; exists {
+		return true
+	}
+	for recordName := range v.records {
+		if name == "" || strings.HasSuffix(recordName, "."+name) {
+			return true
+		}
+	}
+	return false
+}
+
+// zoneSet is everything the server answers for, keyed by origin
+type zoneSet map[string]*zone
+
+// find returns the zone with the longest origin the name falls into
+func (zs zoneSet) find(name string) *zone {
+	var found *zone
+	for origin, z := range zs {
+		if dns.IsSubDomain(origin, name) && (found == nil || len(origin) > len(found.origin)) {
+			found = z
+		}
+	}
+	return found
+}
+
+// origins returns the origins of the zones sorted, for logging
+func (zs zoneSet) origins() []string {
+	origins := make([]string, 0, len(zs))
+	for origin := range zs {
+		origins = append(origins, origin)
+	}
+	sort.Strings(origins)
+	return origins
+}
+
+// answer builds the response to a query coming from source
+func (zs zoneSet) answer(req *dns.Msg, source net.IP) *dns.Msg {
+	resp := new(dns.Msg)
+	resp.SetReply(req)
+	if len(req.Question) != 1 || req.Opcode != dns.OpcodeQuery {
+		resp.Rcode = dns.RcodeRefused
+		return resp
+	}
+	question := req.Question[0]
+	// Answers keep the case of the question, resolvers randomizing it (0x20) check it
+	owner, qname := question.Name, strings.ToLower(question.Name)
+	z := zs.find(qname)
+	if z == nil {
+		resp.Rcode = dns.RcodeRefused
+		return resp
+	}
+	resp.Authoritative = true
+
+	for chain := 0; chain < maxCNAMEChain; chain++ {
+		rrs, rcode := z.lookup(qname, question.Qtype, source)
+		resp.Rcode = rcode
+		if len(rrs) == 0 {
+			break
+		}
+		for _, rr := range rrs {
+			answerRR := dns.Copy(rr)
+			answerRR.Header().Name = owner
+			resp.Answer = append(resp.Answer, answerRR)
+		}
+		cname, isCNAME := rrs[0].(*dns.CNAME)
+		if !isCNAME || question.Qtype == dns.TypeCNAME || !dns.IsSubDomain(z.origin, strings.ToLower(cname.Target)) {
+			return resp
+		}
+		owner, qname = cname.Target, strings.ToLower(cname.Target)
+	}
+	if len(resp.Answer) == 0 || resp.Rcode == dns.RcodeNameError {
+		resp.Ns = append(resp.Ns, z.soa)
+	}
+	return resp
+}
+
+// lookup finds the records of a name in the zone (their owner names are relative to the view they come
+// from, answer renames them), an empty answer with RcodeSuccess is a name without records of that type
+func (z *zone) lookup(qname string, qtype uint16, source net.IP) ([]dns.RR, int) {
+	name := strings.TrimSuffix(strings.TrimSuffix(qname, z.origin), ".")
+	if name == "" {
+		switch qtype {
+		case dns.TypeSOA:
+			return []dns.RR{z.soa}, dns.RcodeSuccess
+		case dns.TypeNS:
+			return z.ns, dns.RcodeSuccess
+		}
+	}
+
+	// A team label picks the view whatever the source is, ex. web.team3.comp.local
+	var v *view
+	labels := dns.SplitDomainName(name)
+	if len(labels) > 0 {
+		if match := teamLabel.FindStringSubmatch(labels[len(labels)-1]); match != nil {
+			number, _ := strconv.Atoi(match[1])
+			v = z.teams[number]
+			if v == nil {
+				return nil, dns.RcodeNameError
+			}
+			name = strings.Join(labels[:len(labels)-1], ".")
+		}
+	}
+	if v == nil {
+		v = z.viewFor(source)
+	}
+	if v == nil {
+		if name == "" {
+			return nil, dns.RcodeSuccess
+		}
+		return nil, dns.RcodeNameError
+	}
+
+	rrs := v.records[name]
+	if len(rrs) == 0 {
+		if v.exists(name) {
+			return nil, dns.RcodeSuccess
+		}
+		return nil, dns.RcodeNameError
+	}
+	var matching []dns.RR
+	for _, rr := range rrs {
+		if rr.Header().Rrtype == qtype || qtype == dns.TypeANY {
+			matching = append(matching, rr)
+		}
+	}
+	if len(matching) == 0 {
+		for _, rr := range rrs {
+			if rr.Header().Rrtype == dns.TypeCNAME {
+				return []dns.RR{rr}, dns.RcodeSuccess
+			}
+		}
+	}
+	return matching, dns.RcodeSuccess
+}
@@ -0,0 +1,358 @@
+package dnsserver
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gen0cide/laforge/ent"
+	"github.com/miekg/dns"
+)
+
+// testZone is comp.local with two teams, each one querying from its own /16 and both from 192.168.0.0/24
+func testZone(t *testing.T) *zone {
+	z := newZone("comp.local", 300, 1, "")
+	for team, cidrs := range map[int][]string{
+		1: {"10.1.0.0/16", "192.168.0.0/24", "fd00:1::/64"},
+		2: {"10.2.0.0/16", "192.168.0.0/24"},
+	} {
+		for _, cidr := range cidrs {
+			if err := z.addSource(cidr, team); err != nil {
+				t.Fatalf("addSource(%q) error = %v", cidr, err)
+			}
+		}
+	}
+	records := []struct {
+		team   int
+		name   string
+		rrType string
+		value  string
+	}{
+		{1, "web", "A", "10.1.0.10"},
+		{1, "web", "AAAA", "fd00:1::10"},
+		{1, "www", "CNAME", "web.comp.local."},
+		{1, "portal", "CNAME", "www.comp.local."},
+		{1, "ext", "CNAME", "example.com."},
+		{1, "db.internal", "A", "10.1.0.20"},
+		{1, "", "TXT", "\"team one\""},
+		{2, "web", "A", "10.2.0.10"},
+	}
+	for _, r := range records {
+		if err := z.team(r.team).add(z.origin, r.name, 300, r.rrType, r.value); err != nil {
+			t.Fatalf("add(%q, %s %s) error = %v", r.name, r.rrType, r.value, err)
+		}
+	}
+	return z
+}
+
+// rrStrings renders records as "<name> <type> <value>"
+func rrStrings(rrs []dns.RR) []string {
+	rendered := []string{}
+	for _, rr := range rrs {
+		value := strings.TrimPrefix(rr.String(), rr.Header().String())
+		rendered = append(rendered, rr.Header().Name+" "+dns.TypeToString[rr.Header().Rrtype]+" "+value)
+	}
+	return rendered
+}
+
+func TestZoneSetFind(t *testing.T) {
+	zs := zoneSet{}
+	for _, origin := range []string{"comp.local", "sub.comp.local", "other.local"} {
+		z := newZone(origin, 300, 1, "")
+		zs[z.origin] = z
+	}
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"comp.local.", "comp.local."},
+		{"web.comp.local.", "comp.local."},
+		{"sub.comp.local.", "sub.comp.local."},
+		{"web.sub.comp.local.", "sub.comp.local."},
+		{"web.team2.sub.comp.local.", "sub.comp.local."},
+		{"web.other.local.", "other.local."},
+		{"notcomp.local.", ""},
+		{"local.", ""},
+		{"example.com.", ""},
+	}
+	for _, tt := range tests {
+		got := ""
+		if z := zs.find(tt.name); z != nil {
+			got = z.origin
+		}
+		if got != tt.want {
+			t.Errorf("find(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestZoneViewFor(t *testing.T) {
+	z := testZone(t)
+	if err := z.addSource("10.1.5.0/24", 2); err != nil {
+		t.Fatalf("addSource error = %v", err)
+	}
+	tests := []struct {
+		source string
+		// want is the team of the view, 0 when there is none
+		want int
+	}{
+		{"10.1.0.5", 1},
+		{"10.2.0.5", 2},
+		{"fd00:1::5", 1},
+		// The most specific prefix wins
+		{"10.1.5.5", 2},
+		// Both teams query from there
+		{"192.168.0.5", 0},
+		{"172.16.0.5", 0},
+	}
+	for _, tt := range tests {
+		got := 0
+		if v := z.viewFor(net.ParseIP(tt.source)); v != nil {
+			got = v.team
+		}
+		if got != tt.want {
+			t.Errorf("viewFor(%s) = team %d, want team %d", tt.source, got, tt.want)
+		}
+	}
+	if v := z.viewFor(nil); v != nil {
+		t.Errorf("viewFor(nil) = team %d, want no view", v.team)
+	}
+}
+
+func TestZoneAddSourceInvalid(t *testing.T) {
+	z := newZone("comp.local", 300, 1, "")
+	for _, cidr := range []string{"10.1.0.0", "10.1.0.0/33", ""} {
+		if err := z.addSource(cidr, 1); err == nil {
+			t.Errorf("addSource(%q) error = nil, want an error", cidr)
+		}
+	}
+}
+
+func TestZoneSetAnswer(t *testing.T) {
+	z := testZone(t)
+	zs := zoneSet{z.origin: z}
+	tests := []struct {
+		name   string
+		qname  string
+		qtype  uint16
+		source string
+		rcode  int
+		answer []string
+		// soa is true when the SOA of the zone is expected in the authority section
+		soa bool
+	}{
+		{
+			name: "team 1 by source", qname: "web.comp.local.", qtype: dns.TypeA, source: "10.1.0.5",
+			answer: []string{"web.comp.local. A 10.1.0.10"},
+		},
+		{
+			name: "team 2 by source", qname: "web.comp.local.", qtype: dns.TypeA, source: "10.2.0.5",
+			answer: []string{"web.comp.local. A 10.2.0.10"},
+		},
+		{
+			name: "team label", qname: "web.team2.comp.local.", qtype: dns.TypeA, source: "10.1.0.5",
+			answer: []string{"web.team2.comp.local. A 10.2.0.10"},
+		},
+		{
+			name: "team label without a source", qname: "web.team1.comp.local.", qtype: dns.TypeAAAA,
+			answer: []string{"web.team1.comp.local. AAAA fd00:1::10"},
+		},
+		{
+			name: "case of the question is kept", qname: "WeB.CoMp.LoCaL.", qtype: dns.TypeA, source: "10.1.0.5",
+			answer: []string{"WeB.CoMp.LoCaL. A 10.1.0.10"},
+		},
+		{
+			name: "no view for the source", qname: "web.comp.local.", qtype: dns.TypeA, source: "192.168.0.5",
+			rcode: dns.RcodeNameError, soa: true,
+		},
+		{
+			name: "unknown team", qname: "web.team9.comp.local.", qtype: dns.TypeA, source: "10.1.0.5",
+			rcode: dns.RcodeNameError, soa: true,
+		},
+		{
+			name: "name of another team only", qname: "www.comp.local.", qtype: dns.TypeA, source: "10.2.0.5",
+			rcode: dns.RcodeNameError, soa: true,
+		},
+		{
+			name: "no record of the type", qname: "web.comp.local.", qtype: dns.TypeMX, source: "10.2.0.5",
+			soa: true,
+		},
+		{
+			name: "empty non-terminal", qname: "internal.comp.local.", qtype: dns.TypeA, source: "10.1.0.5",
+			soa: true,
+		},
+		{
+			name: "below an empty non-terminal", qname: "db.internal.comp.local.", qtype: dns.TypeA, source: "10.1.0.5",
+			answer: []string{"db.internal.comp.local. A 10.1.0.20"},
+		},
+		{
+			name: "cname chain", qname: "portal.comp.local.", qtype: dns.TypeA, source: "10.1.0.5",
+			answer: []string{
+				"portal.comp.local. CNAME www.comp.local.",
+				"www.comp.local. CNAME web.comp.local.",
+				"web.comp.local. A 10.1.0.10",
+			},
+		},
+		{
+			name: "cname asked for", qname: "www.comp.local.", qtype: dns.TypeCNAME, source: "10.1.0.5",
+			answer: []string{"www.comp.local. CNAME web.comp.local."},
+		},
+		{
+			name: "cname out of the zone", qname: "ext.comp.local.", qtype: dns.TypeA, source: "10.1.0.5",
+			answer: []string{"ext.comp.local. CNAME example.com."},
+		},
+		{
+			name: "any", qname: "web.comp.local.", qtype: dns.TypeANY, source: "10.1.0.5",
+			answer: []string{"web.comp.local. A 10.1.0.10", "web.comp.local. AAAA fd00:1::10"},
+		},
+		{
+			name: "origin soa", qname: "comp.local.", qtype: dns.TypeSOA, source: "192.168.0.5",
+			answer: []string{"comp.local. SOA ns.comp.local. hostmaster.comp.local. 1 3600 600 86400 300"},
+		},
+		{
+			name: "origin ns", qname: "comp.local.", qtype: dns.TypeNS,
+			answer: []string{"comp.local. NS ns.comp.local."},
+		},
+		{
+			name: "origin of a view", qname: "comp.local.", qtype: dns.TypeTXT, source: "10.1.0.5",
+			answer: []string{"comp.local. TXT \"team one\""},
+		},
+		{
+			name: "origin without a view", qname: "comp.local.", qtype: dns.TypeTXT, source: "192.168.0.5",
+			soa: true,
+		},
+		{
+			name: "outside of the zones", qname: "web.example.com.", qtype: dns.TypeA, source: "10.1.0.5",
+			rcode: dns.RcodeRefused,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := new(dns.Msg)
+			req.SetQuestion(tt.qname, tt.qtype)
+			resp := zs.answer(req, net.ParseIP(tt.source))
+			if resp.Rcode != tt.rcode {
+				t.Errorf("answer(%s %s) rcode = %s, want %s", tt.qname, dns.TypeToString[tt.qtype], dns.RcodeToString[resp.Rcode], dns.RcodeToString[tt.rcode])
+			}
+			want := tt.answer
+			if want == nil {
+				want = []string{}
+			}
+			if got := rrStrings(resp.Answer); !reflect.DeepEqual(got, want) {
+				t.Errorf("answer(%s %s) = %q, want %q", tt.qname, dns.TypeToString[tt.qtype], got, want)
+			}
+			gotSOA := len(resp.Ns) == 1 && resp.Ns[0] == z.soa
+			if gotSOA != tt.soa {
+				t.Errorf("answer(%s %s) authority = %v, want SOA %v", tt.qname, dns.TypeToString[tt.qtype], resp.Ns, tt.soa)
+			}
+			if tt.rcode != dns.RcodeRefused && !resp.Authoritative {
+				t.Errorf("answer(%s %s) isn't authoritative", tt.qname, dns.TypeToString[tt.qtype])
+			}
+		})
+	}
+}
+
+func TestZoneSetAnswerRefused(t *testing.T) {
+	z := testZone(t)
+	zs := zoneSet{z.origin: z}
+	req := new(dns.Msg)
+	req.SetQuestion("web.comp.local.", dns.TypeA)
+	req.Question = append(req.Question, req.Question[0])
+	if resp := zs.answer(req, net.ParseIP("10.1.0.5")); resp.Rcode != dns.RcodeRefused {
+		t.Errorf("answer() of two questions rcode = %s, want REFUSED", dns.RcodeToString[resp.Rcode])
+	}
+	req = new(dns.Msg)
+	req.SetNotify("comp.local.")
+	if resp := zs.answer(req, net.ParseIP("10.1.0.5")); resp.Rcode != dns.RcodeRefused {
+		t.Errorf("answer() of a notify rcode = %s, want REFUSED", dns.RcodeToString[resp.Rcode])
+	}
+}
+
+func TestViewSources(t *testing.T) {
+	proNetwork := &ent.ProvisionedNetwork{Cidr: "10.0.10.0/24", Ipv6Cidr: "fd00:10::/64"}
+	tests := []struct {
+		name   string
+		config map[string]string
+		vars   map[string]string
+		want   []string
+	}{
+		{
+			name: "network cidrs",
+			want: []string{"10.0.10.0/24", "fd00:10::/64"},
+		},
+		{
+			name: "gateway public ip",
+			vars: map[string]string{"gateway_public_ip": "203.0.113.7"},
+			want: []string{"10.0.10.0/24", "fd00:10::/64", "203.0.113.7/32"},
+		},
+		{
+			name: "ipv6 gateway public ip",
+			vars: map[string]string{"gateway_public_ip": "2001:db8::7"},
+			want: []string{"10.0.10.0/24", "fd00:10::/64", "2001:db8::7/128"},
+		},
+		{
+			name: "invalid gateway public ip",
+			vars: map[string]string{"gateway_public_ip": "pending"},
+			want: []string{"10.0.10.0/24", "fd00:10::/64"},
+		},
+		{
+			name:   "view_cidrs override",
+			config: map[string]string{"view_cidrs": "100.64.${team}.0/24, 100.65.${team}.0/24"},
+			vars:   map[string]string{"gateway_public_ip": "203.0.113.7"},
+			want:   []string{"100.64.3.0/24", "100.65.3.0/24"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entDNS := &ent.DNS{HclID: "dns", Config: tt.config}
+			entTeam := &ent.Team{TeamNumber: 3, Vars: tt.vars}
+			if got := viewSources(entDNS, entTeam, proNetwork); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("viewSources() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestZoneSetAnswerSameNetworks(t *testing.T) {
+	// Both teams have the same network, only the address they are NATed to tells them apart
+	z := newZone("comp.local", 300, 1, "")
+	proNetwork := &ent.ProvisionedNetwork{Cidr: "10.0.10.0/24"}
+	for team, publicIP := range map[int]string{1: "203.0.113.1", 2: "203.0.113.2"} {
+		entTeam := &ent.Team{TeamNumber: team, Vars: map[string]string{"gateway_public_ip": publicIP}}
+		for _, cidr := range viewSources(&ent.DNS{}, entTeam, proNetwork) {
+			if err := z.addSource(cidr, team); err != nil {
+				t.Fatalf("addSource(%q) error = %v", cidr, err)
+			}
+		}
+		if err := z.team(team).add(z.origin, "web", 300, "A", "10.0.10.5"); err != nil {
+			t.Fatalf("add() error = %v", err)
+		}
+		if err := z.team(team).add(z.origin, "web", 300, "TXT", fmt.Sprintf("\"team %d\"", team)); err != nil {
+			t.Fatalf("add() error = %v", err)
+		}
+	}
+	zs := zoneSet{z.origin: z}
+	tests := []struct {
+		source string
+		rcode  int
+		want   []string
+	}{
+		{"203.0.113.1", dns.RcodeSuccess, []string{"web.comp.local. TXT \"team 1\""}},
+		{"203.0.113.2", dns.RcodeSuccess, []string{"web.comp.local. TXT \"team 2\""}},
+		// Not NATed, the network is the same for both teams
+		{"10.0.10.9", dns.RcodeNameError, []string{}},
+	}
+	for _, tt := range tests {
+		req := new(dns.Msg)
+		req.SetQuestion("web.comp.local.", dns.TypeTXT)
+		resp := zs.answer(req, net.ParseIP(tt.source))
+		if resp.Rcode != tt.rcode {
+			t.Errorf("answer() from %s rcode = %s, want %s", tt.source, dns.RcodeToString[resp.Rcode], dns.RcodeToString[tt.rcode])
+		}
+		if got := rrStrings(resp.Answer); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("answer() from %s = %q, want %q", tt.source, got, tt.want)
+		}
+	}
+}
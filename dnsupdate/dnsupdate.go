@@ -0,0 +1,148 @@
+// Package dnsupdate pushes DNS records to a DNS server with RFC 2136 dynamic updates, signed with
+// TSIG (RFC 8945) when the server has a key configured.
+package dnsupdate
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+const (
+	// DefaultTTL is the TTL of the records when none is set
+	DefaultTTL = 300
+	// DefaultTimeout bounds a single update when the server doesn't set a timeout
+	DefaultTimeout = 10 * time.Second
+)
+
+// Server is a DNS server accepting dynamic updates for the zones records are pushed to
+type Server struct {
+	// Address is the host (and optionally the port, 53 when not set) of the server
+	Address string
+	// TSIGKeyName is the name of the key updates are signed with, updates aren't signed when it is empty
+	TSIGKeyName string
+	// TSIGSecret is the base64 encoded secret of the key
+	TSIGSecret string
+	// TSIGAlgorithm is the algorithm of the key (ex. "hmac-sha256"), HMAC-SHA256 when it is empty
+	TSIGAlgorithm string
+	// Timeout bounds a single update
+	Timeout time.Duration
+}
+
+// Record is an RRset, every value of it is a record of the same name and type
+type Record struct {
+	// Name is either relative to the zone or a fully qualified name ending with a dot
+	Name string
+	// Type is the record type (ex. "A" or "CNAME")
+	Type string
+	// Values are the record data in zone file format (ex. "10.0.1.5" for an A record)
+	Values []string
+	// TTL of the records, DefaultTTL when it isn't set
+	TTL uint32
+}
+
+// Upsert replaces the RRset of the record in a zone with the record's values
+func Upsert(ctx context.Context, server Server, zone string, record Record) error {
+	zone = dns.Fqdn(zone)
+	rrs, err := record.rrs(zone)
+	if err != nil {
+		return err
+	}
+	msg := new(dns.Msg)
+	msg.SetUpdate(zone)
+	msg.RemoveRRset([]dns.RR{rrsetHeader(record.fqdn(zone), rrs[0].Header().Rrtype)})
+	msg.Insert(rrs)
+	return exchange(ctx, server, msg)
+}
+
+// Remove deletes the RRset of the record from a zone, removing a record that isn't there is not an error
+func Remove(ctx context.Context, server Server, zone string, record Record) error {
+	zone = dns.Fqdn(zone)
+	rrType, exists := dns.StringToType[strings.ToUpper(record.Type)]
+	if !exists {
+		return fmt.Errorf("unknown record type %s", record.Type)
+	}
+	msg := new(dns.Msg)
+	msg.SetUpdate(zone)
+	msg.RemoveRRset([]dns.RR{rrsetHeader(record.fqdn(zone), rrType)})
+	return exchange(ctx, server, msg)
+}
+
+// fqdn is the fully qualified name of the record in a zone
+func (r Record) fqdn(zone string) string {
+	if r.Name == "" || r.Name == "@" {
+		return zone
+	}
+	if dns.IsFqdn(r.Name) {
+		return r.Name
+	}
+	return r.Name + "." + zone
+}
+
+// rrs parses the values of the record into resource records
+func (r Record) rrs(zone string) ([]dns.RR, error) {
+	if len(r.Values) == 0 {
+		return nil, fmt.Errorf("record %s %s has no values", r.Name, r.Type)
+	}
+	ttl := r.TTL
+	if ttl == 0 {
+		ttl = DefaultTTL
+	}
+	name := r.fqdn(zone)
+	if !dns.IsSubDomain(zone, name) {
+		return nil, fmt.Errorf("record %s is outside of zone %s", name, zone)
+	}
+	rrs := make([]dns.RR, 0, len(r.Values))
+	for _, value := range r.Values {
+		rr, err := dns.NewRR(fmt.Sprintf("%s %d IN %s %s", name, ttl, strings.ToUpper(r.Type), value))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s record %s \"%s\": %v", r.Type, name, value, err)
+		}
+		if rr == nil {
+			return nil, fmt.Errorf("invalid %s record %s: empty value", r.Type, name)
+		}
+		rrs = append(rrs, rr)
+	}
+	return rrs, nil
+}
+
+// rrsetHeader is the class ANY record RemoveRRset turns into the deletion of a whole RRset
+func rrsetHeader(name string, rrType uint16) dns.RR {
+	return &dns.ANY{Hdr: dns.RR_Header{Name: name, Rrtype: rrType, Class: dns.ClassINET}}
+}
+
+// exchange sends an update to the server and checks that the server applied it
+func exchange(ctx context.Context, server Server, msg *dns.Msg) error {
+	address := server.Address
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(address, "53")
+	}
+	timeout := server.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	// Updates can outgrow a UDP response, TCP is what most servers expect them over anyway
+	client := &dns.Client{Net: "tcp", Timeout: timeout}
+	if server.TSIGKeyName != "" {
+		keyName := dns.Fqdn(server.TSIGKeyName)
+		algorithm := dns.HmacSHA256
+		if server.TSIGAlgorithm != "" {
+			algorithm = dns.Fqdn(strings.ToLower(server.TSIGAlgorithm))
+		}
+		client.TsigSecret = map[string]string{keyName: server.TSIGSecret}
+		msg.SetTsig(keyName, algorithm, 300, time.Now().Unix())
+	}
+
+	resp, _, err := client.ExchangeContext(ctx, msg, address)
+	if err != nil {
+		return fmt.Errorf("error sending update to %s: %v", address, err)
+	}
+	if resp.Rcode != dns.RcodeSuccess {
+		return fmt.Errorf("%s refused the update of zone %s: %s", address, msg.Question[0].Name, dns.RcodeToString[resp.Rcode])
+	}
+	return nil
+}
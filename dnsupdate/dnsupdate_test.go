@@ -0,0 +1,235 @@
+package dnsupdate
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+const (
+	testKeyName = "laforge."
+	// base64 of "laforge test secret"
+	testSecret = "bGFmb3JnZSB0ZXN0IHNlY3JldA=="
+)
+
+// updateServer is a DNS server applying the dynamic updates it gets to the RRsets of a single zone
+type updateServer struct {
+	zone string
+	// tsig is set when updates have to be signed with testKeyName
+	tsig bool
+
+	mu     sync.Mutex
+	rrsets map[string][]string
+}
+
+func (s *updateServer) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	resp := new(dns.Msg)
+	resp.SetReply(req)
+	defer func() {
+		if req.IsTsig() != nil {
+			resp.SetTsig(testKeyName, dns.HmacSHA256, 300, time.Now().Unix())
+		}
+		w.WriteMsg(resp)
+	}()
+	if s.tsig && (req.IsTsig() == nil || w.TsigStatus() != nil) {
+		resp.Rcode = dns.RcodeNotAuth
+		return
+	}
+	if req.Opcode != dns.OpcodeUpdate || len(req.Question) != 1 || req.Question[0].Name != s.zone {
+		resp.Rcode = dns.RcodeNotZone
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, rr := range req.Ns {
+		hdr := rr.Header()
+		key := strings.ToLower(hdr.Name) + " " + dns.TypeToString[hdr.Rrtype]
+		switch hdr.Class {
+		case dns.ClassANY:
+			delete(s.rrsets, key)
+		case dns.ClassINET:
+			s.rrsets[key] = append(s.rrsets[key], strings.TrimPrefix(rr.String(), hdr.String()))
+		default:
+			resp.Rcode = dns.RcodeFormatError
+			return
+		}
+	}
+}
+
+// snapshot returns the zone's RRsets as "<name> <type>" -> sorted values
+func (s *updateServer) snapshot() map[string][]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rrsets := map[string][]string{}
+	for key, values := range s.rrsets {
+		sorted := append([]string{}, values...)
+		sort.Strings(sorted)
+		rrsets[key] = sorted
+	}
+	return rrsets
+}
+
+// startUpdateServer serves a zone over TCP on a local port and returns its address
+func startUpdateServer(t *testing.T, s *updateServer) string {
+	s.rrsets = map[string][]string{}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("error listening: %v", err)
+	}
+	started := make(chan struct{})
+	server := &dns.Server{
+		Listener:          listener,
+		Handler:           s,
+		TsigSecret:        map[string]string{testKeyName: testSecret},
+		NotifyStartedFunc: func() { close(started) },
+		// The default only accepts queries
+		MsgAcceptFunc: func(dh dns.Header) dns.MsgAcceptAction { return dns.MsgAccept },
+	}
+	go server.ActivateAndServe()
+	<-started
+	t.Cleanup(func() { server.Shutdown() })
+	return listener.Addr().String()
+}
+
+func TestUpsertRemove(t *testing.T) {
+	for _, tsig := range []bool{false, true} {
+		name := "unsigned"
+		if tsig {
+			name = "tsig"
+		}
+		t.Run(name, func(t *testing.T) {
+			s := &updateServer{zone: "comp.local.", tsig: tsig}
+			server := Server{Address: startUpdateServer(t, s), Timeout: 5 * time.Second}
+			if tsig {
+				server.TSIGKeyName = "laforge"
+				server.TSIGSecret = testSecret
+			}
+			ctx := context.Background()
+			steps := []struct {
+				name   string
+				remove bool
+				record Record
+				want   map[string][]string
+			}{
+				{
+					name:   "insert",
+					record: Record{Name: "web", Type: "A", Values: []string{"10.0.1.5", "10.0.1.6"}},
+					want:   map[string][]string{"web.comp.local. A": {"10.0.1.5", "10.0.1.6"}},
+				},
+				{
+					name:   "replace",
+					record: Record{Name: "web", Type: "a", Values: []string{"10.0.1.7"}, TTL: 60},
+					want:   map[string][]string{"web.comp.local. A": {"10.0.1.7"}},
+				},
+				{
+					name:   "fully qualified name",
+					record: Record{Name: "www.comp.local.", Type: "CNAME", Values: []string{"web.comp.local."}},
+					want: map[string][]string{
+						"web.comp.local. A":     {"10.0.1.7"},
+						"www.comp.local. CNAME": {"web.comp.local."},
+					},
+				},
+				{
+					name:   "zone origin",
+					record: Record{Name: "@", Type: "TXT", Values: []string{"\"laforge\""}},
+					want: map[string][]string{
+						"comp.local. TXT":       {"\"laforge\""},
+						"web.comp.local. A":     {"10.0.1.7"},
+						"www.comp.local. CNAME": {"web.comp.local."},
+					},
+				},
+				{
+					name:   "remove",
+					remove: true,
+					record: Record{Name: "web", Type: "A"},
+					want: map[string][]string{
+						"comp.local. TXT":       {"\"laforge\""},
+						"www.comp.local. CNAME": {"web.comp.local."},
+					},
+				},
+				{
+					name:   "remove missing",
+					remove: true,
+					record: Record{Name: "web", Type: "A"},
+					want: map[string][]string{
+						"comp.local. TXT":       {"\"laforge\""},
+						"www.comp.local. CNAME": {"web.comp.local."},
+					},
+				},
+			}
+			for _, step := range steps {
+				var err error
+				if step.remove {
+					err = Remove(ctx, server, "comp.local", step.record)
+				} else {
+					err = Upsert(ctx, server, "comp.local", step.record)
+				}
+				if err != nil {
+					t.Fatalf("%s: error = %v", step.name, err)
+				}
+				if got := s.snapshot(); !reflect.DeepEqual(got, step.want) {
+					t.Fatalf("%s: zone = %v, want %v", step.name, got, step.want)
+				}
+			}
+		})
+	}
+}
+
+func TestUpsertRefused(t *testing.T) {
+	s := &updateServer{zone: "comp.local.", tsig: true}
+	address := startUpdateServer(t, s)
+	record := Record{Name: "web", Type: "A", Values: []string{"10.0.1.5"}}
+	tests := []struct {
+		name    string
+		server  Server
+		zone    string
+		wantErr string
+	}{
+		{"unsigned", Server{Address: address}, "comp.local", "NOTAUTH"},
+		{"wrong secret", Server{Address: address, TSIGKeyName: "laforge", TSIGSecret: "d3Jvbmc="}, "comp.local", ""},
+		{"other zone", Server{Address: address, TSIGKeyName: "laforge", TSIGSecret: testSecret}, "other.local", "NOTZONE"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.server.Timeout = 5 * time.Second
+			err := Upsert(context.Background(), tt.server, tt.zone, record)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Upsert() error = %v, want the update to be refused (%s)", err, tt.wantErr)
+			}
+			if got := s.snapshot(); len(got) != 0 {
+				t.Errorf("zone = %v, want no records", got)
+			}
+		})
+	}
+}
+
+func TestUpsertInvalidRecord(t *testing.T) {
+	// Nothing listens there, invalid records have to fail before anything is sent
+	server := Server{Address: "127.0.0.1:1", Timeout: time.Second}
+	tests := []struct {
+		name   string
+		record Record
+	}{
+		{"no values", Record{Name: "web", Type: "A"}},
+		{"invalid value", Record{Name: "web", Type: "A", Values: []string{"not-an-ip"}}},
+		{"unknown type", Record{Name: "web", Type: "NOPE", Values: []string{"10.0.1.5"}}},
+		{"outside of zone", Record{Name: "web.other.local.", Type: "A", Values: []string{"10.0.1.5"}}},
+	}
+	for _, tt := range tests {
+		err := Upsert(context.Background(), server, "comp.local", tt.record)
+		if err == nil || strings.Contains(err.Error(), "error sending update") {
+			t.Errorf("%s: Upsert() error = %v, want a record error", tt.name, err)
+		}
+	}
+	err := Remove(context.Background(), server, "comp.local", Record{Name: "web", Type: "NOPE"})
+	if err == nil || strings.Contains(err.Error(), "error sending update") {
+		t.Errorf("Remove() error = %v, want a record error", err)
+	}
+}
@@ -24,6 +24,10 @@ type AgentTask struct {
 	Args string `json:"args,omitempty"`
 	// Number holds the value of the "number" field.
 	Number int `json:"number,omitempty"`
+	// Timeout holds the value of the "timeout" field.
+	Timeout int `json:"timeout,omitempty"`
+	// Attempt holds the value of the "attempt" field.
+	Attempt int `json:"attempt,omitempty"`
 	// Output holds the value of the "output" field.
 	Output string `json:"output,omitempty"`
 	// State holds the value of the "state" field.
@@ -103,6 +107,10 @@ func (*AgentTask) scanValues(columns []string) ([]interface{}, error) {
 		switch columns[i] {
 		case agenttask.FieldNumber:
 			values[i] = new(sql.NullInt64)
+		case agenttask.FieldTimeout:
+			values[i] = new(sql.NullInt64)
+		case agenttask.FieldAttempt:
+			values[i] = new(sql.NullInt64)
 		case agenttask.FieldCommand, agenttask.FieldArgs, agenttask.FieldOutput, agenttask.FieldState, agenttask.FieldErrorMessage:
 			values[i] = new(sql.NullString)
 		case agenttask.FieldID:
@@ -150,6 +158,18 @@ func (at *AgentTask) assignValues(columns []string, values []interface{}) error
 			} else if value.Valid {
 				at.Number = int(value.Int64)
 			}
+		case agenttask.FieldTimeout:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field timeout", values[i])
+			} else if value.Valid {
+				at.Timeout = int(value.Int64)
+			}
+		case agenttask.FieldAttempt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempt", values[i])
+			} else if value.Valid {
+				at.Attempt = int(value.Int64)
+			}
 		case agenttask.FieldOutput:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field output", values[i])
@@ -231,6 +251,10 @@ func (at *AgentTask) String() string {
 	builder.WriteString(at.Args)
 	builder.WriteString(", number=")
 	builder.WriteString(fmt.Sprintf("%v", at.Number))
+	builder.WriteString(", timeout=")
+	builder.WriteString(fmt.Sprintf("%v", at.Timeout))
+	builder.WriteString(", attempt=")
+	builder.WriteString(fmt.Sprintf("%v", at.Attempt))
 	builder.WriteString(", output=")
 	builder.WriteString(at.Output)
 	builder.WriteString(", state=")
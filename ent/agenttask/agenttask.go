@@ -21,6 +21,10 @@ const (
 	FieldArgs = "args"
 	// FieldNumber holds the string denoting the number field in the database.
 	FieldNumber = "number"
+	// FieldTimeout holds the string denoting the timeout field in the database.
+	FieldTimeout = "timeout"
+	// FieldAttempt holds the string denoting the attempt field in the database.
+	FieldAttempt = "attempt"
 	// FieldOutput holds the string denoting the output field in the database.
 	FieldOutput = "output"
 	// FieldState holds the string denoting the state field in the database.
@@ -64,6 +68,8 @@ var Columns = []string{
 	FieldCommand,
 	FieldArgs,
 	FieldNumber,
+	FieldTimeout,
+	FieldAttempt,
 	FieldOutput,
 	FieldState,
 	FieldErrorMessage,
@@ -92,6 +98,10 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultTimeout holds the default value on creation for the "timeout" field.
+	DefaultTimeout int
+	// DefaultAttempt holds the default value on creation for the "attempt" field.
+	DefaultAttempt int
 	// DefaultOutput holds the default value on creation for the "output" field.
 	DefaultOutput string
 	// DefaultErrorMessage holds the default value on creation for the "error_message" field.
@@ -142,6 +152,7 @@ const (
 	StateINPROGRESS State = "INPROGRESS"
 	StateFAILED     State = "FAILED"
 	StateCOMPLETE   State = "COMPLETE"
+	StateCANCELLED  State = "CANCELLED"
 )
 
 func (s State) String() string {
@@ -151,7 +162,7 @@ func (s State) String() string {
 // StateValidator is a validator for the "state" field enum values. It is called by the builders before save.
 func StateValidator(s State) error {
 	switch s {
-	case StateAWAITING, StateINPROGRESS, StateFAILED, StateCOMPLETE:
+	case StateAWAITING, StateINPROGRESS, StateFAILED, StateCOMPLETE, StateCANCELLED:
 		return nil
 	default:
 		return fmt.Errorf("agenttask: invalid enum value for state field: %q", s)
@@ -106,6 +106,20 @@ func Number(v int) predicate.AgentTask {
 	})
 }
 
+// Timeout applies equality check predicate on the "timeout" field. It's identical to TimeoutEQ.
+func Timeout(v int) predicate.AgentTask {
+	return predicate.AgentTask(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTimeout), v))
+	})
+}
+
+// Attempt applies equality check predicate on the "attempt" field. It's identical to AttemptEQ.
+func Attempt(v int) predicate.AgentTask {
+	return predicate.AgentTask(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAttempt), v))
+	})
+}
+
 // Output applies equality check predicate on the "output" field. It's identical to OutputEQ.
 func Output(v string) predicate.AgentTask {
 	return predicate.AgentTask(func(s *sql.Selector) {
@@ -355,6 +369,158 @@ func NumberLTE(v int) predicate.AgentTask {
 	})
 }
 
+// TimeoutEQ applies the EQ predicate on the "timeout" field.
+func TimeoutEQ(v int) predicate.AgentTask {
+	return predicate.AgentTask(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTimeout), v))
+	})
+}
+
+// TimeoutNEQ applies the NEQ predicate on the "timeout" field.
+func TimeoutNEQ(v int) predicate.AgentTask {
+	return predicate.AgentTask(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTimeout), v))
+	})
+}
+
+// TimeoutIn applies the In predicate on the "timeout" field.
+func TimeoutIn(vs ...int) predicate.AgentTask {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AgentTask(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTimeout), v...))
+	})
+}
+
+// TimeoutNotIn applies the NotIn predicate on the "timeout" field.
+func TimeoutNotIn(vs ...int) predicate.AgentTask {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AgentTask(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTimeout), v...))
+	})
+}
+
+// TimeoutGT applies the GT predicate on the "timeout" field.
+func TimeoutGT(v int) predicate.AgentTask {
+	return predicate.AgentTask(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTimeout), v))
+	})
+}
+
+// TimeoutGTE applies the GTE predicate on the "timeout" field.
+func TimeoutGTE(v int) predicate.AgentTask {
+	return predicate.AgentTask(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTimeout), v))
+	})
+}
+
+// TimeoutLT applies the LT predicate on the "timeout" field.
+func TimeoutLT(v int) predicate.AgentTask {
+	return predicate.AgentTask(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTimeout), v))
+	})
+}
+
+// TimeoutLTE applies the LTE predicate on the "timeout" field.
+func TimeoutLTE(v int) predicate.AgentTask {
+	return predicate.AgentTask(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTimeout), v))
+	})
+}
+
+// AttemptEQ applies the EQ predicate on the "attempt" field.
+func AttemptEQ(v int) predicate.AgentTask {
+	return predicate.AgentTask(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAttempt), v))
+	})
+}
+
+// AttemptNEQ applies the NEQ predicate on the "attempt" field.
+func AttemptNEQ(v int) predicate.AgentTask {
+	return predicate.AgentTask(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAttempt), v))
+	})
+}
+
+// AttemptIn applies the In predicate on the "attempt" field.
+func AttemptIn(vs ...int) predicate.AgentTask {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AgentTask(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAttempt), v...))
+	})
+}
+
+// AttemptNotIn applies the NotIn predicate on the "attempt" field.
+func AttemptNotIn(vs ...int) predicate.AgentTask {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.AgentTask(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAttempt), v...))
+	})
+}
+
+// AttemptGT applies the GT predicate on the "attempt" field.
+func AttemptGT(v int) predicate.AgentTask {
+	return predicate.AgentTask(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAttempt), v))
+	})
+}
+
+// AttemptGTE applies the GTE predicate on the "attempt" field.
+func AttemptGTE(v int) predicate.AgentTask {
+	return predicate.AgentTask(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAttempt), v))
+	})
+}
+
+// AttemptLT applies the LT predicate on the "attempt" field.
+func AttemptLT(v int) predicate.AgentTask {
+	return predicate.AgentTask(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAttempt), v))
+	})
+}
+
+// AttemptLTE applies the LTE predicate on the "attempt" field.
+func AttemptLTE(v int) predicate.AgentTask {
+	return predicate.AgentTask(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAttempt), v))
+	})
+}
+
 // OutputEQ applies the EQ predicate on the "output" field.
 func OutputEQ(v string) predicate.AgentTask {
 	return predicate.AgentTask(func(s *sql.Selector) {
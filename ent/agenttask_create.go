@@ -41,6 +41,34 @@ func (atc *AgentTaskCreate) SetNumber(i int) *AgentTaskCreate {
 	return atc
 }
 
+// SetTimeout sets the "timeout" field.
+func (atc *AgentTaskCreate) SetTimeout(i int) *AgentTaskCreate {
+	atc.mutation.SetTimeout(i)
+	return atc
+}
+
+// SetNillableTimeout sets the "timeout" field if the given value is not nil.
+func (atc *AgentTaskCreate) SetNillableTimeout(i *int) *AgentTaskCreate {
+	if i != nil {
+		atc.SetTimeout(*i)
+	}
+	return atc
+}
+
+// SetAttempt sets the "attempt" field.
+func (atc *AgentTaskCreate) SetAttempt(i int) *AgentTaskCreate {
+	atc.mutation.SetAttempt(i)
+	return atc
+}
+
+// SetNillableAttempt sets the "attempt" field if the given value is not nil.
+func (atc *AgentTaskCreate) SetNillableAttempt(i *int) *AgentTaskCreate {
+	if i != nil {
+		atc.SetAttempt(*i)
+	}
+	return atc
+}
+
 // SetOutput sets the "output" field.
 func (atc *AgentTaskCreate) SetOutput(s string) *AgentTaskCreate {
 	atc.mutation.SetOutput(s)
@@ -197,6 +225,14 @@ func (atc *AgentTaskCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (atc *AgentTaskCreate) defaults() {
+	if _, ok := atc.mutation.Timeout(); !ok {
+		v := agenttask.DefaultTimeout
+		atc.mutation.SetTimeout(v)
+	}
+	if _, ok := atc.mutation.Attempt(); !ok {
+		v := agenttask.DefaultAttempt
+		atc.mutation.SetAttempt(v)
+	}
 	if _, ok := atc.mutation.Output(); !ok {
 		v := agenttask.DefaultOutput
 		atc.mutation.SetOutput(v)
@@ -227,6 +263,12 @@ func (atc *AgentTaskCreate) check() error {
 	if _, ok := atc.mutation.Number(); !ok {
 		return &ValidationError{Name: "number", err: errors.New(`ent: missing required field "number"`)}
 	}
+	if _, ok := atc.mutation.Timeout(); !ok {
+		return &ValidationError{Name: "timeout", err: errors.New(`ent: missing required field "timeout"`)}
+	}
+	if _, ok := atc.mutation.Attempt(); !ok {
+		return &ValidationError{Name: "attempt", err: errors.New(`ent: missing required field "attempt"`)}
+	}
 	if _, ok := atc.mutation.Output(); !ok {
 		return &ValidationError{Name: "output", err: errors.New(`ent: missing required field "output"`)}
 	}
@@ -300,6 +342,22 @@ func (atc *AgentTaskCreate) createSpec() (*AgentTask, *sqlgraph.CreateSpec) {
 		})
 		_node.Number = value
 	}
+	if value, ok := atc.mutation.Timeout(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: agenttask.FieldTimeout,
+		})
+		_node.Timeout = value
+	}
+	if value, ok := atc.mutation.Attempt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: agenttask.FieldAttempt,
+		})
+		_node.Attempt = value
+	}
 	if value, ok := atc.mutation.Output(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -56,6 +56,32 @@ func (atu *AgentTaskUpdate) AddNumber(i int) *AgentTaskUpdate {
 	return atu
 }
 
+// SetTimeout sets the "timeout" field.
+func (atu *AgentTaskUpdate) SetTimeout(i int) *AgentTaskUpdate {
+	atu.mutation.ResetTimeout()
+	atu.mutation.SetTimeout(i)
+	return atu
+}
+
+// AddTimeout adds i to the "timeout" field.
+func (atu *AgentTaskUpdate) AddTimeout(i int) *AgentTaskUpdate {
+	atu.mutation.AddTimeout(i)
+	return atu
+}
+
+// SetAttempt sets the "attempt" field.
+func (atu *AgentTaskUpdate) SetAttempt(i int) *AgentTaskUpdate {
+	atu.mutation.ResetAttempt()
+	atu.mutation.SetAttempt(i)
+	return atu
+}
+
+// AddAttempt adds i to the "attempt" field.
+func (atu *AgentTaskUpdate) AddAttempt(i int) *AgentTaskUpdate {
+	atu.mutation.AddAttempt(i)
+	return atu
+}
+
 // SetOutput sets the "output" field.
 func (atu *AgentTaskUpdate) SetOutput(s string) *AgentTaskUpdate {
 	atu.mutation.SetOutput(s)
@@ -297,6 +323,34 @@ func (atu *AgentTaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: agenttask.FieldNumber,
 		})
 	}
+	if value, ok := atu.mutation.Timeout(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: agenttask.FieldTimeout,
+		})
+	}
+	if value, ok := atu.mutation.AddedTimeout(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: agenttask.FieldTimeout,
+		})
+	}
+	if value, ok := atu.mutation.Attempt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: agenttask.FieldAttempt,
+		})
+	}
+	if value, ok := atu.mutation.AddedAttempt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: agenttask.FieldAttempt,
+		})
+	}
 	if value, ok := atu.mutation.Output(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -486,6 +540,32 @@ func (atuo *AgentTaskUpdateOne) AddNumber(i int) *AgentTaskUpdateOne {
 	return atuo
 }
 
+// SetTimeout sets the "timeout" field.
+func (atuo *AgentTaskUpdateOne) SetTimeout(i int) *AgentTaskUpdateOne {
+	atuo.mutation.ResetTimeout()
+	atuo.mutation.SetTimeout(i)
+	return atuo
+}
+
+// AddTimeout adds i to the "timeout" field.
+func (atuo *AgentTaskUpdateOne) AddTimeout(i int) *AgentTaskUpdateOne {
+	atuo.mutation.AddTimeout(i)
+	return atuo
+}
+
+// SetAttempt sets the "attempt" field.
+func (atuo *AgentTaskUpdateOne) SetAttempt(i int) *AgentTaskUpdateOne {
+	atuo.mutation.ResetAttempt()
+	atuo.mutation.SetAttempt(i)
+	return atuo
+}
+
+// AddAttempt adds i to the "attempt" field.
+func (atuo *AgentTaskUpdateOne) AddAttempt(i int) *AgentTaskUpdateOne {
+	atuo.mutation.AddAttempt(i)
+	return atuo
+}
+
 // SetOutput sets the "output" field.
 func (atuo *AgentTaskUpdateOne) SetOutput(s string) *AgentTaskUpdateOne {
 	atuo.mutation.SetOutput(s)
@@ -751,6 +831,34 @@ func (atuo *AgentTaskUpdateOne) sqlSave(ctx context.Context) (_node *AgentTask,
 			Column: agenttask.FieldNumber,
 		})
 	}
+	if value, ok := atuo.mutation.Timeout(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: agenttask.FieldTimeout,
+		})
+	}
+	if value, ok := atuo.mutation.AddedTimeout(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: agenttask.FieldTimeout,
+		})
+	}
+	if value, ok := atuo.mutation.Attempt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: agenttask.FieldAttempt,
+		})
+	}
+	if value, ok := atuo.mutation.AddedAttempt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: agenttask.FieldAttempt,
+		})
+	}
 	if value, ok := atuo.mutation.Output(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -3,10 +3,12 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"entgo.io/ent/dialect/sql"
+	"github.com/gen0cide/laforge/approval"
 	"github.com/gen0cide/laforge/ent/build"
 	"github.com/gen0cide/laforge/ent/buildcommit"
 	"github.com/google/uuid"
@@ -23,6 +25,10 @@ type BuildCommit struct {
 	Revision int `json:"revision,omitempty"`
 	// State holds the value of the "state" field.
 	State buildcommit.State `json:"state,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// Approvals holds the value of the "approvals" field.
+	Approvals []approval.Approval `json:"approvals,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the BuildCommitQuery when eager-loading is set.
 	Edges BuildCommitEdges `json:"edges"`
@@ -75,9 +81,11 @@ func (*BuildCommit) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case buildcommit.FieldApprovals:
+			values[i] = new([]byte)
 		case buildcommit.FieldRevision:
 			values[i] = new(sql.NullInt64)
-		case buildcommit.FieldType, buildcommit.FieldState:
+		case buildcommit.FieldType, buildcommit.FieldState, buildcommit.FieldCreatedBy:
 			values[i] = new(sql.NullString)
 		case buildcommit.FieldID:
 			values[i] = new(uuid.UUID)
@@ -122,6 +130,20 @@ func (bc *BuildCommit) assignValues(columns []string, values []interface{}) erro
 			} else if value.Valid {
 				bc.State = buildcommit.State(value.String)
 			}
+		case buildcommit.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				bc.CreatedBy = value.String
+			}
+		case buildcommit.FieldApprovals:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field approvals", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &bc.Approvals); err != nil {
+					return fmt.Errorf("unmarshal field approvals: %w", err)
+				}
+			}
 		case buildcommit.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field build_commit_build_commit_to_build", values[i])
@@ -173,6 +195,10 @@ func (bc *BuildCommit) String() string {
 	builder.WriteString(fmt.Sprintf("%v", bc.Revision))
 	builder.WriteString(", state=")
 	builder.WriteString(fmt.Sprintf("%v", bc.State))
+	builder.WriteString(", created_by=")
+	builder.WriteString(bc.CreatedBy)
+	builder.WriteString(", approvals=")
+	builder.WriteString(fmt.Sprintf("%v", bc.Approvals))
 	builder.WriteByte(')')
 	return builder.String()
 }
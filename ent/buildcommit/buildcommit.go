@@ -21,6 +21,10 @@ const (
 	FieldRevision = "revision"
 	// FieldState holds the string denoting the state field in the database.
 	FieldState = "state"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldApprovals holds the string denoting the approvals field in the database.
+	FieldApprovals = "approvals"
 	// EdgeBuildCommitToBuild holds the string denoting the buildcommittobuild edge name in mutations.
 	EdgeBuildCommitToBuild = "BuildCommitToBuild"
 	// EdgeBuildCommitToPlanDiffs holds the string denoting the buildcommittoplandiffs edge name in mutations.
@@ -49,6 +53,8 @@ var Columns = []string{
 	FieldType,
 	FieldRevision,
 	FieldState,
+	FieldCreatedBy,
+	FieldApprovals,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "build_commits"
@@ -73,6 +79,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultCreatedBy holds the default value on creation for the "created_by" field.
+	DefaultCreatedBy string
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
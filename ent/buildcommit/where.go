@@ -99,6 +99,13 @@ func Revision(v int) predicate.BuildCommit {
 	})
 }
 
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.BuildCommit {
+	return predicate.BuildCommit(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedBy), v))
+	})
+}
+
 // TypeEQ applies the EQ predicate on the "type" field.
 func TypeEQ(v Type) predicate.BuildCommit {
 	return predicate.BuildCommit(func(s *sql.Selector) {
@@ -271,6 +278,131 @@ func StateNotIn(vs ...State) predicate.BuildCommit {
 	})
 }
 
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.BuildCommit {
+	return predicate.BuildCommit(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedBy), v))
+	})
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.BuildCommit {
+	return predicate.BuildCommit(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedBy), v))
+	})
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.BuildCommit {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BuildCommit(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedBy), v...))
+	})
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.BuildCommit {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.BuildCommit(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedBy), v...))
+	})
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.BuildCommit {
+	return predicate.BuildCommit(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedBy), v))
+	})
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.BuildCommit {
+	return predicate.BuildCommit(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedBy), v))
+	})
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.BuildCommit {
+	return predicate.BuildCommit(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedBy), v))
+	})
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.BuildCommit {
+	return predicate.BuildCommit(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedBy), v))
+	})
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.BuildCommit {
+	return predicate.BuildCommit(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldCreatedBy), v))
+	})
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.BuildCommit {
+	return predicate.BuildCommit(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldCreatedBy), v))
+	})
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.BuildCommit {
+	return predicate.BuildCommit(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldCreatedBy), v))
+	})
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.BuildCommit {
+	return predicate.BuildCommit(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldCreatedBy), v))
+	})
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.BuildCommit {
+	return predicate.BuildCommit(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldCreatedBy), v))
+	})
+}
+
+// ApprovalsIsNil applies the IsNil predicate on the "approvals" field.
+func ApprovalsIsNil() predicate.BuildCommit {
+	return predicate.BuildCommit(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldApprovals)))
+	})
+}
+
+// ApprovalsNotNil applies the NotNil predicate on the "approvals" field.
+func ApprovalsNotNil() predicate.BuildCommit {
+	return predicate.BuildCommit(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldApprovals)))
+	})
+}
+
 // HasBuildCommitToBuild applies the HasEdge predicate on the "BuildCommitToBuild" edge.
 func HasBuildCommitToBuild() predicate.BuildCommit {
 	return predicate.BuildCommit(func(s *sql.Selector) {
@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/gen0cide/laforge/approval"
 	"github.com/gen0cide/laforge/ent/build"
 	"github.com/gen0cide/laforge/ent/buildcommit"
 	"github.com/gen0cide/laforge/ent/plandiff"
@@ -40,6 +41,26 @@ func (bcc *BuildCommitCreate) SetState(b buildcommit.State) *BuildCommitCreate {
 	return bcc
 }
 
+// SetCreatedBy sets the "created_by" field.
+func (bcc *BuildCommitCreate) SetCreatedBy(s string) *BuildCommitCreate {
+	bcc.mutation.SetCreatedBy(s)
+	return bcc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (bcc *BuildCommitCreate) SetNillableCreatedBy(s *string) *BuildCommitCreate {
+	if s != nil {
+		bcc.SetCreatedBy(*s)
+	}
+	return bcc
+}
+
+// SetApprovals sets the "approvals" field.
+func (bcc *BuildCommitCreate) SetApprovals(a []approval.Approval) *BuildCommitCreate {
+	bcc.mutation.SetApprovals(a)
+	return bcc
+}
+
 // SetID sets the "id" field.
 func (bcc *BuildCommitCreate) SetID(u uuid.UUID) *BuildCommitCreate {
 	bcc.mutation.SetID(u)
@@ -143,6 +164,10 @@ func (bcc *BuildCommitCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (bcc *BuildCommitCreate) defaults() {
+	if _, ok := bcc.mutation.CreatedBy(); !ok {
+		v := buildcommit.DefaultCreatedBy
+		bcc.mutation.SetCreatedBy(v)
+	}
 	if _, ok := bcc.mutation.ID(); !ok {
 		v := buildcommit.DefaultID()
 		bcc.mutation.SetID(v)
@@ -170,6 +195,9 @@ func (bcc *BuildCommitCreate) check() error {
 			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "state": %w`, err)}
 		}
 	}
+	if _, ok := bcc.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "created_by"`)}
+	}
 	if _, ok := bcc.mutation.BuildCommitToBuildID(); !ok {
 		return &ValidationError{Name: "BuildCommitToBuild", err: errors.New("ent: missing required edge \"BuildCommitToBuild\"")}
 	}
@@ -229,6 +257,22 @@ func (bcc *BuildCommitCreate) createSpec() (*BuildCommit, *sqlgraph.CreateSpec)
 		})
 		_node.State = value
 	}
+	if value, ok := bcc.mutation.CreatedBy(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: buildcommit.FieldCreatedBy,
+		})
+		_node.CreatedBy = value
+	}
+	if value, ok := bcc.mutation.Approvals(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: buildcommit.FieldApprovals,
+		})
+		_node.Approvals = value
+	}
 	if nodes := bcc.mutation.BuildCommitToBuildIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
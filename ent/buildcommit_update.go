@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/gen0cide/laforge/approval"
 	"github.com/gen0cide/laforge/ent/build"
 	"github.com/gen0cide/laforge/ent/buildcommit"
 	"github.com/gen0cide/laforge/ent/plandiff"
@@ -55,6 +56,32 @@ func (bcu *BuildCommitUpdate) SetState(b buildcommit.State) *BuildCommitUpdate {
 	return bcu
 }
 
+// SetCreatedBy sets the "created_by" field.
+func (bcu *BuildCommitUpdate) SetCreatedBy(s string) *BuildCommitUpdate {
+	bcu.mutation.SetCreatedBy(s)
+	return bcu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (bcu *BuildCommitUpdate) SetNillableCreatedBy(s *string) *BuildCommitUpdate {
+	if s != nil {
+		bcu.SetCreatedBy(*s)
+	}
+	return bcu
+}
+
+// SetApprovals sets the "approvals" field.
+func (bcu *BuildCommitUpdate) SetApprovals(a []approval.Approval) *BuildCommitUpdate {
+	bcu.mutation.SetApprovals(a)
+	return bcu
+}
+
+// ClearApprovals clears the value of the "approvals" field.
+func (bcu *BuildCommitUpdate) ClearApprovals() *BuildCommitUpdate {
+	bcu.mutation.ClearApprovals()
+	return bcu
+}
+
 // SetBuildCommitToBuildID sets the "BuildCommitToBuild" edge to the Build entity by ID.
 func (bcu *BuildCommitUpdate) SetBuildCommitToBuildID(id uuid.UUID) *BuildCommitUpdate {
 	bcu.mutation.SetBuildCommitToBuildID(id)
@@ -237,6 +264,26 @@ func (bcu *BuildCommitUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: buildcommit.FieldState,
 		})
 	}
+	if value, ok := bcu.mutation.CreatedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: buildcommit.FieldCreatedBy,
+		})
+	}
+	if value, ok := bcu.mutation.Approvals(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: buildcommit.FieldApprovals,
+		})
+	}
+	if bcu.mutation.ApprovalsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: buildcommit.FieldApprovals,
+		})
+	}
 	if bcu.mutation.BuildCommitToBuildCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -370,6 +417,32 @@ func (bcuo *BuildCommitUpdateOne) SetState(b buildcommit.State) *BuildCommitUpda
 	return bcuo
 }
 
+// SetCreatedBy sets the "created_by" field.
+func (bcuo *BuildCommitUpdateOne) SetCreatedBy(s string) *BuildCommitUpdateOne {
+	bcuo.mutation.SetCreatedBy(s)
+	return bcuo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (bcuo *BuildCommitUpdateOne) SetNillableCreatedBy(s *string) *BuildCommitUpdateOne {
+	if s != nil {
+		bcuo.SetCreatedBy(*s)
+	}
+	return bcuo
+}
+
+// SetApprovals sets the "approvals" field.
+func (bcuo *BuildCommitUpdateOne) SetApprovals(a []approval.Approval) *BuildCommitUpdateOne {
+	bcuo.mutation.SetApprovals(a)
+	return bcuo
+}
+
+// ClearApprovals clears the value of the "approvals" field.
+func (bcuo *BuildCommitUpdateOne) ClearApprovals() *BuildCommitUpdateOne {
+	bcuo.mutation.ClearApprovals()
+	return bcuo
+}
+
 // SetBuildCommitToBuildID sets the "BuildCommitToBuild" edge to the Build entity by ID.
 func (bcuo *BuildCommitUpdateOne) SetBuildCommitToBuildID(id uuid.UUID) *BuildCommitUpdateOne {
 	bcuo.mutation.SetBuildCommitToBuildID(id)
@@ -576,6 +649,26 @@ func (bcuo *BuildCommitUpdateOne) sqlSave(ctx context.Context) (_node *BuildComm
 			Column: buildcommit.FieldState,
 		})
 	}
+	if value, ok := bcuo.mutation.CreatedBy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: buildcommit.FieldCreatedBy,
+		})
+	}
+	if value, ok := bcuo.mutation.Approvals(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: buildcommit.FieldApprovals,
+		})
+	}
+	if bcuo.mutation.ApprovalsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: buildcommit.FieldApprovals,
+		})
+	}
 	if bcuo.mutation.BuildCommitToBuildCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
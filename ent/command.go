@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/gen0cide/laforge/ent/command"
 	"github.com/gen0cide/laforge/ent/environment"
+	"github.com/gen0cide/laforge/retry"
 	"github.com/google/uuid"
 )
 
@@ -40,6 +41,8 @@ type Command struct {
 	Vars map[string]string `json:"vars,omitempty" hcl:"vars,attr"`
 	// Tags holds the value of the "tags" field.
 	Tags map[string]string `json:"tags,omitempty" hcl:"tags,optional"`
+	// Retry holds the value of the "retry" field.
+	Retry *retry.Policy `json:"retry,omitempty" hcl:"retry,block"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CommandQuery when eager-loading is set.
 	Edges CommandEdges `json:"edges"`
@@ -92,7 +95,7 @@ func (*Command) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case command.FieldArgs, command.FieldVars, command.FieldTags:
+		case command.FieldArgs, command.FieldVars, command.FieldTags, command.FieldRetry:
 			values[i] = new([]byte)
 		case command.FieldIgnoreErrors, command.FieldDisabled:
 			values[i] = new(sql.NullBool)
@@ -197,6 +200,14 @@ func (c *Command) assignValues(columns []string, values []interface{}) error {
 					return fmt.Errorf("unmarshal field tags: %w", err)
 				}
 			}
+		case command.FieldRetry:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field retry", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.Retry); err != nil {
+					return fmt.Errorf("unmarshal field retry: %w", err)
+				}
+			}
 		case command.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field environment_environment_to_command", values[i])
@@ -264,6 +275,8 @@ func (c *Command) String() string {
 	builder.WriteString(fmt.Sprintf("%v", c.Vars))
 	builder.WriteString(", tags=")
 	builder.WriteString(fmt.Sprintf("%v", c.Tags))
+	builder.WriteString(", retry=")
+	builder.WriteString(fmt.Sprintf("%v", c.Retry))
 	builder.WriteByte(')')
 	return builder.String()
 }
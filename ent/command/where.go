@@ -772,6 +772,20 @@ func TimeoutLTE(v int) predicate.Command {
 	})
 }
 
+// RetryIsNil applies the IsNil predicate on the "retry" field.
+func RetryIsNil() predicate.Command {
+	return predicate.Command(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRetry)))
+	})
+}
+
+// RetryNotNil applies the NotNil predicate on the "retry" field.
+func RetryNotNil() predicate.Command {
+	return predicate.Command(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRetry)))
+	})
+}
+
 // HasCommandToUser applies the HasEdge predicate on the "CommandToUser" edge.
 func HasCommandToUser() predicate.Command {
 	return predicate.Command(func(s *sql.Selector) {
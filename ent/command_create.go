@@ -12,6 +12,7 @@ import (
 	"github.com/gen0cide/laforge/ent/command"
 	"github.com/gen0cide/laforge/ent/environment"
 	"github.com/gen0cide/laforge/ent/user"
+	"github.com/gen0cide/laforge/retry"
 	"github.com/google/uuid"
 )
 
@@ -88,6 +89,12 @@ func (cc *CommandCreate) SetTags(m map[string]string) *CommandCreate {
 	return cc
 }
 
+// SetRetry sets the "retry" field.
+func (cc *CommandCreate) SetRetry(r *retry.Policy) *CommandCreate {
+	cc.mutation.SetRetry(r)
+	return cc
+}
+
 // SetID sets the "id" field.
 func (cc *CommandCreate) SetID(u uuid.UUID) *CommandCreate {
 	cc.mutation.SetID(u)
@@ -370,6 +377,14 @@ func (cc *CommandCreate) createSpec() (*Command, *sqlgraph.CreateSpec) {
 		})
 		_node.Tags = value
 	}
+	if value, ok := cc.mutation.Retry(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: command.FieldRetry,
+		})
+		_node.Retry = value
+	}
 	if nodes := cc.mutation.CommandToUserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
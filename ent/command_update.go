@@ -13,6 +13,7 @@ import (
 	"github.com/gen0cide/laforge/ent/environment"
 	"github.com/gen0cide/laforge/ent/predicate"
 	"github.com/gen0cide/laforge/ent/user"
+	"github.com/gen0cide/laforge/retry"
 	"github.com/google/uuid"
 )
 
@@ -109,6 +110,18 @@ func (cu *CommandUpdate) SetTags(m map[string]string) *CommandUpdate {
 	return cu
 }
 
+// SetRetry sets the "retry" field.
+func (cu *CommandUpdate) SetRetry(r *retry.Policy) *CommandUpdate {
+	cu.mutation.SetRetry(r)
+	return cu
+}
+
+// ClearRetry clears the value of the "retry" field.
+func (cu *CommandUpdate) ClearRetry() *CommandUpdate {
+	cu.mutation.ClearRetry()
+	return cu
+}
+
 // AddCommandToUserIDs adds the "CommandToUser" edge to the User entity by IDs.
 func (cu *CommandUpdate) AddCommandToUserIDs(ids ...uuid.UUID) *CommandUpdate {
 	cu.mutation.AddCommandToUserIDs(ids...)
@@ -359,6 +372,19 @@ func (cu *CommandUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: command.FieldTags,
 		})
 	}
+	if value, ok := cu.mutation.Retry(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: command.FieldRetry,
+		})
+	}
+	if cu.mutation.RetryCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: command.FieldRetry,
+		})
+	}
 	if cu.mutation.CommandToUserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -547,6 +573,18 @@ func (cuo *CommandUpdateOne) SetTags(m map[string]string) *CommandUpdateOne {
 	return cuo
 }
 
+// SetRetry sets the "retry" field.
+func (cuo *CommandUpdateOne) SetRetry(r *retry.Policy) *CommandUpdateOne {
+	cuo.mutation.SetRetry(r)
+	return cuo
+}
+
+// ClearRetry clears the value of the "retry" field.
+func (cuo *CommandUpdateOne) ClearRetry() *CommandUpdateOne {
+	cuo.mutation.ClearRetry()
+	return cuo
+}
+
 // AddCommandToUserIDs adds the "CommandToUser" edge to the User entity by IDs.
 func (cuo *CommandUpdateOne) AddCommandToUserIDs(ids ...uuid.UUID) *CommandUpdateOne {
 	cuo.mutation.AddCommandToUserIDs(ids...)
@@ -821,6 +859,19 @@ func (cuo *CommandUpdateOne) sqlSave(ctx context.Context) (_node *Command, err e
 			Column: command.FieldTags,
 		})
 	}
+	if value, ok := cuo.mutation.Retry(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: command.FieldRetry,
+		})
+	}
+	if cuo.mutation.RetryCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: command.FieldRetry,
+		})
+	}
 	if cuo.mutation.CommandToUserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
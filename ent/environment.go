@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"entgo.io/ent/dialect/sql"
+	"github.com/gen0cide/laforge/approval"
 	"github.com/gen0cide/laforge/ent/environment"
 	"github.com/google/uuid"
 )
@@ -39,6 +40,8 @@ type Environment struct {
 	Config map[string]string `json:"config,omitempty" hcl:"config,optional"`
 	// Tags holds the value of the "tags" field.
 	Tags map[string]string `json:"tags,omitempty" hcl:"tags,optional"`
+	// ApprovalPolicy holds the value of the "approval_policy" field.
+	ApprovalPolicy *approval.Policy `json:"approval_policy,omitempty" hcl:"approval_policy,block"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the EnvironmentQuery when eager-loading is set.
 	Edges EnvironmentEdges `json:"edges"`
@@ -281,7 +284,7 @@ func (*Environment) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case environment.FieldAdminCidrs, environment.FieldExposedVdiPorts, environment.FieldConfig, environment.FieldTags:
+		case environment.FieldAdminCidrs, environment.FieldExposedVdiPorts, environment.FieldConfig, environment.FieldTags, environment.FieldApprovalPolicy:
 			values[i] = new([]byte)
 		case environment.FieldTeamCount, environment.FieldRevision:
 			values[i] = new(sql.NullInt64)
@@ -384,6 +387,14 @@ func (e *Environment) assignValues(columns []string, values []interface{}) error
 					return fmt.Errorf("unmarshal field tags: %w", err)
 				}
 			}
+		case environment.FieldApprovalPolicy:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field approval_policy", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &e.ApprovalPolicy); err != nil {
+					return fmt.Errorf("unmarshal field approval_policy: %w", err)
+				}
+			}
 		}
 	}
 	return nil
@@ -519,6 +530,8 @@ func (e *Environment) String() string {
 	builder.WriteString(fmt.Sprintf("%v", e.Config))
 	builder.WriteString(", tags=")
 	builder.WriteString(fmt.Sprintf("%v", e.Tags))
+	builder.WriteString(", approval_policy=")
+	builder.WriteString(fmt.Sprintf("%v", e.ApprovalPolicy))
 	builder.WriteByte(')')
 	return builder.String()
 }
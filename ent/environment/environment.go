@@ -33,6 +33,8 @@ const (
 	FieldConfig = "config"
 	// FieldTags holds the string denoting the tags field in the database.
 	FieldTags = "tags"
+	// FieldApprovalPolicy holds the string denoting the approval_policy field in the database.
+	FieldApprovalPolicy = "approval_policy"
 	// EdgeEnvironmentToUser holds the string denoting the environmenttouser edge name in mutations.
 	EdgeEnvironmentToUser = "EnvironmentToUser"
 	// EdgeEnvironmentToHost holds the string denoting the environmenttohost edge name in mutations.
@@ -196,6 +198,7 @@ var Columns = []string{
 	FieldExposedVdiPorts,
 	FieldConfig,
 	FieldTags,
+	FieldApprovalPolicy,
 }
 
 var (
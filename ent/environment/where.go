@@ -848,6 +848,20 @@ func RevisionLTE(v int) predicate.Environment {
 	})
 }
 
+// ApprovalPolicyIsNil applies the IsNil predicate on the "approval_policy" field.
+func ApprovalPolicyIsNil() predicate.Environment {
+	return predicate.Environment(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldApprovalPolicy)))
+	})
+}
+
+// ApprovalPolicyNotNil applies the NotNil predicate on the "approval_policy" field.
+func ApprovalPolicyNotNil() predicate.Environment {
+	return predicate.Environment(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldApprovalPolicy)))
+	})
+}
+
 // HasEnvironmentToUser applies the HasEdge predicate on the "EnvironmentToUser" edge.
 func HasEnvironmentToUser() predicate.Environment {
 	return predicate.Environment(func(s *sql.Selector) {
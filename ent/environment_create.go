@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/gen0cide/laforge/approval"
 	"github.com/gen0cide/laforge/ent/build"
 	"github.com/gen0cide/laforge/ent/command"
 	"github.com/gen0cide/laforge/ent/competition"
@@ -103,6 +104,12 @@ func (ec *EnvironmentCreate) SetTags(m map[string]string) *EnvironmentCreate {
 	return ec
 }
 
+// SetApprovalPolicy sets the "approval_policy" field.
+func (ec *EnvironmentCreate) SetApprovalPolicy(a *approval.Policy) *EnvironmentCreate {
+	ec.mutation.SetApprovalPolicy(a)
+	return ec
+}
+
 // SetID sets the "id" field.
 func (ec *EnvironmentCreate) SetID(u uuid.UUID) *EnvironmentCreate {
 	ec.mutation.SetID(u)
@@ -596,6 +603,14 @@ func (ec *EnvironmentCreate) createSpec() (*Environment, *sqlgraph.CreateSpec) {
 		})
 		_node.Tags = value
 	}
+	if value, ok := ec.mutation.ApprovalPolicy(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: environment.FieldApprovalPolicy,
+		})
+		_node.ApprovalPolicy = value
+	}
 	if nodes := ec.mutation.EnvironmentToUserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
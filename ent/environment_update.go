@@ -9,6 +9,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/gen0cide/laforge/approval"
 	"github.com/gen0cide/laforge/ent/build"
 	"github.com/gen0cide/laforge/ent/command"
 	"github.com/gen0cide/laforge/ent/competition"
@@ -124,6 +125,18 @@ func (eu *EnvironmentUpdate) SetTags(m map[string]string) *EnvironmentUpdate {
 	return eu
 }
 
+// SetApprovalPolicy sets the "approval_policy" field.
+func (eu *EnvironmentUpdate) SetApprovalPolicy(a *approval.Policy) *EnvironmentUpdate {
+	eu.mutation.SetApprovalPolicy(a)
+	return eu
+}
+
+// ClearApprovalPolicy clears the value of the "approval_policy" field.
+func (eu *EnvironmentUpdate) ClearApprovalPolicy() *EnvironmentUpdate {
+	eu.mutation.ClearApprovalPolicy()
+	return eu
+}
+
 // AddEnvironmentToUserIDs adds the "EnvironmentToUser" edge to the User entity by IDs.
 func (eu *EnvironmentUpdate) AddEnvironmentToUserIDs(ids ...uuid.UUID) *EnvironmentUpdate {
 	eu.mutation.AddEnvironmentToUserIDs(ids...)
@@ -904,6 +917,19 @@ func (eu *EnvironmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: environment.FieldTags,
 		})
 	}
+	if value, ok := eu.mutation.ApprovalPolicy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: environment.FieldApprovalPolicy,
+		})
+	}
+	if eu.mutation.ApprovalPolicyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: environment.FieldApprovalPolicy,
+		})
+	}
 	if eu.mutation.EnvironmentToUserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -1921,6 +1947,18 @@ func (euo *EnvironmentUpdateOne) SetTags(m map[string]string) *EnvironmentUpdate
 	return euo
 }
 
+// SetApprovalPolicy sets the "approval_policy" field.
+func (euo *EnvironmentUpdateOne) SetApprovalPolicy(a *approval.Policy) *EnvironmentUpdateOne {
+	euo.mutation.SetApprovalPolicy(a)
+	return euo
+}
+
+// ClearApprovalPolicy clears the value of the "approval_policy" field.
+func (euo *EnvironmentUpdateOne) ClearApprovalPolicy() *EnvironmentUpdateOne {
+	euo.mutation.ClearApprovalPolicy()
+	return euo
+}
+
 // AddEnvironmentToUserIDs adds the "EnvironmentToUser" edge to the User entity by IDs.
 func (euo *EnvironmentUpdateOne) AddEnvironmentToUserIDs(ids ...uuid.UUID) *EnvironmentUpdateOne {
 	euo.mutation.AddEnvironmentToUserIDs(ids...)
@@ -2725,6 +2763,19 @@ func (euo *EnvironmentUpdateOne) sqlSave(ctx context.Context) (_node *Environmen
 			Column: environment.FieldTags,
 		})
 	}
+	if value, ok := euo.mutation.ApprovalPolicy(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: environment.FieldApprovalPolicy,
+		})
+	}
+	if euo.mutation.ApprovalPolicyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: environment.FieldApprovalPolicy,
+		})
+	}
 	if euo.mutation.EnvironmentToUserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/gen0cide/laforge/ent/environment"
 	"github.com/gen0cide/laforge/ent/filedelete"
+	"github.com/gen0cide/laforge/retry"
 	"github.com/google/uuid"
 )
 
@@ -24,6 +25,8 @@ type FileDelete struct {
 	Path string `json:"path,omitempty" hcl:"path,attr"`
 	// Tags holds the value of the "tags" field.
 	Tags map[string]string `json:"tags,omitempty" hcl:"tags,optional"`
+	// Retry holds the value of the "retry" field.
+	Retry *retry.Policy `json:"retry,omitempty" hcl:"retry,block"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the FileDeleteQuery when eager-loading is set.
 	Edges FileDeleteEdges `json:"edges"`
@@ -63,7 +66,7 @@ func (*FileDelete) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case filedelete.FieldTags:
+		case filedelete.FieldTags, filedelete.FieldRetry:
 			values[i] = new([]byte)
 		case filedelete.FieldHclID, filedelete.FieldPath:
 			values[i] = new(sql.NullString)
@@ -112,6 +115,14 @@ func (fd *FileDelete) assignValues(columns []string, values []interface{}) error
 					return fmt.Errorf("unmarshal field tags: %w", err)
 				}
 			}
+		case filedelete.FieldRetry:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field retry", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &fd.Retry); err != nil {
+					return fmt.Errorf("unmarshal field retry: %w", err)
+				}
+			}
 		case filedelete.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field environment_environment_to_file_delete", values[i])
@@ -158,6 +169,8 @@ func (fd *FileDelete) String() string {
 	builder.WriteString(fd.Path)
 	builder.WriteString(", tags=")
 	builder.WriteString(fmt.Sprintf("%v", fd.Tags))
+	builder.WriteString(", retry=")
+	builder.WriteString(fmt.Sprintf("%v", fd.Retry))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -17,6 +17,8 @@ const (
 	FieldPath = "path"
 	// FieldTags holds the string denoting the tags field in the database.
 	FieldTags = "tags"
+	// FieldRetry holds the string denoting the retry field in the database.
+	FieldRetry = "retry"
 	// EdgeFileDeleteToEnvironment holds the string denoting the filedeletetoenvironment edge name in mutations.
 	EdgeFileDeleteToEnvironment = "FileDeleteToEnvironment"
 	// Table holds the table name of the filedelete in the database.
@@ -36,6 +38,7 @@ var Columns = []string{
 	FieldHclID,
 	FieldPath,
 	FieldTags,
+	FieldRetry,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "file_deletes"
@@ -328,6 +328,20 @@ func PathContainsFold(v string) predicate.FileDelete {
 	})
 }
 
+// RetryIsNil applies the IsNil predicate on the "retry" field.
+func RetryIsNil() predicate.FileDelete {
+	return predicate.FileDelete(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRetry)))
+	})
+}
+
+// RetryNotNil applies the NotNil predicate on the "retry" field.
+func RetryNotNil() predicate.FileDelete {
+	return predicate.FileDelete(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRetry)))
+	})
+}
+
 // HasFileDeleteToEnvironment applies the HasEdge predicate on the "FileDeleteToEnvironment" edge.
 func HasFileDeleteToEnvironment() predicate.FileDelete {
 	return predicate.FileDelete(func(s *sql.Selector) {
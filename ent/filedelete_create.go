@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/gen0cide/laforge/ent/environment"
 	"github.com/gen0cide/laforge/ent/filedelete"
+	"github.com/gen0cide/laforge/retry"
 	"github.com/google/uuid"
 )
 
@@ -39,6 +40,12 @@ func (fdc *FileDeleteCreate) SetTags(m map[string]string) *FileDeleteCreate {
 	return fdc
 }
 
+// SetRetry sets the "retry" field.
+func (fdc *FileDeleteCreate) SetRetry(r *retry.Policy) *FileDeleteCreate {
+	fdc.mutation.SetRetry(r)
+	return fdc
+}
+
 // SetID sets the "id" field.
 func (fdc *FileDeleteCreate) SetID(u uuid.UUID) *FileDeleteCreate {
 	fdc.mutation.SetID(u)
@@ -208,6 +215,14 @@ func (fdc *FileDeleteCreate) createSpec() (*FileDelete, *sqlgraph.CreateSpec) {
 		})
 		_node.Tags = value
 	}
+	if value, ok := fdc.mutation.Retry(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: filedelete.FieldRetry,
+		})
+		_node.Retry = value
+	}
 	if nodes := fdc.mutation.FileDeleteToEnvironmentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
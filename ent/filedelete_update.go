@@ -12,6 +12,7 @@ import (
 	"github.com/gen0cide/laforge/ent/environment"
 	"github.com/gen0cide/laforge/ent/filedelete"
 	"github.com/gen0cide/laforge/ent/predicate"
+	"github.com/gen0cide/laforge/retry"
 	"github.com/google/uuid"
 )
 
@@ -46,6 +47,18 @@ func (fdu *FileDeleteUpdate) SetTags(m map[string]string) *FileDeleteUpdate {
 	return fdu
 }
 
+// SetRetry sets the "retry" field.
+func (fdu *FileDeleteUpdate) SetRetry(r *retry.Policy) *FileDeleteUpdate {
+	fdu.mutation.SetRetry(r)
+	return fdu
+}
+
+// ClearRetry clears the value of the "retry" field.
+func (fdu *FileDeleteUpdate) ClearRetry() *FileDeleteUpdate {
+	fdu.mutation.ClearRetry()
+	return fdu
+}
+
 // SetFileDeleteToEnvironmentID sets the "FileDeleteToEnvironment" edge to the Environment entity by ID.
 func (fdu *FileDeleteUpdate) SetFileDeleteToEnvironmentID(id uuid.UUID) *FileDeleteUpdate {
 	fdu.mutation.SetFileDeleteToEnvironmentID(id)
@@ -169,6 +182,19 @@ func (fdu *FileDeleteUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: filedelete.FieldTags,
 		})
 	}
+	if value, ok := fdu.mutation.Retry(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: filedelete.FieldRetry,
+		})
+	}
+	if fdu.mutation.RetryCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: filedelete.FieldRetry,
+		})
+	}
 	if fdu.mutation.FileDeleteToEnvironmentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -241,6 +267,18 @@ func (fduo *FileDeleteUpdateOne) SetTags(m map[string]string) *FileDeleteUpdateO
 	return fduo
 }
 
+// SetRetry sets the "retry" field.
+func (fduo *FileDeleteUpdateOne) SetRetry(r *retry.Policy) *FileDeleteUpdateOne {
+	fduo.mutation.SetRetry(r)
+	return fduo
+}
+
+// ClearRetry clears the value of the "retry" field.
+func (fduo *FileDeleteUpdateOne) ClearRetry() *FileDeleteUpdateOne {
+	fduo.mutation.ClearRetry()
+	return fduo
+}
+
 // SetFileDeleteToEnvironmentID sets the "FileDeleteToEnvironment" edge to the Environment entity by ID.
 func (fduo *FileDeleteUpdateOne) SetFileDeleteToEnvironmentID(id uuid.UUID) *FileDeleteUpdateOne {
 	fduo.mutation.SetFileDeleteToEnvironmentID(id)
@@ -388,6 +426,19 @@ func (fduo *FileDeleteUpdateOne) sqlSave(ctx context.Context) (_node *FileDelete
 			Column: filedelete.FieldTags,
 		})
 	}
+	if value, ok := fduo.mutation.Retry(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: filedelete.FieldRetry,
+		})
+	}
+	if fduo.mutation.RetryCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: filedelete.FieldRetry,
+		})
+	}
 	if fduo.mutation.FileDeleteToEnvironmentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/gen0cide/laforge/ent/environment"
 	"github.com/gen0cide/laforge/ent/filedownload"
+	"github.com/gen0cide/laforge/retry"
 	"github.com/google/uuid"
 )
 
@@ -38,6 +39,10 @@ type FileDownload struct {
 	AbsPath string `json:"abs_path,omitempty" hcl:"abs_path,optional"`
 	// Tags holds the value of the "tags" field.
 	Tags map[string]string `json:"tags,omitempty" hcl:"tags,optional"`
+	// Retry holds the value of the "retry" field.
+	Retry *retry.Policy `json:"retry,omitempty" hcl:"retry,block"`
+	// Sha256 holds the value of the "sha256" field.
+	Sha256 string `json:"sha256,omitempty" hcl:"sha256,optional"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the FileDownloadQuery when eager-loading is set.
 	Edges FileDownloadEdges `json:"edges"`
@@ -77,11 +82,11 @@ func (*FileDownload) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case filedownload.FieldTags:
+		case filedownload.FieldTags, filedownload.FieldRetry:
 			values[i] = new([]byte)
 		case filedownload.FieldTemplate, filedownload.FieldDisabled:
 			values[i] = new(sql.NullBool)
-		case filedownload.FieldHclID, filedownload.FieldSourceType, filedownload.FieldSource, filedownload.FieldDestination, filedownload.FieldPerms, filedownload.FieldMd5, filedownload.FieldAbsPath:
+		case filedownload.FieldHclID, filedownload.FieldSourceType, filedownload.FieldSource, filedownload.FieldDestination, filedownload.FieldPerms, filedownload.FieldMd5, filedownload.FieldAbsPath, filedownload.FieldSha256:
 			values[i] = new(sql.NullString)
 		case filedownload.FieldID:
 			values[i] = new(uuid.UUID)
@@ -170,6 +175,20 @@ func (fd *FileDownload) assignValues(columns []string, values []interface{}) err
 					return fmt.Errorf("unmarshal field tags: %w", err)
 				}
 			}
+		case filedownload.FieldRetry:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field retry", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &fd.Retry); err != nil {
+					return fmt.Errorf("unmarshal field retry: %w", err)
+				}
+			}
+		case filedownload.FieldSha256:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sha256", values[i])
+			} else if value.Valid {
+				fd.Sha256 = value.String
+			}
 		case filedownload.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field environment_environment_to_file_download", values[i])
@@ -230,6 +249,10 @@ func (fd *FileDownload) String() string {
 	builder.WriteString(fd.AbsPath)
 	builder.WriteString(", tags=")
 	builder.WriteString(fmt.Sprintf("%v", fd.Tags))
+	builder.WriteString(", retry=")
+	builder.WriteString(fmt.Sprintf("%v", fd.Retry))
+	builder.WriteString(", sha256=")
+	builder.WriteString(fd.Sha256)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -31,6 +31,10 @@ const (
 	FieldAbsPath = "abs_path"
 	// FieldTags holds the string denoting the tags field in the database.
 	FieldTags = "tags"
+	// FieldRetry holds the string denoting the retry field in the database.
+	FieldRetry = "retry"
+	// FieldSha256 holds the string denoting the sha256 field in the database.
+	FieldSha256 = "sha256"
 	// EdgeFileDownloadToEnvironment holds the string denoting the filedownloadtoenvironment edge name in mutations.
 	EdgeFileDownloadToEnvironment = "FileDownloadToEnvironment"
 	// Table holds the table name of the filedownload in the database.
@@ -57,6 +61,8 @@ var Columns = []string{
 	FieldMd5,
 	FieldAbsPath,
 	FieldTags,
+	FieldRetry,
+	FieldSha256,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "file_downloads"
@@ -81,6 +87,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultSha256 holds the default value on creation for the "sha256" field.
+	DefaultSha256 string
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -155,6 +155,13 @@ func AbsPath(v string) predicate.FileDownload {
 	})
 }
 
+// Sha256 applies equality check predicate on the "sha256" field. It's identical to Sha256EQ.
+func Sha256(v string) predicate.FileDownload {
+	return predicate.FileDownload(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSha256), v))
+	})
+}
+
 // HclIDEQ applies the EQ predicate on the "hcl_id" field.
 func HclIDEQ(v string) predicate.FileDownload {
 	return predicate.FileDownload(func(s *sql.Selector) {
@@ -960,6 +967,131 @@ func AbsPathContainsFold(v string) predicate.FileDownload {
 	})
 }
 
+// RetryIsNil applies the IsNil predicate on the "retry" field.
+func RetryIsNil() predicate.FileDownload {
+	return predicate.FileDownload(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRetry)))
+	})
+}
+
+// RetryNotNil applies the NotNil predicate on the "retry" field.
+func RetryNotNil() predicate.FileDownload {
+	return predicate.FileDownload(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRetry)))
+	})
+}
+
+// Sha256EQ applies the EQ predicate on the "sha256" field.
+func Sha256EQ(v string) predicate.FileDownload {
+	return predicate.FileDownload(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSha256), v))
+	})
+}
+
+// Sha256NEQ applies the NEQ predicate on the "sha256" field.
+func Sha256NEQ(v string) predicate.FileDownload {
+	return predicate.FileDownload(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSha256), v))
+	})
+}
+
+// Sha256In applies the In predicate on the "sha256" field.
+func Sha256In(vs ...string) predicate.FileDownload {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FileDownload(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSha256), v...))
+	})
+}
+
+// Sha256NotIn applies the NotIn predicate on the "sha256" field.
+func Sha256NotIn(vs ...string) predicate.FileDownload {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FileDownload(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSha256), v...))
+	})
+}
+
+// Sha256GT applies the GT predicate on the "sha256" field.
+func Sha256GT(v string) predicate.FileDownload {
+	return predicate.FileDownload(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSha256), v))
+	})
+}
+
+// Sha256GTE applies the GTE predicate on the "sha256" field.
+func Sha256GTE(v string) predicate.FileDownload {
+	return predicate.FileDownload(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSha256), v))
+	})
+}
+
+// Sha256LT applies the LT predicate on the "sha256" field.
+func Sha256LT(v string) predicate.FileDownload {
+	return predicate.FileDownload(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSha256), v))
+	})
+}
+
+// Sha256LTE applies the LTE predicate on the "sha256" field.
+func Sha256LTE(v string) predicate.FileDownload {
+	return predicate.FileDownload(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSha256), v))
+	})
+}
+
+// Sha256Contains applies the Contains predicate on the "sha256" field.
+func Sha256Contains(v string) predicate.FileDownload {
+	return predicate.FileDownload(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSha256), v))
+	})
+}
+
+// Sha256HasPrefix applies the HasPrefix predicate on the "sha256" field.
+func Sha256HasPrefix(v string) predicate.FileDownload {
+	return predicate.FileDownload(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSha256), v))
+	})
+}
+
+// Sha256HasSuffix applies the HasSuffix predicate on the "sha256" field.
+func Sha256HasSuffix(v string) predicate.FileDownload {
+	return predicate.FileDownload(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSha256), v))
+	})
+}
+
+// Sha256EqualFold applies the EqualFold predicate on the "sha256" field.
+func Sha256EqualFold(v string) predicate.FileDownload {
+	return predicate.FileDownload(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSha256), v))
+	})
+}
+
+// Sha256ContainsFold applies the ContainsFold predicate on the "sha256" field.
+func Sha256ContainsFold(v string) predicate.FileDownload {
+	return predicate.FileDownload(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSha256), v))
+	})
+}
+
 // HasFileDownloadToEnvironment applies the HasEdge predicate on the "FileDownloadToEnvironment" edge.
 func HasFileDownloadToEnvironment() predicate.FileDownload {
 	return predicate.FileDownload(func(s *sql.Selector) {
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/gen0cide/laforge/ent/environment"
 	"github.com/gen0cide/laforge/ent/filedownload"
+	"github.com/gen0cide/laforge/retry"
 	"github.com/google/uuid"
 )
 
@@ -81,6 +82,26 @@ func (fdc *FileDownloadCreate) SetTags(m map[string]string) *FileDownloadCreate
 	return fdc
 }
 
+// SetRetry sets the "retry" field.
+func (fdc *FileDownloadCreate) SetRetry(r *retry.Policy) *FileDownloadCreate {
+	fdc.mutation.SetRetry(r)
+	return fdc
+}
+
+// SetSha256 sets the "sha256" field.
+func (fdc *FileDownloadCreate) SetSha256(s string) *FileDownloadCreate {
+	fdc.mutation.SetSha256(s)
+	return fdc
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (fdc *FileDownloadCreate) SetNillableSha256(s *string) *FileDownloadCreate {
+	if s != nil {
+		fdc.SetSha256(*s)
+	}
+	return fdc
+}
+
 // SetID sets the "id" field.
 func (fdc *FileDownloadCreate) SetID(u uuid.UUID) *FileDownloadCreate {
 	fdc.mutation.SetID(u)
@@ -177,6 +198,10 @@ func (fdc *FileDownloadCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (fdc *FileDownloadCreate) defaults() {
+	if _, ok := fdc.mutation.Sha256(); !ok {
+		v := filedownload.DefaultSha256
+		fdc.mutation.SetSha256(v)
+	}
 	if _, ok := fdc.mutation.ID(); !ok {
 		v := filedownload.DefaultID()
 		fdc.mutation.SetID(v)
@@ -215,6 +240,9 @@ func (fdc *FileDownloadCreate) check() error {
 	if _, ok := fdc.mutation.Tags(); !ok {
 		return &ValidationError{Name: "tags", err: errors.New(`ent: missing required field "tags"`)}
 	}
+	if _, ok := fdc.mutation.Sha256(); !ok {
+		return &ValidationError{Name: "sha256", err: errors.New(`ent: missing required field "sha256"`)}
+	}
 	return nil
 }
 
@@ -327,6 +355,22 @@ func (fdc *FileDownloadCreate) createSpec() (*FileDownload, *sqlgraph.CreateSpec
 		})
 		_node.Tags = value
 	}
+	if value, ok := fdc.mutation.Retry(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: filedownload.FieldRetry,
+		})
+		_node.Retry = value
+	}
+	if value, ok := fdc.mutation.Sha256(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: filedownload.FieldSha256,
+		})
+		_node.Sha256 = value
+	}
 	if nodes := fdc.mutation.FileDownloadToEnvironmentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
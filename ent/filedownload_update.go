@@ -12,6 +12,7 @@ import (
 	"github.com/gen0cide/laforge/ent/environment"
 	"github.com/gen0cide/laforge/ent/filedownload"
 	"github.com/gen0cide/laforge/ent/predicate"
+	"github.com/gen0cide/laforge/retry"
 	"github.com/google/uuid"
 )
 
@@ -88,6 +89,32 @@ func (fdu *FileDownloadUpdate) SetTags(m map[string]string) *FileDownloadUpdate
 	return fdu
 }
 
+// SetRetry sets the "retry" field.
+func (fdu *FileDownloadUpdate) SetRetry(r *retry.Policy) *FileDownloadUpdate {
+	fdu.mutation.SetRetry(r)
+	return fdu
+}
+
+// ClearRetry clears the value of the "retry" field.
+func (fdu *FileDownloadUpdate) ClearRetry() *FileDownloadUpdate {
+	fdu.mutation.ClearRetry()
+	return fdu
+}
+
+// SetSha256 sets the "sha256" field.
+func (fdu *FileDownloadUpdate) SetSha256(s string) *FileDownloadUpdate {
+	fdu.mutation.SetSha256(s)
+	return fdu
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (fdu *FileDownloadUpdate) SetNillableSha256(s *string) *FileDownloadUpdate {
+	if s != nil {
+		fdu.SetSha256(*s)
+	}
+	return fdu
+}
+
 // SetFileDownloadToEnvironmentID sets the "FileDownloadToEnvironment" edge to the Environment entity by ID.
 func (fdu *FileDownloadUpdate) SetFileDownloadToEnvironmentID(id uuid.UUID) *FileDownloadUpdate {
 	fdu.mutation.SetFileDownloadToEnvironmentID(id)
@@ -260,6 +287,26 @@ func (fdu *FileDownloadUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: filedownload.FieldTags,
 		})
 	}
+	if value, ok := fdu.mutation.Retry(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: filedownload.FieldRetry,
+		})
+	}
+	if fdu.mutation.RetryCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: filedownload.FieldRetry,
+		})
+	}
+	if value, ok := fdu.mutation.Sha256(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: filedownload.FieldSha256,
+		})
+	}
 	if fdu.mutation.FileDownloadToEnvironmentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -374,6 +421,32 @@ func (fduo *FileDownloadUpdateOne) SetTags(m map[string]string) *FileDownloadUpd
 	return fduo
 }
 
+// SetRetry sets the "retry" field.
+func (fduo *FileDownloadUpdateOne) SetRetry(r *retry.Policy) *FileDownloadUpdateOne {
+	fduo.mutation.SetRetry(r)
+	return fduo
+}
+
+// ClearRetry clears the value of the "retry" field.
+func (fduo *FileDownloadUpdateOne) ClearRetry() *FileDownloadUpdateOne {
+	fduo.mutation.ClearRetry()
+	return fduo
+}
+
+// SetSha256 sets the "sha256" field.
+func (fduo *FileDownloadUpdateOne) SetSha256(s string) *FileDownloadUpdateOne {
+	fduo.mutation.SetSha256(s)
+	return fduo
+}
+
+// SetNillableSha256 sets the "sha256" field if the given value is not nil.
+func (fduo *FileDownloadUpdateOne) SetNillableSha256(s *string) *FileDownloadUpdateOne {
+	if s != nil {
+		fduo.SetSha256(*s)
+	}
+	return fduo
+}
+
 // SetFileDownloadToEnvironmentID sets the "FileDownloadToEnvironment" edge to the Environment entity by ID.
 func (fduo *FileDownloadUpdateOne) SetFileDownloadToEnvironmentID(id uuid.UUID) *FileDownloadUpdateOne {
 	fduo.mutation.SetFileDownloadToEnvironmentID(id)
@@ -570,6 +643,26 @@ func (fduo *FileDownloadUpdateOne) sqlSave(ctx context.Context) (_node *FileDown
 			Column: filedownload.FieldTags,
 		})
 	}
+	if value, ok := fduo.mutation.Retry(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: filedownload.FieldRetry,
+		})
+	}
+	if fduo.mutation.RetryCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: filedownload.FieldRetry,
+		})
+	}
+	if value, ok := fduo.mutation.Sha256(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: filedownload.FieldSha256,
+		})
+	}
 	if fduo.mutation.FileDownloadToEnvironmentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/gen0cide/laforge/ent/environment"
 	"github.com/gen0cide/laforge/ent/fileextract"
+	"github.com/gen0cide/laforge/retry"
 	"github.com/google/uuid"
 )
 
@@ -28,6 +29,8 @@ type FileExtract struct {
 	Type string `json:"type,omitempty" hcl:"type,attr"`
 	// Tags holds the value of the "tags" field.
 	Tags map[string]string `json:"tags,omitempty" hcl:"tags,optional"`
+	// Retry holds the value of the "retry" field.
+	Retry *retry.Policy `json:"retry,omitempty" hcl:"retry,block"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the FileExtractQuery when eager-loading is set.
 	Edges FileExtractEdges `json:"edges"`
@@ -67,7 +70,7 @@ func (*FileExtract) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case fileextract.FieldTags:
+		case fileextract.FieldTags, fileextract.FieldRetry:
 			values[i] = new([]byte)
 		case fileextract.FieldHclID, fileextract.FieldSource, fileextract.FieldDestination, fileextract.FieldType:
 			values[i] = new(sql.NullString)
@@ -128,6 +131,14 @@ func (fe *FileExtract) assignValues(columns []string, values []interface{}) erro
 					return fmt.Errorf("unmarshal field tags: %w", err)
 				}
 			}
+		case fileextract.FieldRetry:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field retry", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &fe.Retry); err != nil {
+					return fmt.Errorf("unmarshal field retry: %w", err)
+				}
+			}
 		case fileextract.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field environment_environment_to_file_extract", values[i])
@@ -178,6 +189,8 @@ func (fe *FileExtract) String() string {
 	builder.WriteString(fe.Type)
 	builder.WriteString(", tags=")
 	builder.WriteString(fmt.Sprintf("%v", fe.Tags))
+	builder.WriteString(", retry=")
+	builder.WriteString(fmt.Sprintf("%v", fe.Retry))
 	builder.WriteByte(')')
 	return builder.String()
 }
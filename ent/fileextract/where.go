@@ -564,6 +564,20 @@ func TypeContainsFold(v string) predicate.FileExtract {
 	})
 }
 
+// RetryIsNil applies the IsNil predicate on the "retry" field.
+func RetryIsNil() predicate.FileExtract {
+	return predicate.FileExtract(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRetry)))
+	})
+}
+
+// RetryNotNil applies the NotNil predicate on the "retry" field.
+func RetryNotNil() predicate.FileExtract {
+	return predicate.FileExtract(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRetry)))
+	})
+}
+
 // HasFileExtractToEnvironment applies the HasEdge predicate on the "FileExtractToEnvironment" edge.
 func HasFileExtractToEnvironment() predicate.FileExtract {
 	return predicate.FileExtract(func(s *sql.Selector) {
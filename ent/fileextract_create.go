@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/gen0cide/laforge/ent/environment"
 	"github.com/gen0cide/laforge/ent/fileextract"
+	"github.com/gen0cide/laforge/retry"
 	"github.com/google/uuid"
 )
 
@@ -51,6 +52,12 @@ func (fec *FileExtractCreate) SetTags(m map[string]string) *FileExtractCreate {
 	return fec
 }
 
+// SetRetry sets the "retry" field.
+func (fec *FileExtractCreate) SetRetry(r *retry.Policy) *FileExtractCreate {
+	fec.mutation.SetRetry(r)
+	return fec
+}
+
 // SetID sets the "id" field.
 func (fec *FileExtractCreate) SetID(u uuid.UUID) *FileExtractCreate {
 	fec.mutation.SetID(u)
@@ -242,6 +249,14 @@ func (fec *FileExtractCreate) createSpec() (*FileExtract, *sqlgraph.CreateSpec)
 		})
 		_node.Tags = value
 	}
+	if value, ok := fec.mutation.Retry(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: fileextract.FieldRetry,
+		})
+		_node.Retry = value
+	}
 	if nodes := fec.mutation.FileExtractToEnvironmentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
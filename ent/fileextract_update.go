@@ -12,6 +12,7 @@ import (
 	"github.com/gen0cide/laforge/ent/environment"
 	"github.com/gen0cide/laforge/ent/fileextract"
 	"github.com/gen0cide/laforge/ent/predicate"
+	"github.com/gen0cide/laforge/retry"
 	"github.com/google/uuid"
 )
 
@@ -58,6 +59,18 @@ func (feu *FileExtractUpdate) SetTags(m map[string]string) *FileExtractUpdate {
 	return feu
 }
 
+// SetRetry sets the "retry" field.
+func (feu *FileExtractUpdate) SetRetry(r *retry.Policy) *FileExtractUpdate {
+	feu.mutation.SetRetry(r)
+	return feu
+}
+
+// ClearRetry clears the value of the "retry" field.
+func (feu *FileExtractUpdate) ClearRetry() *FileExtractUpdate {
+	feu.mutation.ClearRetry()
+	return feu
+}
+
 // SetFileExtractToEnvironmentID sets the "FileExtractToEnvironment" edge to the Environment entity by ID.
 func (feu *FileExtractUpdate) SetFileExtractToEnvironmentID(id uuid.UUID) *FileExtractUpdate {
 	feu.mutation.SetFileExtractToEnvironmentID(id)
@@ -195,6 +208,19 @@ func (feu *FileExtractUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fileextract.FieldTags,
 		})
 	}
+	if value, ok := feu.mutation.Retry(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: fileextract.FieldRetry,
+		})
+	}
+	if feu.mutation.RetryCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: fileextract.FieldRetry,
+		})
+	}
 	if feu.mutation.FileExtractToEnvironmentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -279,6 +305,18 @@ func (feuo *FileExtractUpdateOne) SetTags(m map[string]string) *FileExtractUpdat
 	return feuo
 }
 
+// SetRetry sets the "retry" field.
+func (feuo *FileExtractUpdateOne) SetRetry(r *retry.Policy) *FileExtractUpdateOne {
+	feuo.mutation.SetRetry(r)
+	return feuo
+}
+
+// ClearRetry clears the value of the "retry" field.
+func (feuo *FileExtractUpdateOne) ClearRetry() *FileExtractUpdateOne {
+	feuo.mutation.ClearRetry()
+	return feuo
+}
+
 // SetFileExtractToEnvironmentID sets the "FileExtractToEnvironment" edge to the Environment entity by ID.
 func (feuo *FileExtractUpdateOne) SetFileExtractToEnvironmentID(id uuid.UUID) *FileExtractUpdateOne {
 	feuo.mutation.SetFileExtractToEnvironmentID(id)
@@ -440,6 +478,19 @@ func (feuo *FileExtractUpdateOne) sqlSave(ctx context.Context) (_node *FileExtra
 			Column: fileextract.FieldTags,
 		})
 	}
+	if value, ok := feuo.mutation.Retry(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: fileextract.FieldRetry,
+		})
+	}
+	if feuo.mutation.RetryCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: fileextract.FieldRetry,
+		})
+	}
 	if feuo.mutation.FileExtractToEnvironmentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
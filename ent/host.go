@@ -29,8 +29,12 @@ type Host struct {
 	OS string `json:"OS,omitempty" hcl:"os,attr"`
 	// LastOctet holds the value of the "last_octet" field.
 	LastOctet int `json:"last_octet,omitempty" hcl:"last_octet,attr"`
+	// Cpus holds the value of the "cpus" field.
+	Cpus int `json:"cpus,omitempty" hcl:"cpus,optional"`
+	// MemoryMB holds the value of the "memory_mb" field.
+	MemoryMB int `json:"memory_mb,omitempty" hcl:"memory_mb,optional"`
 	// InstanceSize holds the value of the "instance_size" field.
-	InstanceSize string `json:"instance_size,omitempty" hcl:"instance_size,attr"`
+	InstanceSize string `json:"instance_size,omitempty" hcl:"instance_size,optional"`
 	// AllowMACChanges holds the value of the "allow_mac_changes" field.
 	AllowMACChanges bool `json:"allow_mac_changes,omitempty" hcl:"allow_mac_changes,optional"`
 	// ExposedTCPPorts holds the value of the "exposed_tcp_ports" field.
@@ -45,6 +49,8 @@ type Host struct {
 	UserGroups []string `json:"user_groups,omitempty" hcl:"user_groups,optional"`
 	// ProvisionSteps holds the value of the "provision_steps" field.
 	ProvisionSteps []string `json:"provision_steps,omitempty" hcl:"provision_steps,optional"`
+	// AdditionalDisks holds the value of the "additional_disks" field.
+	AdditionalDisks []int `json:"additional_disks,omitempty" hcl:"additional_disks,optional"`
 	// Tags holds the value of the "tags" field.
 	Tags map[string]string `json:"tags,omitempty" hcl:"tags,optional"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -156,11 +162,11 @@ func (*Host) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case host.FieldExposedTCPPorts, host.FieldExposedUDPPorts, host.FieldVars, host.FieldUserGroups, host.FieldProvisionSteps, host.FieldTags:
+		case host.FieldExposedTCPPorts, host.FieldExposedUDPPorts, host.FieldVars, host.FieldUserGroups, host.FieldProvisionSteps, host.FieldAdditionalDisks, host.FieldTags:
 			values[i] = new([]byte)
 		case host.FieldAllowMACChanges:
 			values[i] = new(sql.NullBool)
-		case host.FieldLastOctet:
+		case host.FieldLastOctet, host.FieldCpus, host.FieldMemoryMB:
 			values[i] = new(sql.NullInt64)
 		case host.FieldHclID, host.FieldHostname, host.FieldDescription, host.FieldOS, host.FieldInstanceSize, host.FieldOverridePassword:
 			values[i] = new(sql.NullString)
@@ -219,6 +225,18 @@ func (h *Host) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				h.LastOctet = int(value.Int64)
 			}
+		case host.FieldCpus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cpus", values[i])
+			} else if value.Valid {
+				h.Cpus = int(value.Int64)
+			}
+		case host.FieldMemoryMB:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field memory_mb", values[i])
+			} else if value.Valid {
+				h.MemoryMB = int(value.Int64)
+			}
 		case host.FieldInstanceSize:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field instance_size", values[i])
@@ -277,6 +295,14 @@ func (h *Host) assignValues(columns []string, values []interface{}) error {
 					return fmt.Errorf("unmarshal field provision_steps: %w", err)
 				}
 			}
+		case host.FieldAdditionalDisks:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field additional_disks", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &h.AdditionalDisks); err != nil {
+					return fmt.Errorf("unmarshal field additional_disks: %w", err)
+				}
+			}
 		case host.FieldTags:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field tags", values[i])
@@ -360,6 +386,10 @@ func (h *Host) String() string {
 	builder.WriteString(h.OS)
 	builder.WriteString(", last_octet=")
 	builder.WriteString(fmt.Sprintf("%v", h.LastOctet))
+	builder.WriteString(", cpus=")
+	builder.WriteString(fmt.Sprintf("%v", h.Cpus))
+	builder.WriteString(", memory_mb=")
+	builder.WriteString(fmt.Sprintf("%v", h.MemoryMB))
 	builder.WriteString(", instance_size=")
 	builder.WriteString(h.InstanceSize)
 	builder.WriteString(", allow_mac_changes=")
@@ -376,6 +406,8 @@ func (h *Host) String() string {
 	builder.WriteString(fmt.Sprintf("%v", h.UserGroups))
 	builder.WriteString(", provision_steps=")
 	builder.WriteString(fmt.Sprintf("%v", h.ProvisionSteps))
+	builder.WriteString(", additional_disks=")
+	builder.WriteString(fmt.Sprintf("%v", h.AdditionalDisks))
 	builder.WriteString(", tags=")
 	builder.WriteString(fmt.Sprintf("%v", h.Tags))
 	builder.WriteByte(')')
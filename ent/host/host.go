@@ -21,6 +21,10 @@ const (
 	FieldOS = "os"
 	// FieldLastOctet holds the string denoting the last_octet field in the database.
 	FieldLastOctet = "last_octet"
+	// FieldCpus holds the string denoting the cpus field in the database.
+	FieldCpus = "cpus"
+	// FieldMemoryMB holds the string denoting the memory_mb field in the database.
+	FieldMemoryMB = "memory_mb"
 	// FieldInstanceSize holds the string denoting the instance_size field in the database.
 	FieldInstanceSize = "instance_size"
 	// FieldAllowMACChanges holds the string denoting the allow_mac_changes field in the database.
@@ -37,6 +41,8 @@ const (
 	FieldUserGroups = "user_groups"
 	// FieldProvisionSteps holds the string denoting the provision_steps field in the database.
 	FieldProvisionSteps = "provision_steps"
+	// FieldAdditionalDisks holds the string denoting the additional_disks field in the database.
+	FieldAdditionalDisks = "additional_disks"
 	// FieldTags holds the string denoting the tags field in the database.
 	FieldTags = "tags"
 	// EdgeHostToDisk holds the string denoting the hosttodisk edge name in mutations.
@@ -103,6 +109,8 @@ var Columns = []string{
 	FieldDescription,
 	FieldOS,
 	FieldLastOctet,
+	FieldCpus,
+	FieldMemoryMB,
 	FieldInstanceSize,
 	FieldAllowMACChanges,
 	FieldExposedTCPPorts,
@@ -111,6 +119,7 @@ var Columns = []string{
 	FieldVars,
 	FieldUserGroups,
 	FieldProvisionSteps,
+	FieldAdditionalDisks,
 	FieldTags,
 }
 
@@ -127,6 +127,20 @@ func LastOctet(v int) predicate.Host {
 	})
 }
 
+// Cpus applies equality check predicate on the "cpus" field. It's identical to CpusEQ.
+func Cpus(v int) predicate.Host {
+	return predicate.Host(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCpus), v))
+	})
+}
+
+// MemoryMB applies equality check predicate on the "memory_mb" field. It's identical to MemoryMBEQ.
+func MemoryMB(v int) predicate.Host {
+	return predicate.Host(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMemoryMB), v))
+	})
+}
+
 // InstanceSize applies equality check predicate on the "instance_size" field. It's identical to InstanceSizeEQ.
 func InstanceSize(v string) predicate.Host {
 	return predicate.Host(func(s *sql.Selector) {
@@ -668,6 +682,186 @@ func LastOctetLTE(v int) predicate.Host {
 	})
 }
 
+// CpusEQ applies the EQ predicate on the "cpus" field.
+func CpusEQ(v int) predicate.Host {
+	return predicate.Host(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCpus), v))
+	})
+}
+
+// CpusNEQ applies the NEQ predicate on the "cpus" field.
+func CpusNEQ(v int) predicate.Host {
+	return predicate.Host(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCpus), v))
+	})
+}
+
+// CpusIn applies the In predicate on the "cpus" field.
+func CpusIn(vs ...int) predicate.Host {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Host(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCpus), v...))
+	})
+}
+
+// CpusNotIn applies the NotIn predicate on the "cpus" field.
+func CpusNotIn(vs ...int) predicate.Host {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Host(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCpus), v...))
+	})
+}
+
+// CpusGT applies the GT predicate on the "cpus" field.
+func CpusGT(v int) predicate.Host {
+	return predicate.Host(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCpus), v))
+	})
+}
+
+// CpusGTE applies the GTE predicate on the "cpus" field.
+func CpusGTE(v int) predicate.Host {
+	return predicate.Host(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCpus), v))
+	})
+}
+
+// CpusLT applies the LT predicate on the "cpus" field.
+func CpusLT(v int) predicate.Host {
+	return predicate.Host(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCpus), v))
+	})
+}
+
+// CpusLTE applies the LTE predicate on the "cpus" field.
+func CpusLTE(v int) predicate.Host {
+	return predicate.Host(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCpus), v))
+	})
+}
+
+// CpusIsNil applies the IsNil predicate on the "cpus" field.
+func CpusIsNil() predicate.Host {
+	return predicate.Host(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldCpus)))
+	})
+}
+
+// CpusNotNil applies the NotNil predicate on the "cpus" field.
+func CpusNotNil() predicate.Host {
+	return predicate.Host(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldCpus)))
+	})
+}
+
+// MemoryMBEQ applies the EQ predicate on the "memory_mb" field.
+func MemoryMBEQ(v int) predicate.Host {
+	return predicate.Host(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldMemoryMB), v))
+	})
+}
+
+// MemoryMBNEQ applies the NEQ predicate on the "memory_mb" field.
+func MemoryMBNEQ(v int) predicate.Host {
+	return predicate.Host(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldMemoryMB), v))
+	})
+}
+
+// MemoryMBIn applies the In predicate on the "memory_mb" field.
+func MemoryMBIn(vs ...int) predicate.Host {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Host(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldMemoryMB), v...))
+	})
+}
+
+// MemoryMBNotIn applies the NotIn predicate on the "memory_mb" field.
+func MemoryMBNotIn(vs ...int) predicate.Host {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Host(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldMemoryMB), v...))
+	})
+}
+
+// MemoryMBGT applies the GT predicate on the "memory_mb" field.
+func MemoryMBGT(v int) predicate.Host {
+	return predicate.Host(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldMemoryMB), v))
+	})
+}
+
+// MemoryMBGTE applies the GTE predicate on the "memory_mb" field.
+func MemoryMBGTE(v int) predicate.Host {
+	return predicate.Host(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldMemoryMB), v))
+	})
+}
+
+// MemoryMBLT applies the LT predicate on the "memory_mb" field.
+func MemoryMBLT(v int) predicate.Host {
+	return predicate.Host(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldMemoryMB), v))
+	})
+}
+
+// MemoryMBLTE applies the LTE predicate on the "memory_mb" field.
+func MemoryMBLTE(v int) predicate.Host {
+	return predicate.Host(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldMemoryMB), v))
+	})
+}
+
+// MemoryMBIsNil applies the IsNil predicate on the "memory_mb" field.
+func MemoryMBIsNil() predicate.Host {
+	return predicate.Host(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldMemoryMB)))
+	})
+}
+
+// MemoryMBNotNil applies the NotNil predicate on the "memory_mb" field.
+func MemoryMBNotNil() predicate.Host {
+	return predicate.Host(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldMemoryMB)))
+	})
+}
+
 // InstanceSizeEQ applies the EQ predicate on the "instance_size" field.
 func InstanceSizeEQ(v string) predicate.Host {
 	return predicate.Host(func(s *sql.Selector) {
@@ -918,6 +1112,20 @@ func ProvisionStepsNotNil() predicate.Host {
 	})
 }
 
+// AdditionalDisksIsNil applies the IsNil predicate on the "additional_disks" field.
+func AdditionalDisksIsNil() predicate.Host {
+	return predicate.Host(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAdditionalDisks)))
+	})
+}
+
+// AdditionalDisksNotNil applies the NotNil predicate on the "additional_disks" field.
+func AdditionalDisksNotNil() predicate.Host {
+	return predicate.Host(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAdditionalDisks)))
+	})
+}
+
 // HasHostToDisk applies the HasEdge predicate on the "HostToDisk" edge.
 func HasHostToDisk() predicate.Host {
 	return predicate.Host(func(s *sql.Selector) {
@@ -55,6 +55,34 @@ func (hc *HostCreate) SetLastOctet(i int) *HostCreate {
 	return hc
 }
 
+// SetCpus sets the "cpus" field.
+func (hc *HostCreate) SetCpus(i int) *HostCreate {
+	hc.mutation.SetCpus(i)
+	return hc
+}
+
+// SetNillableCpus sets the "cpus" field if the given value is not nil.
+func (hc *HostCreate) SetNillableCpus(i *int) *HostCreate {
+	if i != nil {
+		hc.SetCpus(*i)
+	}
+	return hc
+}
+
+// SetMemoryMB sets the "memory_mb" field.
+func (hc *HostCreate) SetMemoryMB(i int) *HostCreate {
+	hc.mutation.SetMemoryMB(i)
+	return hc
+}
+
+// SetNillableMemoryMB sets the "memory_mb" field if the given value is not nil.
+func (hc *HostCreate) SetNillableMemoryMB(i *int) *HostCreate {
+	if i != nil {
+		hc.SetMemoryMB(*i)
+	}
+	return hc
+}
+
 // SetInstanceSize sets the "instance_size" field.
 func (hc *HostCreate) SetInstanceSize(s string) *HostCreate {
 	hc.mutation.SetInstanceSize(s)
@@ -103,6 +131,12 @@ func (hc *HostCreate) SetProvisionSteps(s []string) *HostCreate {
 	return hc
 }
 
+// SetAdditionalDisks sets the "additional_disks" field.
+func (hc *HostCreate) SetAdditionalDisks(i []int) *HostCreate {
+	hc.mutation.SetAdditionalDisks(i)
+	return hc
+}
+
 // SetTags sets the "tags" field.
 func (hc *HostCreate) SetTags(m map[string]string) *HostCreate {
 	hc.mutation.SetTags(m)
@@ -403,6 +437,22 @@ func (hc *HostCreate) createSpec() (*Host, *sqlgraph.CreateSpec) {
 		})
 		_node.LastOctet = value
 	}
+	if value, ok := hc.mutation.Cpus(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: host.FieldCpus,
+		})
+		_node.Cpus = value
+	}
+	if value, ok := hc.mutation.MemoryMB(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: host.FieldMemoryMB,
+		})
+		_node.MemoryMB = value
+	}
 	if value, ok := hc.mutation.InstanceSize(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -467,6 +517,14 @@ func (hc *HostCreate) createSpec() (*Host, *sqlgraph.CreateSpec) {
 		})
 		_node.ProvisionSteps = value
 	}
+	if value, ok := hc.mutation.AdditionalDisks(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: host.FieldAdditionalDisks,
+		})
+		_node.AdditionalDisks = value
+	}
 	if value, ok := hc.mutation.Tags(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
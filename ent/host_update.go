@@ -69,6 +69,60 @@ func (hu *HostUpdate) AddLastOctet(i int) *HostUpdate {
 	return hu
 }
 
+// SetCpus sets the "cpus" field.
+func (hu *HostUpdate) SetCpus(i int) *HostUpdate {
+	hu.mutation.ResetCpus()
+	hu.mutation.SetCpus(i)
+	return hu
+}
+
+// SetNillableCpus sets the "cpus" field if the given value is not nil.
+func (hu *HostUpdate) SetNillableCpus(i *int) *HostUpdate {
+	if i != nil {
+		hu.SetCpus(*i)
+	}
+	return hu
+}
+
+// AddCpus adds i to the "cpus" field.
+func (hu *HostUpdate) AddCpus(i int) *HostUpdate {
+	hu.mutation.AddCpus(i)
+	return hu
+}
+
+// ClearCpus clears the value of the "cpus" field.
+func (hu *HostUpdate) ClearCpus() *HostUpdate {
+	hu.mutation.ClearCpus()
+	return hu
+}
+
+// SetMemoryMB sets the "memory_mb" field.
+func (hu *HostUpdate) SetMemoryMB(i int) *HostUpdate {
+	hu.mutation.ResetMemoryMB()
+	hu.mutation.SetMemoryMB(i)
+	return hu
+}
+
+// SetNillableMemoryMB sets the "memory_mb" field if the given value is not nil.
+func (hu *HostUpdate) SetNillableMemoryMB(i *int) *HostUpdate {
+	if i != nil {
+		hu.SetMemoryMB(*i)
+	}
+	return hu
+}
+
+// AddMemoryMB adds i to the "memory_mb" field.
+func (hu *HostUpdate) AddMemoryMB(i int) *HostUpdate {
+	hu.mutation.AddMemoryMB(i)
+	return hu
+}
+
+// ClearMemoryMB clears the value of the "memory_mb" field.
+func (hu *HostUpdate) ClearMemoryMB() *HostUpdate {
+	hu.mutation.ClearMemoryMB()
+	return hu
+}
+
 // SetInstanceSize sets the "instance_size" field.
 func (hu *HostUpdate) SetInstanceSize(s string) *HostUpdate {
 	hu.mutation.SetInstanceSize(s)
@@ -123,6 +177,18 @@ func (hu *HostUpdate) ClearProvisionSteps() *HostUpdate {
 	return hu
 }
 
+// SetAdditionalDisks sets the "additional_disks" field.
+func (hu *HostUpdate) SetAdditionalDisks(i []int) *HostUpdate {
+	hu.mutation.SetAdditionalDisks(i)
+	return hu
+}
+
+// ClearAdditionalDisks clears the value of the "additional_disks" field.
+func (hu *HostUpdate) ClearAdditionalDisks() *HostUpdate {
+	hu.mutation.ClearAdditionalDisks()
+	return hu
+}
+
 // SetTags sets the "tags" field.
 func (hu *HostUpdate) SetTags(m map[string]string) *HostUpdate {
 	hu.mutation.SetTags(m)
@@ -442,6 +508,46 @@ func (hu *HostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: host.FieldLastOctet,
 		})
 	}
+	if value, ok := hu.mutation.Cpus(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: host.FieldCpus,
+		})
+	}
+	if value, ok := hu.mutation.AddedCpus(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: host.FieldCpus,
+		})
+	}
+	if hu.mutation.CpusCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: host.FieldCpus,
+		})
+	}
+	if value, ok := hu.mutation.MemoryMB(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: host.FieldMemoryMB,
+		})
+	}
+	if value, ok := hu.mutation.AddedMemoryMB(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: host.FieldMemoryMB,
+		})
+	}
+	if hu.mutation.MemoryMBCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: host.FieldMemoryMB,
+		})
+	}
 	if value, ok := hu.mutation.InstanceSize(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -504,6 +610,19 @@ func (hu *HostUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: host.FieldProvisionSteps,
 		})
 	}
+	if value, ok := hu.mutation.AdditionalDisks(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: host.FieldAdditionalDisks,
+		})
+	}
+	if hu.mutation.AdditionalDisksCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: host.FieldAdditionalDisks,
+		})
+	}
 	if value, ok := hu.mutation.Tags(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -853,6 +972,60 @@ func (huo *HostUpdateOne) AddLastOctet(i int) *HostUpdateOne {
 	return huo
 }
 
+// SetCpus sets the "cpus" field.
+func (huo *HostUpdateOne) SetCpus(i int) *HostUpdateOne {
+	huo.mutation.ResetCpus()
+	huo.mutation.SetCpus(i)
+	return huo
+}
+
+// SetNillableCpus sets the "cpus" field if the given value is not nil.
+func (huo *HostUpdateOne) SetNillableCpus(i *int) *HostUpdateOne {
+	if i != nil {
+		huo.SetCpus(*i)
+	}
+	return huo
+}
+
+// AddCpus adds i to the "cpus" field.
+func (huo *HostUpdateOne) AddCpus(i int) *HostUpdateOne {
+	huo.mutation.AddCpus(i)
+	return huo
+}
+
+// ClearCpus clears the value of the "cpus" field.
+func (huo *HostUpdateOne) ClearCpus() *HostUpdateOne {
+	huo.mutation.ClearCpus()
+	return huo
+}
+
+// SetMemoryMB sets the "memory_mb" field.
+func (huo *HostUpdateOne) SetMemoryMB(i int) *HostUpdateOne {
+	huo.mutation.ResetMemoryMB()
+	huo.mutation.SetMemoryMB(i)
+	return huo
+}
+
+// SetNillableMemoryMB sets the "memory_mb" field if the given value is not nil.
+func (huo *HostUpdateOne) SetNillableMemoryMB(i *int) *HostUpdateOne {
+	if i != nil {
+		huo.SetMemoryMB(*i)
+	}
+	return huo
+}
+
+// AddMemoryMB adds i to the "memory_mb" field.
+func (huo *HostUpdateOne) AddMemoryMB(i int) *HostUpdateOne {
+	huo.mutation.AddMemoryMB(i)
+	return huo
+}
+
+// ClearMemoryMB clears the value of the "memory_mb" field.
+func (huo *HostUpdateOne) ClearMemoryMB() *HostUpdateOne {
+	huo.mutation.ClearMemoryMB()
+	return huo
+}
+
 // SetInstanceSize sets the "instance_size" field.
 func (huo *HostUpdateOne) SetInstanceSize(s string) *HostUpdateOne {
 	huo.mutation.SetInstanceSize(s)
@@ -907,6 +1080,18 @@ func (huo *HostUpdateOne) ClearProvisionSteps() *HostUpdateOne {
 	return huo
 }
 
+// SetAdditionalDisks sets the "additional_disks" field.
+func (huo *HostUpdateOne) SetAdditionalDisks(i []int) *HostUpdateOne {
+	huo.mutation.SetAdditionalDisks(i)
+	return huo
+}
+
+// ClearAdditionalDisks clears the value of the "additional_disks" field.
+func (huo *HostUpdateOne) ClearAdditionalDisks() *HostUpdateOne {
+	huo.mutation.ClearAdditionalDisks()
+	return huo
+}
+
 // SetTags sets the "tags" field.
 func (huo *HostUpdateOne) SetTags(m map[string]string) *HostUpdateOne {
 	huo.mutation.SetTags(m)
@@ -1250,6 +1435,46 @@ func (huo *HostUpdateOne) sqlSave(ctx context.Context) (_node *Host, err error)
 			Column: host.FieldLastOctet,
 		})
 	}
+	if value, ok := huo.mutation.Cpus(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: host.FieldCpus,
+		})
+	}
+	if value, ok := huo.mutation.AddedCpus(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: host.FieldCpus,
+		})
+	}
+	if huo.mutation.CpusCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: host.FieldCpus,
+		})
+	}
+	if value, ok := huo.mutation.MemoryMB(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: host.FieldMemoryMB,
+		})
+	}
+	if value, ok := huo.mutation.AddedMemoryMB(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: host.FieldMemoryMB,
+		})
+	}
+	if huo.mutation.MemoryMBCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: host.FieldMemoryMB,
+		})
+	}
 	if value, ok := huo.mutation.InstanceSize(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -1312,6 +1537,19 @@ func (huo *HostUpdateOne) sqlSave(ctx context.Context) (_node *Host, err error)
 			Column: host.FieldProvisionSteps,
 		})
 	}
+	if value, ok := huo.mutation.AdditionalDisks(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: host.FieldAdditionalDisks,
+		})
+	}
+	if huo.mutation.AdditionalDisksCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: host.FieldAdditionalDisks,
+		})
+	}
 	if value, ok := huo.mutation.Tags(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -87,8 +87,10 @@ var (
 		{Name: "command", Type: field.TypeEnum, Enums: []string{"DEFAULT", "DELETE", "REBOOT", "EXTRACT", "DOWNLOAD", "CREATEUSER", "CREATEUSERPASS", "ADDTOGROUP", "EXECUTE", "VALIDATE", "CHANGEPERMS", "APPENDFILE"}},
 		{Name: "args", Type: field.TypeString},
 		{Name: "number", Type: field.TypeInt},
+		{Name: "timeout", Type: field.TypeInt, Default: 0},
+		{Name: "attempt", Type: field.TypeInt, Default: 1},
 		{Name: "output", Type: field.TypeString, Default: ""},
-		{Name: "state", Type: field.TypeEnum, Enums: []string{"AWAITING", "INPROGRESS", "FAILED", "COMPLETE"}},
+		{Name: "state", Type: field.TypeEnum, Enums: []string{"AWAITING", "INPROGRESS", "FAILED", "COMPLETE", "CANCELLED"}},
 		{Name: "error_message", Type: field.TypeString, Default: ""},
 		{Name: "agent_task_agent_task_to_provisioning_step", Type: field.TypeUUID, Nullable: true},
 		{Name: "agent_task_agent_task_to_provisioned_host", Type: field.TypeUUID, Nullable: true},
@@ -101,13 +103,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "agent_tasks_provisioning_steps_AgentTaskToProvisioningStep",
-				Columns:    []*schema.Column{AgentTasksColumns[7]},
+				Columns:    []*schema.Column{AgentTasksColumns[9]},
 				RefColumns: []*schema.Column{ProvisioningStepsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "agent_tasks_provisioned_hosts_AgentTaskToProvisionedHost",
-				Columns:    []*schema.Column{AgentTasksColumns[8]},
+				Columns:    []*schema.Column{AgentTasksColumns[10]},
 				RefColumns: []*schema.Column{ProvisionedHostsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -176,6 +178,8 @@ var (
 		{Name: "type", Type: field.TypeEnum, Enums: []string{"ROOT", "REBUILD", "DELETE"}},
 		{Name: "revision", Type: field.TypeInt},
 		{Name: "state", Type: field.TypeEnum, Enums: []string{"PLANNING", "INPROGRESS", "APPLIED", "CANCELLED", "APPROVED"}},
+		{Name: "created_by", Type: field.TypeString, Default: ""},
+		{Name: "approvals", Type: field.TypeJSON, Nullable: true},
 		{Name: "build_commit_build_commit_to_build", Type: field.TypeUUID, Nullable: true},
 	}
 	// BuildCommitsTable holds the schema information for the "build_commits" table.
@@ -186,7 +190,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "build_commits_builds_BuildCommitToBuild",
-				Columns:    []*schema.Column{BuildCommitsColumns[4]},
+				Columns:    []*schema.Column{BuildCommitsColumns[6]},
 				RefColumns: []*schema.Column{BuildsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -206,6 +210,7 @@ var (
 		{Name: "timeout", Type: field.TypeInt},
 		{Name: "vars", Type: field.TypeJSON},
 		{Name: "tags", Type: field.TypeJSON},
+		{Name: "retry", Type: field.TypeJSON, Nullable: true},
 		{Name: "environment_environment_to_command", Type: field.TypeUUID, Nullable: true},
 	}
 	// CommandsTable holds the schema information for the "commands" table.
@@ -216,7 +221,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "commands_environments_EnvironmentToCommand",
-				Columns:    []*schema.Column{CommandsColumns[12]},
+				Columns:    []*schema.Column{CommandsColumns[13]},
 				RefColumns: []*schema.Column{EnvironmentsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -322,6 +327,7 @@ var (
 		{Name: "exposed_vdi_ports", Type: field.TypeJSON},
 		{Name: "config", Type: field.TypeJSON},
 		{Name: "tags", Type: field.TypeJSON},
+		{Name: "approval_policy", Type: field.TypeJSON, Nullable: true},
 	}
 	// EnvironmentsTable holds the schema information for the "environments" table.
 	EnvironmentsTable = &schema.Table{
@@ -335,6 +341,7 @@ var (
 		{Name: "hcl_id", Type: field.TypeString},
 		{Name: "path", Type: field.TypeString},
 		{Name: "tags", Type: field.TypeJSON},
+		{Name: "retry", Type: field.TypeJSON, Nullable: true},
 		{Name: "environment_environment_to_file_delete", Type: field.TypeUUID, Nullable: true},
 	}
 	// FileDeletesTable holds the schema information for the "file_deletes" table.
@@ -345,7 +352,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "file_deletes_environments_EnvironmentToFileDelete",
-				Columns:    []*schema.Column{FileDeletesColumns[4]},
+				Columns:    []*schema.Column{FileDeletesColumns[5]},
 				RefColumns: []*schema.Column{EnvironmentsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -364,6 +371,8 @@ var (
 		{Name: "md5", Type: field.TypeString},
 		{Name: "abs_path", Type: field.TypeString},
 		{Name: "tags", Type: field.TypeJSON},
+		{Name: "retry", Type: field.TypeJSON, Nullable: true},
+		{Name: "sha256", Type: field.TypeString, Default: ""},
 		{Name: "environment_environment_to_file_download", Type: field.TypeUUID, Nullable: true},
 	}
 	// FileDownloadsTable holds the schema information for the "file_downloads" table.
@@ -374,7 +383,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "file_downloads_environments_EnvironmentToFileDownload",
-				Columns:    []*schema.Column{FileDownloadsColumns[11]},
+				Columns:    []*schema.Column{FileDownloadsColumns[13]},
 				RefColumns: []*schema.Column{EnvironmentsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -388,6 +397,7 @@ var (
 		{Name: "destination", Type: field.TypeString},
 		{Name: "type", Type: field.TypeString},
 		{Name: "tags", Type: field.TypeJSON},
+		{Name: "retry", Type: field.TypeJSON, Nullable: true},
 		{Name: "environment_environment_to_file_extract", Type: field.TypeUUID, Nullable: true},
 	}
 	// FileExtractsTable holds the schema information for the "file_extracts" table.
@@ -398,7 +408,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "file_extracts_environments_EnvironmentToFileExtract",
-				Columns:    []*schema.Column{FileExtractsColumns[6]},
+				Columns:    []*schema.Column{FileExtractsColumns[7]},
 				RefColumns: []*schema.Column{EnvironmentsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -472,6 +482,8 @@ var (
 		{Name: "description", Type: field.TypeString},
 		{Name: "os", Type: field.TypeString},
 		{Name: "last_octet", Type: field.TypeInt},
+		{Name: "cpus", Type: field.TypeInt, Nullable: true},
+		{Name: "memory_mb", Type: field.TypeInt, Nullable: true},
 		{Name: "instance_size", Type: field.TypeString},
 		{Name: "allow_mac_changes", Type: field.TypeBool},
 		{Name: "exposed_tcp_ports", Type: field.TypeJSON},
@@ -480,6 +492,7 @@ var (
 		{Name: "vars", Type: field.TypeJSON},
 		{Name: "user_groups", Type: field.TypeJSON},
 		{Name: "provision_steps", Type: field.TypeJSON, Nullable: true},
+		{Name: "additional_disks", Type: field.TypeJSON, Nullable: true},
 		{Name: "tags", Type: field.TypeJSON},
 		{Name: "environment_environment_to_host", Type: field.TypeUUID, Nullable: true},
 	}
@@ -491,7 +504,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "hosts_environments_EnvironmentToHost",
-				Columns:    []*schema.Column{HostsColumns[15]},
+				Columns:    []*schema.Column{HostsColumns[18]},
 				RefColumns: []*schema.Column{EnvironmentsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -594,9 +607,11 @@ var (
 		{Name: "hcl_id", Type: field.TypeString},
 		{Name: "name", Type: field.TypeString},
 		{Name: "cidr", Type: field.TypeString},
+		{Name: "ipv6_cidr", Type: field.TypeString, Default: ""},
 		{Name: "vdi_visible", Type: field.TypeBool},
 		{Name: "vars", Type: field.TypeJSON},
 		{Name: "tags", Type: field.TypeJSON},
+		{Name: "reserved", Type: field.TypeJSON, Nullable: true},
 		{Name: "environment_environment_to_network", Type: field.TypeUUID, Nullable: true},
 	}
 	// NetworksTable holds the schema information for the "networks" table.
@@ -607,7 +622,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "networks_environments_EnvironmentToNetwork",
-				Columns:    []*schema.Column{NetworksColumns[7]},
+				Columns:    []*schema.Column{NetworksColumns[9]},
 				RefColumns: []*schema.Column{EnvironmentsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -619,6 +634,7 @@ var (
 		{Name: "step_number", Type: field.TypeInt},
 		{Name: "type", Type: field.TypeEnum, Enums: []string{"start_build", "start_team", "provision_network", "provision_host", "execute_step"}},
 		{Name: "build_id", Type: field.TypeString},
+		{Name: "checksum", Type: field.TypeString, Default: ""},
 		{Name: "plan_plan_to_build", Type: field.TypeUUID, Nullable: true},
 	}
 	// PlansTable holds the schema information for the "plans" table.
@@ -629,7 +645,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "plans_builds_PlanToBuild",
-				Columns:    []*schema.Column{PlansColumns[4]},
+				Columns:    []*schema.Column{PlansColumns[5]},
 				RefColumns: []*schema.Column{BuildsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -667,6 +683,7 @@ var (
 	ProvisionedHostsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "subnet_ip", Type: field.TypeString},
+		{Name: "subnet_ipv6", Type: field.TypeString, Default: ""},
 		{Name: "addon_type", Type: field.TypeEnum, Nullable: true, Enums: []string{"DNS"}},
 		{Name: "gin_file_middleware_gin_file_middleware_to_provisioned_host", Type: field.TypeUUID, Unique: true, Nullable: true},
 		{Name: "plan_plan_to_provisioned_host", Type: field.TypeUUID, Unique: true, Nullable: true},
@@ -683,37 +700,37 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "provisioned_hosts_gin_file_middlewares_GinFileMiddlewareToProvisionedHost",
-				Columns:    []*schema.Column{ProvisionedHostsColumns[3]},
+				Columns:    []*schema.Column{ProvisionedHostsColumns[4]},
 				RefColumns: []*schema.Column{GinFileMiddlewaresColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "provisioned_hosts_plans_PlanToProvisionedHost",
-				Columns:    []*schema.Column{ProvisionedHostsColumns[4]},
+				Columns:    []*schema.Column{ProvisionedHostsColumns[5]},
 				RefColumns: []*schema.Column{PlansColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "provisioned_hosts_provisioned_networks_ProvisionedHostToProvisionedNetwork",
-				Columns:    []*schema.Column{ProvisionedHostsColumns[5]},
+				Columns:    []*schema.Column{ProvisionedHostsColumns[6]},
 				RefColumns: []*schema.Column{ProvisionedNetworksColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "provisioned_hosts_hosts_ProvisionedHostToHost",
-				Columns:    []*schema.Column{ProvisionedHostsColumns[6]},
+				Columns:    []*schema.Column{ProvisionedHostsColumns[7]},
 				RefColumns: []*schema.Column{HostsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "provisioned_hosts_plans_ProvisionedHostToEndStepPlan",
-				Columns:    []*schema.Column{ProvisionedHostsColumns[7]},
+				Columns:    []*schema.Column{ProvisionedHostsColumns[8]},
 				RefColumns: []*schema.Column{PlansColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "provisioned_hosts_builds_ProvisionedHostToBuild",
-				Columns:    []*schema.Column{ProvisionedHostsColumns[8]},
+				Columns:    []*schema.Column{ProvisionedHostsColumns[9]},
 				RefColumns: []*schema.Column{BuildsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -724,6 +741,7 @@ var (
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "name", Type: field.TypeString},
 		{Name: "cidr", Type: field.TypeString},
+		{Name: "ipv6_cidr", Type: field.TypeString, Default: ""},
 		{Name: "plan_plan_to_provisioned_network", Type: field.TypeUUID, Unique: true, Nullable: true},
 		{Name: "provisioned_network_provisioned_network_to_network", Type: field.TypeUUID, Nullable: true},
 		{Name: "provisioned_network_provisioned_network_to_build", Type: field.TypeUUID, Nullable: true},
@@ -737,25 +755,25 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "provisioned_networks_plans_PlanToProvisionedNetwork",
-				Columns:    []*schema.Column{ProvisionedNetworksColumns[3]},
+				Columns:    []*schema.Column{ProvisionedNetworksColumns[4]},
 				RefColumns: []*schema.Column{PlansColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "provisioned_networks_networks_ProvisionedNetworkToNetwork",
-				Columns:    []*schema.Column{ProvisionedNetworksColumns[4]},
+				Columns:    []*schema.Column{ProvisionedNetworksColumns[5]},
 				RefColumns: []*schema.Column{NetworksColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "provisioned_networks_builds_ProvisionedNetworkToBuild",
-				Columns:    []*schema.Column{ProvisionedNetworksColumns[5]},
+				Columns:    []*schema.Column{ProvisionedNetworksColumns[6]},
 				RefColumns: []*schema.Column{BuildsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "provisioned_networks_teams_ProvisionedNetworkToTeam",
-				Columns:    []*schema.Column{ProvisionedNetworksColumns[6]},
+				Columns:    []*schema.Column{ProvisionedNetworksColumns[7]},
 				RefColumns: []*schema.Column{TeamsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -870,6 +888,7 @@ var (
 		{Name: "vars", Type: field.TypeJSON},
 		{Name: "abs_path", Type: field.TypeString},
 		{Name: "tags", Type: field.TypeJSON},
+		{Name: "retry", Type: field.TypeJSON, Nullable: true},
 		{Name: "environment_environment_to_script", Type: field.TypeUUID, Nullable: true},
 	}
 	// ScriptsTable holds the schema information for the "scripts" table.
@@ -880,7 +899,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "scripts_environments_EnvironmentToScript",
-				Columns:    []*schema.Column{ScriptsColumns[15]},
+				Columns:    []*schema.Column{ScriptsColumns[16]},
 				RefColumns: []*schema.Column{EnvironmentsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
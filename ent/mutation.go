@@ -8,6 +8,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/gen0cide/laforge/approval"
 	"github.com/gen0cide/laforge/ent/adhocplan"
 	"github.com/gen0cide/laforge/ent/agentstatus"
 	"github.com/gen0cide/laforge/ent/agenttask"
@@ -44,6 +45,7 @@ import (
 	"github.com/gen0cide/laforge/ent/team"
 	"github.com/gen0cide/laforge/ent/token"
 	"github.com/gen0cide/laforge/ent/user"
+	"github.com/gen0cide/laforge/retry"
 	"github.com/google/uuid"
 
 	"entgo.io/ent"
@@ -2214,6 +2216,10 @@ type AgentTaskMutation struct {
 	args                                *string
 	number                              *int
 	addnumber                           *int
+	timeout                             *int
+	addtimeout                          *int
+	attempt                             *int
+	addattempt                          *int
 	output                              *string
 	state                               *agenttask.State
 	error_message                       *string
@@ -2443,6 +2449,118 @@ func (m *AgentTaskMutation) ResetNumber() {
 	m.addnumber = nil
 }
 
+// SetTimeout sets the "timeout" field.
+func (m *AgentTaskMutation) SetTimeout(i int) {
+	m.timeout = &i
+	m.addtimeout = nil
+}
+
+// Timeout returns the value of the "timeout" field in the mutation.
+func (m *AgentTaskMutation) Timeout() (r int, exists bool) {
+	v := m.timeout
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeout returns the old "timeout" field's value of the AgentTask entity.
+// If the AgentTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentTaskMutation) OldTimeout(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTimeout is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTimeout requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeout: %w", err)
+	}
+	return oldValue.Timeout, nil
+}
+
+// AddTimeout adds i to the "timeout" field.
+func (m *AgentTaskMutation) AddTimeout(i int) {
+	if m.addtimeout != nil {
+		*m.addtimeout += i
+	} else {
+		m.addtimeout = &i
+	}
+}
+
+// AddedTimeout returns the value that was added to the "timeout" field in this mutation.
+func (m *AgentTaskMutation) AddedTimeout() (r int, exists bool) {
+	v := m.addtimeout
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTimeout resets all changes to the "timeout" field.
+func (m *AgentTaskMutation) ResetTimeout() {
+	m.timeout = nil
+	m.addtimeout = nil
+}
+
+// SetAttempt sets the "attempt" field.
+func (m *AgentTaskMutation) SetAttempt(i int) {
+	m.attempt = &i
+	m.addattempt = nil
+}
+
+// Attempt returns the value of the "attempt" field in the mutation.
+func (m *AgentTaskMutation) Attempt() (r int, exists bool) {
+	v := m.attempt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempt returns the old "attempt" field's value of the AgentTask entity.
+// If the AgentTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentTaskMutation) OldAttempt(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldAttempt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldAttempt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempt: %w", err)
+	}
+	return oldValue.Attempt, nil
+}
+
+// AddAttempt adds i to the "attempt" field.
+func (m *AgentTaskMutation) AddAttempt(i int) {
+	if m.addattempt != nil {
+		*m.addattempt += i
+	} else {
+		m.addattempt = &i
+	}
+}
+
+// AddedAttempt returns the value that was added to the "attempt" field in this mutation.
+func (m *AgentTaskMutation) AddedAttempt() (r int, exists bool) {
+	v := m.addattempt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempt resets all changes to the "attempt" field.
+func (m *AgentTaskMutation) ResetAttempt() {
+	m.attempt = nil
+	m.addattempt = nil
+}
+
 // SetOutput sets the "output" field.
 func (m *AgentTaskMutation) SetOutput(s string) {
 	m.output = &s
@@ -2702,7 +2820,7 @@ func (m *AgentTaskMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *AgentTaskMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+	fields := make([]string, 0, 8)
 	if m.command != nil {
 		fields = append(fields, agenttask.FieldCommand)
 	}
@@ -2712,6 +2830,12 @@ func (m *AgentTaskMutation) Fields() []string {
 	if m.number != nil {
 		fields = append(fields, agenttask.FieldNumber)
 	}
+	if m.timeout != nil {
+		fields = append(fields, agenttask.FieldTimeout)
+	}
+	if m.attempt != nil {
+		fields = append(fields, agenttask.FieldAttempt)
+	}
 	if m.output != nil {
 		fields = append(fields, agenttask.FieldOutput)
 	}
@@ -2735,6 +2859,10 @@ func (m *AgentTaskMutation) Field(name string) (ent.Value, bool) {
 		return m.Args()
 	case agenttask.FieldNumber:
 		return m.Number()
+	case agenttask.FieldTimeout:
+		return m.Timeout()
+	case agenttask.FieldAttempt:
+		return m.Attempt()
 	case agenttask.FieldOutput:
 		return m.Output()
 	case agenttask.FieldState:
@@ -2756,6 +2884,10 @@ func (m *AgentTaskMutation) OldField(ctx context.Context, name string) (ent.Valu
 		return m.OldArgs(ctx)
 	case agenttask.FieldNumber:
 		return m.OldNumber(ctx)
+	case agenttask.FieldTimeout:
+		return m.OldTimeout(ctx)
+	case agenttask.FieldAttempt:
+		return m.OldAttempt(ctx)
 	case agenttask.FieldOutput:
 		return m.OldOutput(ctx)
 	case agenttask.FieldState:
@@ -2792,6 +2924,20 @@ func (m *AgentTaskMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetNumber(v)
 		return nil
+	case agenttask.FieldTimeout:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeout(v)
+		return nil
+	case agenttask.FieldAttempt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempt(v)
+		return nil
 	case agenttask.FieldOutput:
 		v, ok := value.(string)
 		if !ok {
@@ -2824,6 +2970,12 @@ func (m *AgentTaskMutation) AddedFields() []string {
 	if m.addnumber != nil {
 		fields = append(fields, agenttask.FieldNumber)
 	}
+	if m.addtimeout != nil {
+		fields = append(fields, agenttask.FieldTimeout)
+	}
+	if m.addattempt != nil {
+		fields = append(fields, agenttask.FieldAttempt)
+	}
 	return fields
 }
 
@@ -2834,6 +2986,10 @@ func (m *AgentTaskMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	case agenttask.FieldNumber:
 		return m.AddedNumber()
+	case agenttask.FieldTimeout:
+		return m.AddedTimeout()
+	case agenttask.FieldAttempt:
+		return m.AddedAttempt()
 	}
 	return nil, false
 }
@@ -2850,6 +3006,20 @@ func (m *AgentTaskMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddNumber(v)
 		return nil
+	case agenttask.FieldTimeout:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTimeout(v)
+		return nil
+	case agenttask.FieldAttempt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempt(v)
+		return nil
 	}
 	return fmt.Errorf("unknown AgentTask numeric field %s", name)
 }
@@ -2886,6 +3056,12 @@ func (m *AgentTaskMutation) ResetField(name string) error {
 	case agenttask.FieldNumber:
 		m.ResetNumber()
 		return nil
+	case agenttask.FieldTimeout:
+		m.ResetTimeout()
+		return nil
+	case agenttask.FieldAttempt:
+		m.ResetAttempt()
+		return nil
 	case agenttask.FieldOutput:
 		m.ResetOutput()
 		return nil
@@ -5179,6 +5355,8 @@ type BuildCommitMutation struct {
 	revision                       *int
 	addrevision                    *int
 	state                          *buildcommit.State
+	created_by                     *string
+	approvals                      *[]approval.Approval
 	clearedFields                  map[string]struct{}
 	_BuildCommitToBuild            *uuid.UUID
 	cleared_BuildCommitToBuild     bool
@@ -5403,6 +5581,91 @@ func (m *BuildCommitMutation) ResetState() {
 	m.state = nil
 }
 
+// SetCreatedBy sets the "created_by" field.
+func (m *BuildCommitMutation) SetCreatedBy(s string) {
+	m.created_by = &s
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *BuildCommitMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the BuildCommit entity.
+// If the BuildCommit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildCommitMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *BuildCommitMutation) ResetCreatedBy() {
+	m.created_by = nil
+}
+
+// SetApprovals sets the "approvals" field.
+func (m *BuildCommitMutation) SetApprovals(a []approval.Approval) {
+	m.approvals = &a
+}
+
+// Approvals returns the value of the "approvals" field in the mutation.
+func (m *BuildCommitMutation) Approvals() (r []approval.Approval, exists bool) {
+	v := m.approvals
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApprovals returns the old "approvals" field's value of the BuildCommit entity.
+// If the BuildCommit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BuildCommitMutation) OldApprovals(ctx context.Context) (v []approval.Approval, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldApprovals is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldApprovals requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApprovals: %w", err)
+	}
+	return oldValue.Approvals, nil
+}
+
+// ClearApprovals clears the value of the "approvals" field.
+func (m *BuildCommitMutation) ClearApprovals() {
+	m.approvals = nil
+	m.clearedFields[buildcommit.FieldApprovals] = struct{}{}
+}
+
+// ApprovalsCleared returns if the "approvals" field was cleared in this mutation.
+func (m *BuildCommitMutation) ApprovalsCleared() bool {
+	_, ok := m.clearedFields[buildcommit.FieldApprovals]
+	return ok
+}
+
+// ResetApprovals resets all changes to the "approvals" field.
+func (m *BuildCommitMutation) ResetApprovals() {
+	m.approvals = nil
+	delete(m.clearedFields, buildcommit.FieldApprovals)
+}
+
 // SetBuildCommitToBuildID sets the "BuildCommitToBuild" edge to the Build entity by id.
 func (m *BuildCommitMutation) SetBuildCommitToBuildID(id uuid.UUID) {
 	m._BuildCommitToBuild = &id
@@ -5515,7 +5778,7 @@ func (m *BuildCommitMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *BuildCommitMutation) Fields() []string {
-	fields := make([]string, 0, 3)
+	fields := make([]string, 0, 5)
 	if m._type != nil {
 		fields = append(fields, buildcommit.FieldType)
 	}
@@ -5525,6 +5788,12 @@ func (m *BuildCommitMutation) Fields() []string {
 	if m.state != nil {
 		fields = append(fields, buildcommit.FieldState)
 	}
+	if m.created_by != nil {
+		fields = append(fields, buildcommit.FieldCreatedBy)
+	}
+	if m.approvals != nil {
+		fields = append(fields, buildcommit.FieldApprovals)
+	}
 	return fields
 }
 
@@ -5539,6 +5808,10 @@ func (m *BuildCommitMutation) Field(name string) (ent.Value, bool) {
 		return m.Revision()
 	case buildcommit.FieldState:
 		return m.State()
+	case buildcommit.FieldCreatedBy:
+		return m.CreatedBy()
+	case buildcommit.FieldApprovals:
+		return m.Approvals()
 	}
 	return nil, false
 }
@@ -5554,6 +5827,10 @@ func (m *BuildCommitMutation) OldField(ctx context.Context, name string) (ent.Va
 		return m.OldRevision(ctx)
 	case buildcommit.FieldState:
 		return m.OldState(ctx)
+	case buildcommit.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case buildcommit.FieldApprovals:
+		return m.OldApprovals(ctx)
 	}
 	return nil, fmt.Errorf("unknown BuildCommit field %s", name)
 }
@@ -5584,6 +5861,20 @@ func (m *BuildCommitMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetState(v)
 		return nil
+	case buildcommit.FieldCreatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case buildcommit.FieldApprovals:
+		v, ok := value.([]approval.Approval)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApprovals(v)
+		return nil
 	}
 	return fmt.Errorf("unknown BuildCommit field %s", name)
 }
@@ -5628,7 +5919,11 @@ func (m *BuildCommitMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *BuildCommitMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(buildcommit.FieldApprovals) {
+		fields = append(fields, buildcommit.FieldApprovals)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -5641,6 +5936,11 @@ func (m *BuildCommitMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *BuildCommitMutation) ClearField(name string) error {
+	switch name {
+	case buildcommit.FieldApprovals:
+		m.ClearApprovals()
+		return nil
+	}
 	return fmt.Errorf("unknown BuildCommit nullable field %s", name)
 }
 
@@ -5657,6 +5957,12 @@ func (m *BuildCommitMutation) ResetField(name string) error {
 	case buildcommit.FieldState:
 		m.ResetState()
 		return nil
+	case buildcommit.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case buildcommit.FieldApprovals:
+		m.ResetApprovals()
+		return nil
 	}
 	return fmt.Errorf("unknown BuildCommit field %s", name)
 }
@@ -5782,6 +6088,7 @@ type CommandMutation struct {
 	addtimeout                   *int
 	vars                         *map[string]string
 	tags                         *map[string]string
+	retry                        **retry.Policy
 	clearedFields                map[string]struct{}
 	_CommandToUser               map[uuid.UUID]struct{}
 	removed_CommandToUser        map[uuid.UUID]struct{}
@@ -6314,6 +6621,55 @@ func (m *CommandMutation) ResetTags() {
 	m.tags = nil
 }
 
+// SetRetry sets the "retry" field.
+func (m *CommandMutation) SetRetry(value *retry.Policy) {
+	m.retry = &value
+}
+
+// Retry returns the value of the "retry" field in the mutation.
+func (m *CommandMutation) Retry() (r *retry.Policy, exists bool) {
+	v := m.retry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetry returns the old "retry" field's value of the Command entity.
+// If the Command object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CommandMutation) OldRetry(ctx context.Context) (v *retry.Policy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldRetry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldRetry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetry: %w", err)
+	}
+	return oldValue.Retry, nil
+}
+
+// ClearRetry clears the value of the "retry" field.
+func (m *CommandMutation) ClearRetry() {
+	m.retry = nil
+	m.clearedFields[command.FieldRetry] = struct{}{}
+}
+
+// RetryCleared returns if the "retry" field was cleared in this mutation.
+func (m *CommandMutation) RetryCleared() bool {
+	_, ok := m.clearedFields[command.FieldRetry]
+	return ok
+}
+
+// ResetRetry resets all changes to the "retry" field.
+func (m *CommandMutation) ResetRetry() {
+	m.retry = nil
+	delete(m.clearedFields, command.FieldRetry)
+}
+
 // AddCommandToUserIDs adds the "CommandToUser" edge to the User entity by ids.
 func (m *CommandMutation) AddCommandToUserIDs(ids ...uuid.UUID) {
 	if m._CommandToUser == nil {
@@ -6426,7 +6782,7 @@ func (m *CommandMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *CommandMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+	fields := make([]string, 0, 12)
 	if m.hcl_id != nil {
 		fields = append(fields, command.FieldHclID)
 	}
@@ -6460,6 +6816,9 @@ func (m *CommandMutation) Fields() []string {
 	if m.tags != nil {
 		fields = append(fields, command.FieldTags)
 	}
+	if m.retry != nil {
+		fields = append(fields, command.FieldRetry)
+	}
 	return fields
 }
 
@@ -6490,6 +6849,8 @@ func (m *CommandMutation) Field(name string) (ent.Value, bool) {
 		return m.Vars()
 	case command.FieldTags:
 		return m.Tags()
+	case command.FieldRetry:
+		return m.Retry()
 	}
 	return nil, false
 }
@@ -6521,6 +6882,8 @@ func (m *CommandMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldVars(ctx)
 	case command.FieldTags:
 		return m.OldTags(ctx)
+	case command.FieldRetry:
+		return m.OldRetry(ctx)
 	}
 	return nil, fmt.Errorf("unknown Command field %s", name)
 }
@@ -6607,6 +6970,13 @@ func (m *CommandMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetTags(v)
 		return nil
+	case command.FieldRetry:
+		v, ok := value.(*retry.Policy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetry(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Command field %s", name)
 }
@@ -6663,7 +7033,11 @@ func (m *CommandMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *CommandMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(command.FieldRetry) {
+		fields = append(fields, command.FieldRetry)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -6676,6 +7050,11 @@ func (m *CommandMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *CommandMutation) ClearField(name string) error {
+	switch name {
+	case command.FieldRetry:
+		m.ClearRetry()
+		return nil
+	}
 	return fmt.Errorf("unknown Command nullable field %s", name)
 }
 
@@ -6716,6 +7095,9 @@ func (m *CommandMutation) ResetField(name string) error {
 	case command.FieldTags:
 		m.ResetTags()
 		return nil
+	case command.FieldRetry:
+		m.ResetRetry()
+		return nil
 	}
 	return fmt.Errorf("unknown Command field %s", name)
 }
@@ -9428,6 +9810,7 @@ type EnvironmentMutation struct {
 	exposed_vdi_ports                    *[]string
 	_config                              *map[string]string
 	tags                                 *map[string]string
+	approval_policy                      **approval.Policy
 	clearedFields                        map[string]struct{}
 	_EnvironmentToUser                   map[uuid.UUID]struct{}
 	removed_EnvironmentToUser            map[uuid.UUID]struct{}
@@ -10006,6 +10389,55 @@ func (m *EnvironmentMutation) ResetTags() {
 	m.tags = nil
 }
 
+// SetApprovalPolicy sets the "approval_policy" field.
+func (m *EnvironmentMutation) SetApprovalPolicy(a *approval.Policy) {
+	m.approval_policy = &a
+}
+
+// ApprovalPolicy returns the value of the "approval_policy" field in the mutation.
+func (m *EnvironmentMutation) ApprovalPolicy() (r *approval.Policy, exists bool) {
+	v := m.approval_policy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApprovalPolicy returns the old "approval_policy" field's value of the Environment entity.
+// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentMutation) OldApprovalPolicy(ctx context.Context) (v *approval.Policy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldApprovalPolicy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldApprovalPolicy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApprovalPolicy: %w", err)
+	}
+	return oldValue.ApprovalPolicy, nil
+}
+
+// ClearApprovalPolicy clears the value of the "approval_policy" field.
+func (m *EnvironmentMutation) ClearApprovalPolicy() {
+	m.approval_policy = nil
+	m.clearedFields[environment.FieldApprovalPolicy] = struct{}{}
+}
+
+// ApprovalPolicyCleared returns if the "approval_policy" field was cleared in this mutation.
+func (m *EnvironmentMutation) ApprovalPolicyCleared() bool {
+	_, ok := m.clearedFields[environment.FieldApprovalPolicy]
+	return ok
+}
+
+// ResetApprovalPolicy resets all changes to the "approval_policy" field.
+func (m *EnvironmentMutation) ResetApprovalPolicy() {
+	m.approval_policy = nil
+	delete(m.clearedFields, environment.FieldApprovalPolicy)
+}
+
 // AddEnvironmentToUserIDs adds the "EnvironmentToUser" edge to the User entity by ids.
 func (m *EnvironmentMutation) AddEnvironmentToUserIDs(ids ...uuid.UUID) {
 	if m._EnvironmentToUser == nil {
@@ -10943,7 +11375,7 @@ func (m *EnvironmentMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *EnvironmentMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+	fields := make([]string, 0, 12)
 	if m.hcl_id != nil {
 		fields = append(fields, environment.FieldHclID)
 	}
@@ -10977,6 +11409,9 @@ func (m *EnvironmentMutation) Fields() []string {
 	if m.tags != nil {
 		fields = append(fields, environment.FieldTags)
 	}
+	if m.approval_policy != nil {
+		fields = append(fields, environment.FieldApprovalPolicy)
+	}
 	return fields
 }
 
@@ -11007,6 +11442,8 @@ func (m *EnvironmentMutation) Field(name string) (ent.Value, bool) {
 		return m.Config()
 	case environment.FieldTags:
 		return m.Tags()
+	case environment.FieldApprovalPolicy:
+		return m.ApprovalPolicy()
 	}
 	return nil, false
 }
@@ -11038,6 +11475,8 @@ func (m *EnvironmentMutation) OldField(ctx context.Context, name string) (ent.Va
 		return m.OldConfig(ctx)
 	case environment.FieldTags:
 		return m.OldTags(ctx)
+	case environment.FieldApprovalPolicy:
+		return m.OldApprovalPolicy(ctx)
 	}
 	return nil, fmt.Errorf("unknown Environment field %s", name)
 }
@@ -11124,9 +11563,16 @@ func (m *EnvironmentMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetTags(v)
 		return nil
-	}
-	return fmt.Errorf("unknown Environment field %s", name)
-}
+	case environment.FieldApprovalPolicy:
+		v, ok := value.(*approval.Policy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApprovalPolicy(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Environment field %s", name)
+}
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
@@ -11180,7 +11626,11 @@ func (m *EnvironmentMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *EnvironmentMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(environment.FieldApprovalPolicy) {
+		fields = append(fields, environment.FieldApprovalPolicy)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -11193,6 +11643,11 @@ func (m *EnvironmentMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *EnvironmentMutation) ClearField(name string) error {
+	switch name {
+	case environment.FieldApprovalPolicy:
+		m.ClearApprovalPolicy()
+		return nil
+	}
 	return fmt.Errorf("unknown Environment nullable field %s", name)
 }
 
@@ -11233,6 +11688,9 @@ func (m *EnvironmentMutation) ResetField(name string) error {
 	case environment.FieldTags:
 		m.ResetTags()
 		return nil
+	case environment.FieldApprovalPolicy:
+		m.ResetApprovalPolicy()
+		return nil
 	}
 	return fmt.Errorf("unknown Environment field %s", name)
 }
@@ -11746,6 +12204,7 @@ type FileDeleteMutation struct {
 	hcl_id                          *string
 	_path                           *string
 	tags                            *map[string]string
+	retry                           **retry.Policy
 	clearedFields                   map[string]struct{}
 	_FileDeleteToEnvironment        *uuid.UUID
 	cleared_FileDeleteToEnvironment bool
@@ -11947,6 +12406,55 @@ func (m *FileDeleteMutation) ResetTags() {
 	m.tags = nil
 }
 
+// SetRetry sets the "retry" field.
+func (m *FileDeleteMutation) SetRetry(value *retry.Policy) {
+	m.retry = &value
+}
+
+// Retry returns the value of the "retry" field in the mutation.
+func (m *FileDeleteMutation) Retry() (r *retry.Policy, exists bool) {
+	v := m.retry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetry returns the old "retry" field's value of the FileDelete entity.
+// If the FileDelete object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileDeleteMutation) OldRetry(ctx context.Context) (v *retry.Policy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldRetry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldRetry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetry: %w", err)
+	}
+	return oldValue.Retry, nil
+}
+
+// ClearRetry clears the value of the "retry" field.
+func (m *FileDeleteMutation) ClearRetry() {
+	m.retry = nil
+	m.clearedFields[filedelete.FieldRetry] = struct{}{}
+}
+
+// RetryCleared returns if the "retry" field was cleared in this mutation.
+func (m *FileDeleteMutation) RetryCleared() bool {
+	_, ok := m.clearedFields[filedelete.FieldRetry]
+	return ok
+}
+
+// ResetRetry resets all changes to the "retry" field.
+func (m *FileDeleteMutation) ResetRetry() {
+	m.retry = nil
+	delete(m.clearedFields, filedelete.FieldRetry)
+}
+
 // SetFileDeleteToEnvironmentID sets the "FileDeleteToEnvironment" edge to the Environment entity by id.
 func (m *FileDeleteMutation) SetFileDeleteToEnvironmentID(id uuid.UUID) {
 	m._FileDeleteToEnvironment = &id
@@ -12005,7 +12513,7 @@ func (m *FileDeleteMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *FileDeleteMutation) Fields() []string {
-	fields := make([]string, 0, 3)
+	fields := make([]string, 0, 4)
 	if m.hcl_id != nil {
 		fields = append(fields, filedelete.FieldHclID)
 	}
@@ -12015,6 +12523,9 @@ func (m *FileDeleteMutation) Fields() []string {
 	if m.tags != nil {
 		fields = append(fields, filedelete.FieldTags)
 	}
+	if m.retry != nil {
+		fields = append(fields, filedelete.FieldRetry)
+	}
 	return fields
 }
 
@@ -12029,6 +12540,8 @@ func (m *FileDeleteMutation) Field(name string) (ent.Value, bool) {
 		return m.Path()
 	case filedelete.FieldTags:
 		return m.Tags()
+	case filedelete.FieldRetry:
+		return m.Retry()
 	}
 	return nil, false
 }
@@ -12044,6 +12557,8 @@ func (m *FileDeleteMutation) OldField(ctx context.Context, name string) (ent.Val
 		return m.OldPath(ctx)
 	case filedelete.FieldTags:
 		return m.OldTags(ctx)
+	case filedelete.FieldRetry:
+		return m.OldRetry(ctx)
 	}
 	return nil, fmt.Errorf("unknown FileDelete field %s", name)
 }
@@ -12074,6 +12589,13 @@ func (m *FileDeleteMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetTags(v)
 		return nil
+	case filedelete.FieldRetry:
+		v, ok := value.(*retry.Policy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetry(v)
+		return nil
 	}
 	return fmt.Errorf("unknown FileDelete field %s", name)
 }
@@ -12103,7 +12625,11 @@ func (m *FileDeleteMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *FileDeleteMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(filedelete.FieldRetry) {
+		fields = append(fields, filedelete.FieldRetry)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -12116,6 +12642,11 @@ func (m *FileDeleteMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *FileDeleteMutation) ClearField(name string) error {
+	switch name {
+	case filedelete.FieldRetry:
+		m.ClearRetry()
+		return nil
+	}
 	return fmt.Errorf("unknown FileDelete nullable field %s", name)
 }
 
@@ -12132,6 +12663,9 @@ func (m *FileDeleteMutation) ResetField(name string) error {
 	case filedelete.FieldTags:
 		m.ResetTags()
 		return nil
+	case filedelete.FieldRetry:
+		m.ResetRetry()
+		return nil
 	}
 	return fmt.Errorf("unknown FileDelete field %s", name)
 }
@@ -12228,6 +12762,8 @@ type FileDownloadMutation struct {
 	md5                               *string
 	abs_path                          *string
 	tags                              *map[string]string
+	retry                             **retry.Policy
+	sha256                            *string
 	clearedFields                     map[string]struct{}
 	_FileDownloadToEnvironment        *uuid.UUID
 	cleared_FileDownloadToEnvironment bool
@@ -12681,6 +13217,91 @@ func (m *FileDownloadMutation) ResetTags() {
 	m.tags = nil
 }
 
+// SetRetry sets the "retry" field.
+func (m *FileDownloadMutation) SetRetry(value *retry.Policy) {
+	m.retry = &value
+}
+
+// Retry returns the value of the "retry" field in the mutation.
+func (m *FileDownloadMutation) Retry() (r *retry.Policy, exists bool) {
+	v := m.retry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetry returns the old "retry" field's value of the FileDownload entity.
+// If the FileDownload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileDownloadMutation) OldRetry(ctx context.Context) (v *retry.Policy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldRetry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldRetry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetry: %w", err)
+	}
+	return oldValue.Retry, nil
+}
+
+// ClearRetry clears the value of the "retry" field.
+func (m *FileDownloadMutation) ClearRetry() {
+	m.retry = nil
+	m.clearedFields[filedownload.FieldRetry] = struct{}{}
+}
+
+// RetryCleared returns if the "retry" field was cleared in this mutation.
+func (m *FileDownloadMutation) RetryCleared() bool {
+	_, ok := m.clearedFields[filedownload.FieldRetry]
+	return ok
+}
+
+// ResetRetry resets all changes to the "retry" field.
+func (m *FileDownloadMutation) ResetRetry() {
+	m.retry = nil
+	delete(m.clearedFields, filedownload.FieldRetry)
+}
+
+// SetSha256 sets the "sha256" field.
+func (m *FileDownloadMutation) SetSha256(s string) {
+	m.sha256 = &s
+}
+
+// Sha256 returns the value of the "sha256" field in the mutation.
+func (m *FileDownloadMutation) Sha256() (r string, exists bool) {
+	v := m.sha256
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSha256 returns the old "sha256" field's value of the FileDownload entity.
+// If the FileDownload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileDownloadMutation) OldSha256(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldSha256 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldSha256 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSha256: %w", err)
+	}
+	return oldValue.Sha256, nil
+}
+
+// ResetSha256 resets all changes to the "sha256" field.
+func (m *FileDownloadMutation) ResetSha256() {
+	m.sha256 = nil
+}
+
 // SetFileDownloadToEnvironmentID sets the "FileDownloadToEnvironment" edge to the Environment entity by id.
 func (m *FileDownloadMutation) SetFileDownloadToEnvironmentID(id uuid.UUID) {
 	m._FileDownloadToEnvironment = &id
@@ -12739,7 +13360,7 @@ func (m *FileDownloadMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *FileDownloadMutation) Fields() []string {
-	fields := make([]string, 0, 10)
+	fields := make([]string, 0, 12)
 	if m.hcl_id != nil {
 		fields = append(fields, filedownload.FieldHclID)
 	}
@@ -12770,6 +13391,12 @@ func (m *FileDownloadMutation) Fields() []string {
 	if m.tags != nil {
 		fields = append(fields, filedownload.FieldTags)
 	}
+	if m.retry != nil {
+		fields = append(fields, filedownload.FieldRetry)
+	}
+	if m.sha256 != nil {
+		fields = append(fields, filedownload.FieldSha256)
+	}
 	return fields
 }
 
@@ -12798,6 +13425,10 @@ func (m *FileDownloadMutation) Field(name string) (ent.Value, bool) {
 		return m.AbsPath()
 	case filedownload.FieldTags:
 		return m.Tags()
+	case filedownload.FieldRetry:
+		return m.Retry()
+	case filedownload.FieldSha256:
+		return m.Sha256()
 	}
 	return nil, false
 }
@@ -12827,6 +13458,10 @@ func (m *FileDownloadMutation) OldField(ctx context.Context, name string) (ent.V
 		return m.OldAbsPath(ctx)
 	case filedownload.FieldTags:
 		return m.OldTags(ctx)
+	case filedownload.FieldRetry:
+		return m.OldRetry(ctx)
+	case filedownload.FieldSha256:
+		return m.OldSha256(ctx)
 	}
 	return nil, fmt.Errorf("unknown FileDownload field %s", name)
 }
@@ -12906,6 +13541,20 @@ func (m *FileDownloadMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetTags(v)
 		return nil
+	case filedownload.FieldRetry:
+		v, ok := value.(*retry.Policy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetry(v)
+		return nil
+	case filedownload.FieldSha256:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSha256(v)
+		return nil
 	}
 	return fmt.Errorf("unknown FileDownload field %s", name)
 }
@@ -12935,7 +13584,11 @@ func (m *FileDownloadMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *FileDownloadMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(filedownload.FieldRetry) {
+		fields = append(fields, filedownload.FieldRetry)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -12948,6 +13601,11 @@ func (m *FileDownloadMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *FileDownloadMutation) ClearField(name string) error {
+	switch name {
+	case filedownload.FieldRetry:
+		m.ClearRetry()
+		return nil
+	}
 	return fmt.Errorf("unknown FileDownload nullable field %s", name)
 }
 
@@ -12985,6 +13643,12 @@ func (m *FileDownloadMutation) ResetField(name string) error {
 	case filedownload.FieldTags:
 		m.ResetTags()
 		return nil
+	case filedownload.FieldRetry:
+		m.ResetRetry()
+		return nil
+	case filedownload.FieldSha256:
+		m.ResetSha256()
+		return nil
 	}
 	return fmt.Errorf("unknown FileDownload field %s", name)
 }
@@ -13076,6 +13740,7 @@ type FileExtractMutation struct {
 	destination                      *string
 	_type                            *string
 	tags                             *map[string]string
+	retry                            **retry.Policy
 	clearedFields                    map[string]struct{}
 	_FileExtractToEnvironment        *uuid.UUID
 	cleared_FileExtractToEnvironment bool
@@ -13349,6 +14014,55 @@ func (m *FileExtractMutation) ResetTags() {
 	m.tags = nil
 }
 
+// SetRetry sets the "retry" field.
+func (m *FileExtractMutation) SetRetry(value *retry.Policy) {
+	m.retry = &value
+}
+
+// Retry returns the value of the "retry" field in the mutation.
+func (m *FileExtractMutation) Retry() (r *retry.Policy, exists bool) {
+	v := m.retry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetry returns the old "retry" field's value of the FileExtract entity.
+// If the FileExtract object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileExtractMutation) OldRetry(ctx context.Context) (v *retry.Policy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldRetry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldRetry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetry: %w", err)
+	}
+	return oldValue.Retry, nil
+}
+
+// ClearRetry clears the value of the "retry" field.
+func (m *FileExtractMutation) ClearRetry() {
+	m.retry = nil
+	m.clearedFields[fileextract.FieldRetry] = struct{}{}
+}
+
+// RetryCleared returns if the "retry" field was cleared in this mutation.
+func (m *FileExtractMutation) RetryCleared() bool {
+	_, ok := m.clearedFields[fileextract.FieldRetry]
+	return ok
+}
+
+// ResetRetry resets all changes to the "retry" field.
+func (m *FileExtractMutation) ResetRetry() {
+	m.retry = nil
+	delete(m.clearedFields, fileextract.FieldRetry)
+}
+
 // SetFileExtractToEnvironmentID sets the "FileExtractToEnvironment" edge to the Environment entity by id.
 func (m *FileExtractMutation) SetFileExtractToEnvironmentID(id uuid.UUID) {
 	m._FileExtractToEnvironment = &id
@@ -13407,7 +14121,7 @@ func (m *FileExtractMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *FileExtractMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+	fields := make([]string, 0, 6)
 	if m.hcl_id != nil {
 		fields = append(fields, fileextract.FieldHclID)
 	}
@@ -13423,6 +14137,9 @@ func (m *FileExtractMutation) Fields() []string {
 	if m.tags != nil {
 		fields = append(fields, fileextract.FieldTags)
 	}
+	if m.retry != nil {
+		fields = append(fields, fileextract.FieldRetry)
+	}
 	return fields
 }
 
@@ -13441,6 +14158,8 @@ func (m *FileExtractMutation) Field(name string) (ent.Value, bool) {
 		return m.GetType()
 	case fileextract.FieldTags:
 		return m.Tags()
+	case fileextract.FieldRetry:
+		return m.Retry()
 	}
 	return nil, false
 }
@@ -13460,6 +14179,8 @@ func (m *FileExtractMutation) OldField(ctx context.Context, name string) (ent.Va
 		return m.OldType(ctx)
 	case fileextract.FieldTags:
 		return m.OldTags(ctx)
+	case fileextract.FieldRetry:
+		return m.OldRetry(ctx)
 	}
 	return nil, fmt.Errorf("unknown FileExtract field %s", name)
 }
@@ -13504,6 +14225,13 @@ func (m *FileExtractMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetTags(v)
 		return nil
+	case fileextract.FieldRetry:
+		v, ok := value.(*retry.Policy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetry(v)
+		return nil
 	}
 	return fmt.Errorf("unknown FileExtract field %s", name)
 }
@@ -13533,7 +14261,11 @@ func (m *FileExtractMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *FileExtractMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(fileextract.FieldRetry) {
+		fields = append(fields, fileextract.FieldRetry)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -13546,6 +14278,11 @@ func (m *FileExtractMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *FileExtractMutation) ClearField(name string) error {
+	switch name {
+	case fileextract.FieldRetry:
+		m.ClearRetry()
+		return nil
+	}
 	return fmt.Errorf("unknown FileExtract nullable field %s", name)
 }
 
@@ -13568,6 +14305,9 @@ func (m *FileExtractMutation) ResetField(name string) error {
 	case fileextract.FieldTags:
 		m.ResetTags()
 		return nil
+	case fileextract.FieldRetry:
+		m.ResetRetry()
+		return nil
 	}
 	return fmt.Errorf("unknown FileExtract field %s", name)
 }
@@ -14978,6 +15718,10 @@ type HostMutation struct {
 	_OS                                  *string
 	last_octet                           *int
 	addlast_octet                        *int
+	cpus                                 *int
+	addcpus                              *int
+	memory_mb                            *int
+	addmemory_mb                         *int
 	instance_size                        *string
 	allow_mac_changes                    *bool
 	exposed_tcp_ports                    *[]string
@@ -14986,6 +15730,7 @@ type HostMutation struct {
 	vars                                 *map[string]string
 	user_groups                          *[]string
 	provision_steps                      *[]string
+	additional_disks                     *[]int
 	tags                                 *map[string]string
 	clearedFields                        map[string]struct{}
 	_HostToDisk                          *uuid.UUID
@@ -15294,6 +16039,146 @@ func (m *HostMutation) ResetLastOctet() {
 	m.addlast_octet = nil
 }
 
+// SetCpus sets the "cpus" field.
+func (m *HostMutation) SetCpus(i int) {
+	m.cpus = &i
+	m.addcpus = nil
+}
+
+// Cpus returns the value of the "cpus" field in the mutation.
+func (m *HostMutation) Cpus() (r int, exists bool) {
+	v := m.cpus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCpus returns the old "cpus" field's value of the Host entity.
+// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HostMutation) OldCpus(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldCpus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldCpus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCpus: %w", err)
+	}
+	return oldValue.Cpus, nil
+}
+
+// AddCpus adds i to the "cpus" field.
+func (m *HostMutation) AddCpus(i int) {
+	if m.addcpus != nil {
+		*m.addcpus += i
+	} else {
+		m.addcpus = &i
+	}
+}
+
+// AddedCpus returns the value that was added to the "cpus" field in this mutation.
+func (m *HostMutation) AddedCpus() (r int, exists bool) {
+	v := m.addcpus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCpus clears the value of the "cpus" field.
+func (m *HostMutation) ClearCpus() {
+	m.cpus = nil
+	m.addcpus = nil
+	m.clearedFields[host.FieldCpus] = struct{}{}
+}
+
+// CpusCleared returns if the "cpus" field was cleared in this mutation.
+func (m *HostMutation) CpusCleared() bool {
+	_, ok := m.clearedFields[host.FieldCpus]
+	return ok
+}
+
+// ResetCpus resets all changes to the "cpus" field.
+func (m *HostMutation) ResetCpus() {
+	m.cpus = nil
+	m.addcpus = nil
+	delete(m.clearedFields, host.FieldCpus)
+}
+
+// SetMemoryMB sets the "memory_mb" field.
+func (m *HostMutation) SetMemoryMB(i int) {
+	m.memory_mb = &i
+	m.addmemory_mb = nil
+}
+
+// MemoryMB returns the value of the "memory_mb" field in the mutation.
+func (m *HostMutation) MemoryMB() (r int, exists bool) {
+	v := m.memory_mb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMemoryMB returns the old "memory_mb" field's value of the Host entity.
+// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HostMutation) OldMemoryMB(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldMemoryMB is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldMemoryMB requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMemoryMB: %w", err)
+	}
+	return oldValue.MemoryMB, nil
+}
+
+// AddMemoryMB adds i to the "memory_mb" field.
+func (m *HostMutation) AddMemoryMB(i int) {
+	if m.addmemory_mb != nil {
+		*m.addmemory_mb += i
+	} else {
+		m.addmemory_mb = &i
+	}
+}
+
+// AddedMemoryMB returns the value that was added to the "memory_mb" field in this mutation.
+func (m *HostMutation) AddedMemoryMB() (r int, exists bool) {
+	v := m.addmemory_mb
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMemoryMB clears the value of the "memory_mb" field.
+func (m *HostMutation) ClearMemoryMB() {
+	m.memory_mb = nil
+	m.addmemory_mb = nil
+	m.clearedFields[host.FieldMemoryMB] = struct{}{}
+}
+
+// MemoryMBCleared returns if the "memory_mb" field was cleared in this mutation.
+func (m *HostMutation) MemoryMBCleared() bool {
+	_, ok := m.clearedFields[host.FieldMemoryMB]
+	return ok
+}
+
+// ResetMemoryMB resets all changes to the "memory_mb" field.
+func (m *HostMutation) ResetMemoryMB() {
+	m.memory_mb = nil
+	m.addmemory_mb = nil
+	delete(m.clearedFields, host.FieldMemoryMB)
+}
+
 // SetInstanceSize sets the "instance_size" field.
 func (m *HostMutation) SetInstanceSize(s string) {
 	m.instance_size = &s
@@ -15560,39 +16445,88 @@ func (m *HostMutation) ProvisionSteps() (r []string, exists bool) {
 	return *v, true
 }
 
-// OldProvisionSteps returns the old "provision_steps" field's value of the Host entity.
+// OldProvisionSteps returns the old "provision_steps" field's value of the Host entity.
+// If the Host object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HostMutation) OldProvisionSteps(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldProvisionSteps is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldProvisionSteps requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvisionSteps: %w", err)
+	}
+	return oldValue.ProvisionSteps, nil
+}
+
+// ClearProvisionSteps clears the value of the "provision_steps" field.
+func (m *HostMutation) ClearProvisionSteps() {
+	m.provision_steps = nil
+	m.clearedFields[host.FieldProvisionSteps] = struct{}{}
+}
+
+// ProvisionStepsCleared returns if the "provision_steps" field was cleared in this mutation.
+func (m *HostMutation) ProvisionStepsCleared() bool {
+	_, ok := m.clearedFields[host.FieldProvisionSteps]
+	return ok
+}
+
+// ResetProvisionSteps resets all changes to the "provision_steps" field.
+func (m *HostMutation) ResetProvisionSteps() {
+	m.provision_steps = nil
+	delete(m.clearedFields, host.FieldProvisionSteps)
+}
+
+// SetAdditionalDisks sets the "additional_disks" field.
+func (m *HostMutation) SetAdditionalDisks(i []int) {
+	m.additional_disks = &i
+}
+
+// AdditionalDisks returns the value of the "additional_disks" field in the mutation.
+func (m *HostMutation) AdditionalDisks() (r []int, exists bool) {
+	v := m.additional_disks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAdditionalDisks returns the old "additional_disks" field's value of the Host entity.
 // If the Host object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HostMutation) OldProvisionSteps(ctx context.Context) (v []string, err error) {
+func (m *HostMutation) OldAdditionalDisks(ctx context.Context) (v []int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldProvisionSteps is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldAdditionalDisks is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldProvisionSteps requires an ID field in the mutation")
+		return v, fmt.Errorf("OldAdditionalDisks requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProvisionSteps: %w", err)
+		return v, fmt.Errorf("querying old value for OldAdditionalDisks: %w", err)
 	}
-	return oldValue.ProvisionSteps, nil
+	return oldValue.AdditionalDisks, nil
 }
 
-// ClearProvisionSteps clears the value of the "provision_steps" field.
-func (m *HostMutation) ClearProvisionSteps() {
-	m.provision_steps = nil
-	m.clearedFields[host.FieldProvisionSteps] = struct{}{}
+// ClearAdditionalDisks clears the value of the "additional_disks" field.
+func (m *HostMutation) ClearAdditionalDisks() {
+	m.additional_disks = nil
+	m.clearedFields[host.FieldAdditionalDisks] = struct{}{}
 }
 
-// ProvisionStepsCleared returns if the "provision_steps" field was cleared in this mutation.
-func (m *HostMutation) ProvisionStepsCleared() bool {
-	_, ok := m.clearedFields[host.FieldProvisionSteps]
+// AdditionalDisksCleared returns if the "additional_disks" field was cleared in this mutation.
+func (m *HostMutation) AdditionalDisksCleared() bool {
+	_, ok := m.clearedFields[host.FieldAdditionalDisks]
 	return ok
 }
 
-// ResetProvisionSteps resets all changes to the "provision_steps" field.
-func (m *HostMutation) ResetProvisionSteps() {
-	m.provision_steps = nil
-	delete(m.clearedFields, host.FieldProvisionSteps)
+// ResetAdditionalDisks resets all changes to the "additional_disks" field.
+func (m *HostMutation) ResetAdditionalDisks() {
+	m.additional_disks = nil
+	delete(m.clearedFields, host.FieldAdditionalDisks)
 }
 
 // SetTags sets the "tags" field.
@@ -15944,7 +16878,7 @@ func (m *HostMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *HostMutation) Fields() []string {
-	fields := make([]string, 0, 14)
+	fields := make([]string, 0, 17)
 	if m.hcl_id != nil {
 		fields = append(fields, host.FieldHclID)
 	}
@@ -15960,6 +16894,12 @@ func (m *HostMutation) Fields() []string {
 	if m.last_octet != nil {
 		fields = append(fields, host.FieldLastOctet)
 	}
+	if m.cpus != nil {
+		fields = append(fields, host.FieldCpus)
+	}
+	if m.memory_mb != nil {
+		fields = append(fields, host.FieldMemoryMB)
+	}
 	if m.instance_size != nil {
 		fields = append(fields, host.FieldInstanceSize)
 	}
@@ -15984,6 +16924,9 @@ func (m *HostMutation) Fields() []string {
 	if m.provision_steps != nil {
 		fields = append(fields, host.FieldProvisionSteps)
 	}
+	if m.additional_disks != nil {
+		fields = append(fields, host.FieldAdditionalDisks)
+	}
 	if m.tags != nil {
 		fields = append(fields, host.FieldTags)
 	}
@@ -16005,6 +16948,10 @@ func (m *HostMutation) Field(name string) (ent.Value, bool) {
 		return m.OS()
 	case host.FieldLastOctet:
 		return m.LastOctet()
+	case host.FieldCpus:
+		return m.Cpus()
+	case host.FieldMemoryMB:
+		return m.MemoryMB()
 	case host.FieldInstanceSize:
 		return m.InstanceSize()
 	case host.FieldAllowMACChanges:
@@ -16021,6 +16968,8 @@ func (m *HostMutation) Field(name string) (ent.Value, bool) {
 		return m.UserGroups()
 	case host.FieldProvisionSteps:
 		return m.ProvisionSteps()
+	case host.FieldAdditionalDisks:
+		return m.AdditionalDisks()
 	case host.FieldTags:
 		return m.Tags()
 	}
@@ -16042,6 +16991,10 @@ func (m *HostMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldOS(ctx)
 	case host.FieldLastOctet:
 		return m.OldLastOctet(ctx)
+	case host.FieldCpus:
+		return m.OldCpus(ctx)
+	case host.FieldMemoryMB:
+		return m.OldMemoryMB(ctx)
 	case host.FieldInstanceSize:
 		return m.OldInstanceSize(ctx)
 	case host.FieldAllowMACChanges:
@@ -16058,6 +17011,8 @@ func (m *HostMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldUserGroups(ctx)
 	case host.FieldProvisionSteps:
 		return m.OldProvisionSteps(ctx)
+	case host.FieldAdditionalDisks:
+		return m.OldAdditionalDisks(ctx)
 	case host.FieldTags:
 		return m.OldTags(ctx)
 	}
@@ -16104,6 +17059,20 @@ func (m *HostMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetLastOctet(v)
 		return nil
+	case host.FieldCpus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCpus(v)
+		return nil
+	case host.FieldMemoryMB:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMemoryMB(v)
+		return nil
 	case host.FieldInstanceSize:
 		v, ok := value.(string)
 		if !ok {
@@ -16160,6 +17129,13 @@ func (m *HostMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetProvisionSteps(v)
 		return nil
+	case host.FieldAdditionalDisks:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdditionalDisks(v)
+		return nil
 	case host.FieldTags:
 		v, ok := value.(map[string]string)
 		if !ok {
@@ -16178,6 +17154,12 @@ func (m *HostMutation) AddedFields() []string {
 	if m.addlast_octet != nil {
 		fields = append(fields, host.FieldLastOctet)
 	}
+	if m.addcpus != nil {
+		fields = append(fields, host.FieldCpus)
+	}
+	if m.addmemory_mb != nil {
+		fields = append(fields, host.FieldMemoryMB)
+	}
 	return fields
 }
 
@@ -16188,6 +17170,10 @@ func (m *HostMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	case host.FieldLastOctet:
 		return m.AddedLastOctet()
+	case host.FieldCpus:
+		return m.AddedCpus()
+	case host.FieldMemoryMB:
+		return m.AddedMemoryMB()
 	}
 	return nil, false
 }
@@ -16204,6 +17190,20 @@ func (m *HostMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddLastOctet(v)
 		return nil
+	case host.FieldCpus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCpus(v)
+		return nil
+	case host.FieldMemoryMB:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMemoryMB(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Host numeric field %s", name)
 }
@@ -16212,9 +17212,18 @@ func (m *HostMutation) AddField(name string, value ent.Value) error {
 // mutation.
 func (m *HostMutation) ClearedFields() []string {
 	var fields []string
+	if m.FieldCleared(host.FieldCpus) {
+		fields = append(fields, host.FieldCpus)
+	}
+	if m.FieldCleared(host.FieldMemoryMB) {
+		fields = append(fields, host.FieldMemoryMB)
+	}
 	if m.FieldCleared(host.FieldProvisionSteps) {
 		fields = append(fields, host.FieldProvisionSteps)
 	}
+	if m.FieldCleared(host.FieldAdditionalDisks) {
+		fields = append(fields, host.FieldAdditionalDisks)
+	}
 	return fields
 }
 
@@ -16229,9 +17238,18 @@ func (m *HostMutation) FieldCleared(name string) bool {
 // error if the field is not defined in the schema.
 func (m *HostMutation) ClearField(name string) error {
 	switch name {
+	case host.FieldCpus:
+		m.ClearCpus()
+		return nil
+	case host.FieldMemoryMB:
+		m.ClearMemoryMB()
+		return nil
 	case host.FieldProvisionSteps:
 		m.ClearProvisionSteps()
 		return nil
+	case host.FieldAdditionalDisks:
+		m.ClearAdditionalDisks()
+		return nil
 	}
 	return fmt.Errorf("unknown Host nullable field %s", name)
 }
@@ -16255,6 +17273,12 @@ func (m *HostMutation) ResetField(name string) error {
 	case host.FieldLastOctet:
 		m.ResetLastOctet()
 		return nil
+	case host.FieldCpus:
+		m.ResetCpus()
+		return nil
+	case host.FieldMemoryMB:
+		m.ResetMemoryMB()
+		return nil
 	case host.FieldInstanceSize:
 		m.ResetInstanceSize()
 		return nil
@@ -16279,6 +17303,9 @@ func (m *HostMutation) ResetField(name string) error {
 	case host.FieldProvisionSteps:
 		m.ResetProvisionSteps()
 		return nil
+	case host.FieldAdditionalDisks:
+		m.ResetAdditionalDisks()
+		return nil
 	case host.FieldTags:
 		m.ResetTags()
 		return nil
@@ -18560,9 +19587,11 @@ type NetworkMutation struct {
 	hcl_id                           *string
 	name                             *string
 	cidr                             *string
+	ipv6_cidr                        *string
 	vdi_visible                      *bool
 	vars                             *map[string]string
 	tags                             *map[string]string
+	reserved                         *[]string
 	clearedFields                    map[string]struct{}
 	_NetworkToEnvironment            *uuid.UUID
 	cleared_NetworkToEnvironment     bool
@@ -18770,6 +19799,42 @@ func (m *NetworkMutation) ResetCidr() {
 	m.cidr = nil
 }
 
+// SetIpv6Cidr sets the "ipv6_cidr" field.
+func (m *NetworkMutation) SetIpv6Cidr(s string) {
+	m.ipv6_cidr = &s
+}
+
+// Ipv6Cidr returns the value of the "ipv6_cidr" field in the mutation.
+func (m *NetworkMutation) Ipv6Cidr() (r string, exists bool) {
+	v := m.ipv6_cidr
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIpv6Cidr returns the old "ipv6_cidr" field's value of the Network entity.
+// If the Network object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NetworkMutation) OldIpv6Cidr(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldIpv6Cidr is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldIpv6Cidr requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIpv6Cidr: %w", err)
+	}
+	return oldValue.Ipv6Cidr, nil
+}
+
+// ResetIpv6Cidr resets all changes to the "ipv6_cidr" field.
+func (m *NetworkMutation) ResetIpv6Cidr() {
+	m.ipv6_cidr = nil
+}
+
 // SetVdiVisible sets the "vdi_visible" field.
 func (m *NetworkMutation) SetVdiVisible(b bool) {
 	m.vdi_visible = &b
@@ -18878,6 +19943,55 @@ func (m *NetworkMutation) ResetTags() {
 	m.tags = nil
 }
 
+// SetReserved sets the "reserved" field.
+func (m *NetworkMutation) SetReserved(s []string) {
+	m.reserved = &s
+}
+
+// Reserved returns the value of the "reserved" field in the mutation.
+func (m *NetworkMutation) Reserved() (r []string, exists bool) {
+	v := m.reserved
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReserved returns the old "reserved" field's value of the Network entity.
+// If the Network object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NetworkMutation) OldReserved(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldReserved is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldReserved requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReserved: %w", err)
+	}
+	return oldValue.Reserved, nil
+}
+
+// ClearReserved clears the value of the "reserved" field.
+func (m *NetworkMutation) ClearReserved() {
+	m.reserved = nil
+	m.clearedFields[network.FieldReserved] = struct{}{}
+}
+
+// ReservedCleared returns if the "reserved" field was cleared in this mutation.
+func (m *NetworkMutation) ReservedCleared() bool {
+	_, ok := m.clearedFields[network.FieldReserved]
+	return ok
+}
+
+// ResetReserved resets all changes to the "reserved" field.
+func (m *NetworkMutation) ResetReserved() {
+	m.reserved = nil
+	delete(m.clearedFields, network.FieldReserved)
+}
+
 // SetNetworkToEnvironmentID sets the "NetworkToEnvironment" edge to the Environment entity by id.
 func (m *NetworkMutation) SetNetworkToEnvironmentID(id uuid.UUID) {
 	m._NetworkToEnvironment = &id
@@ -19044,7 +20158,7 @@ func (m *NetworkMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *NetworkMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+	fields := make([]string, 0, 8)
 	if m.hcl_id != nil {
 		fields = append(fields, network.FieldHclID)
 	}
@@ -19054,6 +20168,9 @@ func (m *NetworkMutation) Fields() []string {
 	if m.cidr != nil {
 		fields = append(fields, network.FieldCidr)
 	}
+	if m.ipv6_cidr != nil {
+		fields = append(fields, network.FieldIpv6Cidr)
+	}
 	if m.vdi_visible != nil {
 		fields = append(fields, network.FieldVdiVisible)
 	}
@@ -19063,6 +20180,9 @@ func (m *NetworkMutation) Fields() []string {
 	if m.tags != nil {
 		fields = append(fields, network.FieldTags)
 	}
+	if m.reserved != nil {
+		fields = append(fields, network.FieldReserved)
+	}
 	return fields
 }
 
@@ -19077,12 +20197,16 @@ func (m *NetworkMutation) Field(name string) (ent.Value, bool) {
 		return m.Name()
 	case network.FieldCidr:
 		return m.Cidr()
+	case network.FieldIpv6Cidr:
+		return m.Ipv6Cidr()
 	case network.FieldVdiVisible:
 		return m.VdiVisible()
 	case network.FieldVars:
 		return m.Vars()
 	case network.FieldTags:
 		return m.Tags()
+	case network.FieldReserved:
+		return m.Reserved()
 	}
 	return nil, false
 }
@@ -19098,12 +20222,16 @@ func (m *NetworkMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldName(ctx)
 	case network.FieldCidr:
 		return m.OldCidr(ctx)
+	case network.FieldIpv6Cidr:
+		return m.OldIpv6Cidr(ctx)
 	case network.FieldVdiVisible:
 		return m.OldVdiVisible(ctx)
 	case network.FieldVars:
 		return m.OldVars(ctx)
 	case network.FieldTags:
 		return m.OldTags(ctx)
+	case network.FieldReserved:
+		return m.OldReserved(ctx)
 	}
 	return nil, fmt.Errorf("unknown Network field %s", name)
 }
@@ -19134,6 +20262,13 @@ func (m *NetworkMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetCidr(v)
 		return nil
+	case network.FieldIpv6Cidr:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIpv6Cidr(v)
+		return nil
 	case network.FieldVdiVisible:
 		v, ok := value.(bool)
 		if !ok {
@@ -19155,6 +20290,13 @@ func (m *NetworkMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetTags(v)
 		return nil
+	case network.FieldReserved:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReserved(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Network field %s", name)
 }
@@ -19184,7 +20326,11 @@ func (m *NetworkMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *NetworkMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(network.FieldReserved) {
+		fields = append(fields, network.FieldReserved)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -19197,6 +20343,11 @@ func (m *NetworkMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *NetworkMutation) ClearField(name string) error {
+	switch name {
+	case network.FieldReserved:
+		m.ClearReserved()
+		return nil
+	}
 	return fmt.Errorf("unknown Network nullable field %s", name)
 }
 
@@ -19213,6 +20364,9 @@ func (m *NetworkMutation) ResetField(name string) error {
 	case network.FieldCidr:
 		m.ResetCidr()
 		return nil
+	case network.FieldIpv6Cidr:
+		m.ResetIpv6Cidr()
+		return nil
 	case network.FieldVdiVisible:
 		m.ResetVdiVisible()
 		return nil
@@ -19222,6 +20376,9 @@ func (m *NetworkMutation) ResetField(name string) error {
 	case network.FieldTags:
 		m.ResetTags()
 		return nil
+	case network.FieldReserved:
+		m.ResetReserved()
+		return nil
 	}
 	return fmt.Errorf("unknown Network field %s", name)
 }
@@ -19364,6 +20521,7 @@ type PlanMutation struct {
 	addstep_number                   *int
 	_type                            *plan.Type
 	build_id                         *string
+	checksum                         *string
 	clearedFields                    map[string]struct{}
 	_PrevPlan                        map[uuid.UUID]struct{}
 	removed_PrevPlan                 map[uuid.UUID]struct{}
@@ -19604,6 +20762,42 @@ func (m *PlanMutation) ResetBuildID() {
 	m.build_id = nil
 }
 
+// SetChecksum sets the "checksum" field.
+func (m *PlanMutation) SetChecksum(s string) {
+	m.checksum = &s
+}
+
+// Checksum returns the value of the "checksum" field in the mutation.
+func (m *PlanMutation) Checksum() (r string, exists bool) {
+	v := m.checksum
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChecksum returns the old "checksum" field's value of the Plan entity.
+// If the Plan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlanMutation) OldChecksum(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldChecksum is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldChecksum requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChecksum: %w", err)
+	}
+	return oldValue.Checksum, nil
+}
+
+// ResetChecksum resets all changes to the "checksum" field.
+func (m *PlanMutation) ResetChecksum() {
+	m.checksum = nil
+}
+
 // AddPrevPlanIDs adds the "PrevPlan" edge to the Plan entity by ids.
 func (m *PlanMutation) AddPrevPlanIDs(ids ...uuid.UUID) {
 	if m._PrevPlan == nil {
@@ -20019,7 +21213,7 @@ func (m *PlanMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *PlanMutation) Fields() []string {
-	fields := make([]string, 0, 3)
+	fields := make([]string, 0, 4)
 	if m.step_number != nil {
 		fields = append(fields, plan.FieldStepNumber)
 	}
@@ -20029,6 +21223,9 @@ func (m *PlanMutation) Fields() []string {
 	if m.build_id != nil {
 		fields = append(fields, plan.FieldBuildID)
 	}
+	if m.checksum != nil {
+		fields = append(fields, plan.FieldChecksum)
+	}
 	return fields
 }
 
@@ -20043,6 +21240,8 @@ func (m *PlanMutation) Field(name string) (ent.Value, bool) {
 		return m.GetType()
 	case plan.FieldBuildID:
 		return m.BuildID()
+	case plan.FieldChecksum:
+		return m.Checksum()
 	}
 	return nil, false
 }
@@ -20058,6 +21257,8 @@ func (m *PlanMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldType(ctx)
 	case plan.FieldBuildID:
 		return m.OldBuildID(ctx)
+	case plan.FieldChecksum:
+		return m.OldChecksum(ctx)
 	}
 	return nil, fmt.Errorf("unknown Plan field %s", name)
 }
@@ -20088,6 +21289,13 @@ func (m *PlanMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetBuildID(v)
 		return nil
+	case plan.FieldChecksum:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChecksum(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Plan field %s", name)
 }
@@ -20161,6 +21369,9 @@ func (m *PlanMutation) ResetField(name string) error {
 	case plan.FieldBuildID:
 		m.ResetBuildID()
 		return nil
+	case plan.FieldChecksum:
+		m.ResetChecksum()
+		return nil
 	}
 	return fmt.Errorf("unknown Plan field %s", name)
 }
@@ -20932,6 +22143,7 @@ type ProvisionedHostMutation struct {
 	typ                                         string
 	id                                          *uuid.UUID
 	subnet_ip                                   *string
+	subnet_ipv6                                 *string
 	addon_type                                  *provisionedhost.AddonType
 	clearedFields                               map[string]struct{}
 	_ProvisionedHostToStatus                    *uuid.UUID
@@ -21083,6 +22295,42 @@ func (m *ProvisionedHostMutation) ResetSubnetIP() {
 	m.subnet_ip = nil
 }
 
+// SetSubnetIpv6 sets the "subnet_ipv6" field.
+func (m *ProvisionedHostMutation) SetSubnetIpv6(s string) {
+	m.subnet_ipv6 = &s
+}
+
+// SubnetIpv6 returns the value of the "subnet_ipv6" field in the mutation.
+func (m *ProvisionedHostMutation) SubnetIpv6() (r string, exists bool) {
+	v := m.subnet_ipv6
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubnetIpv6 returns the old "subnet_ipv6" field's value of the ProvisionedHost entity.
+// If the ProvisionedHost object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProvisionedHostMutation) OldSubnetIpv6(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldSubnetIpv6 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldSubnetIpv6 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubnetIpv6: %w", err)
+	}
+	return oldValue.SubnetIpv6, nil
+}
+
+// ResetSubnetIpv6 resets all changes to the "subnet_ipv6" field.
+func (m *ProvisionedHostMutation) ResetSubnetIpv6() {
+	m.subnet_ipv6 = nil
+}
+
 // SetAddonType sets the "addon_type" field.
 func (m *ProvisionedHostMutation) SetAddonType(pt provisionedhost.AddonType) {
 	m.addon_type = &pt
@@ -21586,10 +22834,13 @@ func (m *ProvisionedHostMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ProvisionedHostMutation) Fields() []string {
-	fields := make([]string, 0, 2)
+	fields := make([]string, 0, 3)
 	if m.subnet_ip != nil {
 		fields = append(fields, provisionedhost.FieldSubnetIP)
 	}
+	if m.subnet_ipv6 != nil {
+		fields = append(fields, provisionedhost.FieldSubnetIpv6)
+	}
 	if m.addon_type != nil {
 		fields = append(fields, provisionedhost.FieldAddonType)
 	}
@@ -21603,6 +22854,8 @@ func (m *ProvisionedHostMutation) Field(name string) (ent.Value, bool) {
 	switch name {
 	case provisionedhost.FieldSubnetIP:
 		return m.SubnetIP()
+	case provisionedhost.FieldSubnetIpv6:
+		return m.SubnetIpv6()
 	case provisionedhost.FieldAddonType:
 		return m.AddonType()
 	}
@@ -21616,6 +22869,8 @@ func (m *ProvisionedHostMutation) OldField(ctx context.Context, name string) (en
 	switch name {
 	case provisionedhost.FieldSubnetIP:
 		return m.OldSubnetIP(ctx)
+	case provisionedhost.FieldSubnetIpv6:
+		return m.OldSubnetIpv6(ctx)
 	case provisionedhost.FieldAddonType:
 		return m.OldAddonType(ctx)
 	}
@@ -21634,6 +22889,13 @@ func (m *ProvisionedHostMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetSubnetIP(v)
 		return nil
+	case provisionedhost.FieldSubnetIpv6:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubnetIpv6(v)
+		return nil
 	case provisionedhost.FieldAddonType:
 		v, ok := value.(provisionedhost.AddonType)
 		if !ok {
@@ -21702,6 +22964,9 @@ func (m *ProvisionedHostMutation) ResetField(name string) error {
 	case provisionedhost.FieldSubnetIP:
 		m.ResetSubnetIP()
 		return nil
+	case provisionedhost.FieldSubnetIpv6:
+		m.ResetSubnetIpv6()
+		return nil
 	case provisionedhost.FieldAddonType:
 		m.ResetAddonType()
 		return nil
@@ -21979,6 +23244,7 @@ type ProvisionedNetworkMutation struct {
 	id                                          *uuid.UUID
 	name                                        *string
 	cidr                                        *string
+	ipv6_cidr                                   *string
 	clearedFields                               map[string]struct{}
 	_ProvisionedNetworkToStatus                 *uuid.UUID
 	cleared_ProvisionedNetworkToStatus          bool
@@ -22155,6 +23421,42 @@ func (m *ProvisionedNetworkMutation) ResetCidr() {
 	m.cidr = nil
 }
 
+// SetIpv6Cidr sets the "ipv6_cidr" field.
+func (m *ProvisionedNetworkMutation) SetIpv6Cidr(s string) {
+	m.ipv6_cidr = &s
+}
+
+// Ipv6Cidr returns the value of the "ipv6_cidr" field in the mutation.
+func (m *ProvisionedNetworkMutation) Ipv6Cidr() (r string, exists bool) {
+	v := m.ipv6_cidr
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIpv6Cidr returns the old "ipv6_cidr" field's value of the ProvisionedNetwork entity.
+// If the ProvisionedNetwork object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProvisionedNetworkMutation) OldIpv6Cidr(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldIpv6Cidr is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldIpv6Cidr requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIpv6Cidr: %w", err)
+	}
+	return oldValue.Ipv6Cidr, nil
+}
+
+// ResetIpv6Cidr resets all changes to the "ipv6_cidr" field.
+func (m *ProvisionedNetworkMutation) ResetIpv6Cidr() {
+	m.ipv6_cidr = nil
+}
+
 // SetProvisionedNetworkToStatusID sets the "ProvisionedNetworkToStatus" edge to the Status entity by id.
 func (m *ProvisionedNetworkMutation) SetProvisionedNetworkToStatusID(id uuid.UUID) {
 	m._ProvisionedNetworkToStatus = &id
@@ -22423,13 +23725,16 @@ func (m *ProvisionedNetworkMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ProvisionedNetworkMutation) Fields() []string {
-	fields := make([]string, 0, 2)
+	fields := make([]string, 0, 3)
 	if m.name != nil {
 		fields = append(fields, provisionednetwork.FieldName)
 	}
 	if m.cidr != nil {
 		fields = append(fields, provisionednetwork.FieldCidr)
 	}
+	if m.ipv6_cidr != nil {
+		fields = append(fields, provisionednetwork.FieldIpv6Cidr)
+	}
 	return fields
 }
 
@@ -22442,6 +23747,8 @@ func (m *ProvisionedNetworkMutation) Field(name string) (ent.Value, bool) {
 		return m.Name()
 	case provisionednetwork.FieldCidr:
 		return m.Cidr()
+	case provisionednetwork.FieldIpv6Cidr:
+		return m.Ipv6Cidr()
 	}
 	return nil, false
 }
@@ -22455,6 +23762,8 @@ func (m *ProvisionedNetworkMutation) OldField(ctx context.Context, name string)
 		return m.OldName(ctx)
 	case provisionednetwork.FieldCidr:
 		return m.OldCidr(ctx)
+	case provisionednetwork.FieldIpv6Cidr:
+		return m.OldIpv6Cidr(ctx)
 	}
 	return nil, fmt.Errorf("unknown ProvisionedNetwork field %s", name)
 }
@@ -22478,6 +23787,13 @@ func (m *ProvisionedNetworkMutation) SetField(name string, value ent.Value) erro
 		}
 		m.SetCidr(v)
 		return nil
+	case provisionednetwork.FieldIpv6Cidr:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIpv6Cidr(v)
+		return nil
 	}
 	return fmt.Errorf("unknown ProvisionedNetwork field %s", name)
 }
@@ -22533,6 +23849,9 @@ func (m *ProvisionedNetworkMutation) ResetField(name string) error {
 	case provisionednetwork.FieldCidr:
 		m.ResetCidr()
 		return nil
+	case provisionednetwork.FieldIpv6Cidr:
+		m.ResetIpv6Cidr()
+		return nil
 	}
 	return fmt.Errorf("unknown ProvisionedNetwork field %s", name)
 }
@@ -24411,6 +25730,7 @@ type ScriptMutation struct {
 	vars                        *map[string]string
 	abs_path                    *string
 	tags                        *map[string]string
+	retry                       **retry.Policy
 	clearedFields               map[string]struct{}
 	_ScriptToUser               map[uuid.UUID]struct{}
 	removed_ScriptToUser        map[uuid.UUID]struct{}
@@ -25054,6 +26374,55 @@ func (m *ScriptMutation) ResetTags() {
 	m.tags = nil
 }
 
+// SetRetry sets the "retry" field.
+func (m *ScriptMutation) SetRetry(value *retry.Policy) {
+	m.retry = &value
+}
+
+// Retry returns the value of the "retry" field in the mutation.
+func (m *ScriptMutation) Retry() (r *retry.Policy, exists bool) {
+	v := m.retry
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetry returns the old "retry" field's value of the Script entity.
+// If the Script object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScriptMutation) OldRetry(ctx context.Context) (v *retry.Policy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldRetry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldRetry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetry: %w", err)
+	}
+	return oldValue.Retry, nil
+}
+
+// ClearRetry clears the value of the "retry" field.
+func (m *ScriptMutation) ClearRetry() {
+	m.retry = nil
+	m.clearedFields[script.FieldRetry] = struct{}{}
+}
+
+// RetryCleared returns if the "retry" field was cleared in this mutation.
+func (m *ScriptMutation) RetryCleared() bool {
+	_, ok := m.clearedFields[script.FieldRetry]
+	return ok
+}
+
+// ResetRetry resets all changes to the "retry" field.
+func (m *ScriptMutation) ResetRetry() {
+	m.retry = nil
+	delete(m.clearedFields, script.FieldRetry)
+}
+
 // AddScriptToUserIDs adds the "ScriptToUser" edge to the User entity by ids.
 func (m *ScriptMutation) AddScriptToUserIDs(ids ...uuid.UUID) {
 	if m._ScriptToUser == nil {
@@ -25220,7 +26589,7 @@ func (m *ScriptMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ScriptMutation) Fields() []string {
-	fields := make([]string, 0, 14)
+	fields := make([]string, 0, 15)
 	if m.hcl_id != nil {
 		fields = append(fields, script.FieldHclID)
 	}
@@ -25263,6 +26632,9 @@ func (m *ScriptMutation) Fields() []string {
 	if m.tags != nil {
 		fields = append(fields, script.FieldTags)
 	}
+	if m.retry != nil {
+		fields = append(fields, script.FieldRetry)
+	}
 	return fields
 }
 
@@ -25299,6 +26671,8 @@ func (m *ScriptMutation) Field(name string) (ent.Value, bool) {
 		return m.AbsPath()
 	case script.FieldTags:
 		return m.Tags()
+	case script.FieldRetry:
+		return m.Retry()
 	}
 	return nil, false
 }
@@ -25336,6 +26710,8 @@ func (m *ScriptMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldAbsPath(ctx)
 	case script.FieldTags:
 		return m.OldTags(ctx)
+	case script.FieldRetry:
+		return m.OldRetry(ctx)
 	}
 	return nil, fmt.Errorf("unknown Script field %s", name)
 }
@@ -25443,6 +26819,13 @@ func (m *ScriptMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetTags(v)
 		return nil
+	case script.FieldRetry:
+		v, ok := value.(*retry.Policy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetry(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Script field %s", name)
 }
@@ -25499,7 +26882,11 @@ func (m *ScriptMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *ScriptMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(script.FieldRetry) {
+		fields = append(fields, script.FieldRetry)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -25512,6 +26899,11 @@ func (m *ScriptMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *ScriptMutation) ClearField(name string) error {
+	switch name {
+	case script.FieldRetry:
+		m.ClearRetry()
+		return nil
+	}
 	return fmt.Errorf("unknown Script nullable field %s", name)
 }
 
@@ -25561,6 +26953,9 @@ func (m *ScriptMutation) ResetField(name string) error {
 	case script.FieldTags:
 		m.ResetTags()
 		return nil
+	case script.FieldRetry:
+		m.ResetRetry()
+		return nil
 	}
 	return fmt.Errorf("unknown Script field %s", name)
 }
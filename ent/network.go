@@ -24,12 +24,16 @@ type Network struct {
 	Name string `json:"name,omitempty" hcl:"name,attr"`
 	// Cidr holds the value of the "cidr" field.
 	Cidr string `json:"cidr,omitempty" hcl:"cidr,attr"`
+	// Ipv6Cidr holds the value of the "ipv6_cidr" field.
+	Ipv6Cidr string `json:"ipv6_cidr,omitempty" hcl:"ipv6_cidr,optional"`
 	// VdiVisible holds the value of the "vdi_visible" field.
 	VdiVisible bool `json:"vdi_visible,omitempty" hcl:"vdi_visible,optional"`
 	// Vars holds the value of the "vars" field.
 	Vars map[string]string `json:"vars,omitempty" hcl:"vars,optional"`
 	// Tags holds the value of the "tags" field.
 	Tags map[string]string `json:"tags,omitempty" hcl:"tags,optional"`
+	// Reserved holds the value of the "reserved" field.
+	Reserved []string `json:"reserved,omitempty" hcl:"reserved,optional"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the NetworkQuery when eager-loading is set.
 	Edges NetworkEdges `json:"edges"`
@@ -95,11 +99,11 @@ func (*Network) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case network.FieldVars, network.FieldTags:
+		case network.FieldVars, network.FieldTags, network.FieldReserved:
 			values[i] = new([]byte)
 		case network.FieldVdiVisible:
 			values[i] = new(sql.NullBool)
-		case network.FieldHclID, network.FieldName, network.FieldCidr:
+		case network.FieldHclID, network.FieldName, network.FieldCidr, network.FieldIpv6Cidr:
 			values[i] = new(sql.NullString)
 		case network.FieldID:
 			values[i] = new(uuid.UUID)
@@ -144,6 +148,12 @@ func (n *Network) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				n.Cidr = value.String
 			}
+		case network.FieldIpv6Cidr:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ipv6_cidr", values[i])
+			} else if value.Valid {
+				n.Ipv6Cidr = value.String
+			}
 		case network.FieldVdiVisible:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field vdi_visible", values[i])
@@ -166,6 +176,14 @@ func (n *Network) assignValues(columns []string, values []interface{}) error {
 					return fmt.Errorf("unmarshal field tags: %w", err)
 				}
 			}
+		case network.FieldReserved:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field reserved", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &n.Reserved); err != nil {
+					return fmt.Errorf("unmarshal field reserved: %w", err)
+				}
+			}
 		case network.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field environment_environment_to_network", values[i])
@@ -222,12 +240,16 @@ func (n *Network) String() string {
 	builder.WriteString(n.Name)
 	builder.WriteString(", cidr=")
 	builder.WriteString(n.Cidr)
+	builder.WriteString(", ipv6_cidr=")
+	builder.WriteString(n.Ipv6Cidr)
 	builder.WriteString(", vdi_visible=")
 	builder.WriteString(fmt.Sprintf("%v", n.VdiVisible))
 	builder.WriteString(", vars=")
 	builder.WriteString(fmt.Sprintf("%v", n.Vars))
 	builder.WriteString(", tags=")
 	builder.WriteString(fmt.Sprintf("%v", n.Tags))
+	builder.WriteString(", reserved=")
+	builder.WriteString(fmt.Sprintf("%v", n.Reserved))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -17,12 +17,16 @@ const (
 	FieldName = "name"
 	// FieldCidr holds the string denoting the cidr field in the database.
 	FieldCidr = "cidr"
+	// FieldIpv6Cidr holds the string denoting the ipv6_cidr field in the database.
+	FieldIpv6Cidr = "ipv6_cidr"
 	// FieldVdiVisible holds the string denoting the vdi_visible field in the database.
 	FieldVdiVisible = "vdi_visible"
 	// FieldVars holds the string denoting the vars field in the database.
 	FieldVars = "vars"
 	// FieldTags holds the string denoting the tags field in the database.
 	FieldTags = "tags"
+	// FieldReserved holds the string denoting the reserved field in the database.
+	FieldReserved = "reserved"
 	// EdgeNetworkToEnvironment holds the string denoting the networktoenvironment edge name in mutations.
 	EdgeNetworkToEnvironment = "NetworkToEnvironment"
 	// EdgeNetworkToHostDependency holds the string denoting the networktohostdependency edge name in mutations.
@@ -60,9 +64,11 @@ var Columns = []string{
 	FieldHclID,
 	FieldName,
 	FieldCidr,
+	FieldIpv6Cidr,
 	FieldVdiVisible,
 	FieldVars,
 	FieldTags,
+	FieldReserved,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "networks"
@@ -87,6 +93,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultIpv6Cidr holds the default value on creation for the "ipv6_cidr" field.
+	DefaultIpv6Cidr string
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
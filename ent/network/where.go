@@ -113,6 +113,13 @@ func Cidr(v string) predicate.Network {
 	})
 }
 
+// Ipv6Cidr applies equality check predicate on the "ipv6_cidr" field. It's identical to Ipv6CidrEQ.
+func Ipv6Cidr(v string) predicate.Network {
+	return predicate.Network(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIpv6Cidr), v))
+	})
+}
+
 // VdiVisible applies equality check predicate on the "vdi_visible" field. It's identical to VdiVisibleEQ.
 func VdiVisible(v bool) predicate.Network {
 	return predicate.Network(func(s *sql.Selector) {
@@ -453,6 +460,117 @@ func CidrContainsFold(v string) predicate.Network {
 	})
 }
 
+// Ipv6CidrEQ applies the EQ predicate on the "ipv6_cidr" field.
+func Ipv6CidrEQ(v string) predicate.Network {
+	return predicate.Network(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIpv6Cidr), v))
+	})
+}
+
+// Ipv6CidrNEQ applies the NEQ predicate on the "ipv6_cidr" field.
+func Ipv6CidrNEQ(v string) predicate.Network {
+	return predicate.Network(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldIpv6Cidr), v))
+	})
+}
+
+// Ipv6CidrIn applies the In predicate on the "ipv6_cidr" field.
+func Ipv6CidrIn(vs ...string) predicate.Network {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Network(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldIpv6Cidr), v...))
+	})
+}
+
+// Ipv6CidrNotIn applies the NotIn predicate on the "ipv6_cidr" field.
+func Ipv6CidrNotIn(vs ...string) predicate.Network {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Network(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldIpv6Cidr), v...))
+	})
+}
+
+// Ipv6CidrGT applies the GT predicate on the "ipv6_cidr" field.
+func Ipv6CidrGT(v string) predicate.Network {
+	return predicate.Network(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldIpv6Cidr), v))
+	})
+}
+
+// Ipv6CidrGTE applies the GTE predicate on the "ipv6_cidr" field.
+func Ipv6CidrGTE(v string) predicate.Network {
+	return predicate.Network(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldIpv6Cidr), v))
+	})
+}
+
+// Ipv6CidrLT applies the LT predicate on the "ipv6_cidr" field.
+func Ipv6CidrLT(v string) predicate.Network {
+	return predicate.Network(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldIpv6Cidr), v))
+	})
+}
+
+// Ipv6CidrLTE applies the LTE predicate on the "ipv6_cidr" field.
+func Ipv6CidrLTE(v string) predicate.Network {
+	return predicate.Network(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldIpv6Cidr), v))
+	})
+}
+
+// Ipv6CidrContains applies the Contains predicate on the "ipv6_cidr" field.
+func Ipv6CidrContains(v string) predicate.Network {
+	return predicate.Network(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldIpv6Cidr), v))
+	})
+}
+
+// Ipv6CidrHasPrefix applies the HasPrefix predicate on the "ipv6_cidr" field.
+func Ipv6CidrHasPrefix(v string) predicate.Network {
+	return predicate.Network(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldIpv6Cidr), v))
+	})
+}
+
+// Ipv6CidrHasSuffix applies the HasSuffix predicate on the "ipv6_cidr" field.
+func Ipv6CidrHasSuffix(v string) predicate.Network {
+	return predicate.Network(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldIpv6Cidr), v))
+	})
+}
+
+// Ipv6CidrEqualFold applies the EqualFold predicate on the "ipv6_cidr" field.
+func Ipv6CidrEqualFold(v string) predicate.Network {
+	return predicate.Network(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldIpv6Cidr), v))
+	})
+}
+
+// Ipv6CidrContainsFold applies the ContainsFold predicate on the "ipv6_cidr" field.
+func Ipv6CidrContainsFold(v string) predicate.Network {
+	return predicate.Network(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldIpv6Cidr), v))
+	})
+}
+
 // VdiVisibleEQ applies the EQ predicate on the "vdi_visible" field.
 func VdiVisibleEQ(v bool) predicate.Network {
 	return predicate.Network(func(s *sql.Selector) {
@@ -467,6 +585,20 @@ func VdiVisibleNEQ(v bool) predicate.Network {
 	})
 }
 
+// ReservedIsNil applies the IsNil predicate on the "reserved" field.
+func ReservedIsNil() predicate.Network {
+	return predicate.Network(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldReserved)))
+	})
+}
+
+// ReservedNotNil applies the NotNil predicate on the "reserved" field.
+func ReservedNotNil() predicate.Network {
+	return predicate.Network(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldReserved)))
+	})
+}
+
 // HasNetworkToEnvironment applies the HasEdge predicate on the "NetworkToEnvironment" edge.
 func HasNetworkToEnvironment() predicate.Network {
 	return predicate.Network(func(s *sql.Selector) {
@@ -41,6 +41,20 @@ func (nc *NetworkCreate) SetCidr(s string) *NetworkCreate {
 	return nc
 }
 
+// SetIpv6Cidr sets the "ipv6_cidr" field.
+func (nc *NetworkCreate) SetIpv6Cidr(s string) *NetworkCreate {
+	nc.mutation.SetIpv6Cidr(s)
+	return nc
+}
+
+// SetNillableIpv6Cidr sets the "ipv6_cidr" field if the given value is not nil.
+func (nc *NetworkCreate) SetNillableIpv6Cidr(s *string) *NetworkCreate {
+	if s != nil {
+		nc.SetIpv6Cidr(*s)
+	}
+	return nc
+}
+
 // SetVdiVisible sets the "vdi_visible" field.
 func (nc *NetworkCreate) SetVdiVisible(b bool) *NetworkCreate {
 	nc.mutation.SetVdiVisible(b)
@@ -59,6 +73,12 @@ func (nc *NetworkCreate) SetTags(m map[string]string) *NetworkCreate {
 	return nc
 }
 
+// SetReserved sets the "reserved" field.
+func (nc *NetworkCreate) SetReserved(s []string) *NetworkCreate {
+	nc.mutation.SetReserved(s)
+	return nc
+}
+
 // SetID sets the "id" field.
 func (nc *NetworkCreate) SetID(u uuid.UUID) *NetworkCreate {
 	nc.mutation.SetID(u)
@@ -185,6 +205,10 @@ func (nc *NetworkCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (nc *NetworkCreate) defaults() {
+	if _, ok := nc.mutation.Ipv6Cidr(); !ok {
+		v := network.DefaultIpv6Cidr
+		nc.mutation.SetIpv6Cidr(v)
+	}
 	if _, ok := nc.mutation.ID(); !ok {
 		v := network.DefaultID()
 		nc.mutation.SetID(v)
@@ -202,6 +226,9 @@ func (nc *NetworkCreate) check() error {
 	if _, ok := nc.mutation.Cidr(); !ok {
 		return &ValidationError{Name: "cidr", err: errors.New(`ent: missing required field "cidr"`)}
 	}
+	if _, ok := nc.mutation.Ipv6Cidr(); !ok {
+		return &ValidationError{Name: "ipv6_cidr", err: errors.New(`ent: missing required field "ipv6_cidr"`)}
+	}
 	if _, ok := nc.mutation.VdiVisible(); !ok {
 		return &ValidationError{Name: "vdi_visible", err: errors.New(`ent: missing required field "vdi_visible"`)}
 	}
@@ -267,6 +294,14 @@ func (nc *NetworkCreate) createSpec() (*Network, *sqlgraph.CreateSpec) {
 		})
 		_node.Cidr = value
 	}
+	if value, ok := nc.mutation.Ipv6Cidr(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: network.FieldIpv6Cidr,
+		})
+		_node.Ipv6Cidr = value
+	}
 	if value, ok := nc.mutation.VdiVisible(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeBool,
@@ -291,6 +326,14 @@ func (nc *NetworkCreate) createSpec() (*Network, *sqlgraph.CreateSpec) {
 		})
 		_node.Tags = value
 	}
+	if value, ok := nc.mutation.Reserved(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: network.FieldReserved,
+		})
+		_node.Reserved = value
+	}
 	if nodes := nc.mutation.NetworkToEnvironmentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -48,6 +48,12 @@ func (nu *NetworkUpdate) SetCidr(s string) *NetworkUpdate {
 	return nu
 }
 
+// SetIpv6Cidr sets the "ipv6_cidr" field.
+func (nu *NetworkUpdate) SetIpv6Cidr(s string) *NetworkUpdate {
+	nu.mutation.SetIpv6Cidr(s)
+	return nu
+}
+
 // SetVdiVisible sets the "vdi_visible" field.
 func (nu *NetworkUpdate) SetVdiVisible(b bool) *NetworkUpdate {
 	nu.mutation.SetVdiVisible(b)
@@ -66,6 +72,18 @@ func (nu *NetworkUpdate) SetTags(m map[string]string) *NetworkUpdate {
 	return nu
 }
 
+// SetReserved sets the "reserved" field.
+func (nu *NetworkUpdate) SetReserved(s []string) *NetworkUpdate {
+	nu.mutation.SetReserved(s)
+	return nu
+}
+
+// ClearReserved clears the value of the "reserved" field.
+func (nu *NetworkUpdate) ClearReserved() *NetworkUpdate {
+	nu.mutation.ClearReserved()
+	return nu
+}
+
 // SetNetworkToEnvironmentID sets the "NetworkToEnvironment" edge to the Environment entity by ID.
 func (nu *NetworkUpdate) SetNetworkToEnvironmentID(id uuid.UUID) *NetworkUpdate {
 	nu.mutation.SetNetworkToEnvironmentID(id)
@@ -261,6 +279,13 @@ func (nu *NetworkUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: network.FieldCidr,
 		})
 	}
+	if value, ok := nu.mutation.Ipv6Cidr(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: network.FieldIpv6Cidr,
+		})
+	}
 	if value, ok := nu.mutation.VdiVisible(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeBool,
@@ -282,6 +307,19 @@ func (nu *NetworkUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: network.FieldTags,
 		})
 	}
+	if value, ok := nu.mutation.Reserved(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: network.FieldReserved,
+		})
+	}
+	if nu.mutation.ReservedCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: network.FieldReserved,
+		})
+	}
 	if nu.mutation.NetworkToEnvironmentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -462,6 +500,12 @@ func (nuo *NetworkUpdateOne) SetCidr(s string) *NetworkUpdateOne {
 	return nuo
 }
 
+// SetIpv6Cidr sets the "ipv6_cidr" field.
+func (nuo *NetworkUpdateOne) SetIpv6Cidr(s string) *NetworkUpdateOne {
+	nuo.mutation.SetIpv6Cidr(s)
+	return nuo
+}
+
 // SetVdiVisible sets the "vdi_visible" field.
 func (nuo *NetworkUpdateOne) SetVdiVisible(b bool) *NetworkUpdateOne {
 	nuo.mutation.SetVdiVisible(b)
@@ -480,6 +524,18 @@ func (nuo *NetworkUpdateOne) SetTags(m map[string]string) *NetworkUpdateOne {
 	return nuo
 }
 
+// SetReserved sets the "reserved" field.
+func (nuo *NetworkUpdateOne) SetReserved(s []string) *NetworkUpdateOne {
+	nuo.mutation.SetReserved(s)
+	return nuo
+}
+
+// ClearReserved clears the value of the "reserved" field.
+func (nuo *NetworkUpdateOne) ClearReserved() *NetworkUpdateOne {
+	nuo.mutation.ClearReserved()
+	return nuo
+}
+
 // SetNetworkToEnvironmentID sets the "NetworkToEnvironment" edge to the Environment entity by ID.
 func (nuo *NetworkUpdateOne) SetNetworkToEnvironmentID(id uuid.UUID) *NetworkUpdateOne {
 	nuo.mutation.SetNetworkToEnvironmentID(id)
@@ -699,6 +755,13 @@ func (nuo *NetworkUpdateOne) sqlSave(ctx context.Context) (_node *Network, err e
 			Column: network.FieldCidr,
 		})
 	}
+	if value, ok := nuo.mutation.Ipv6Cidr(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: network.FieldIpv6Cidr,
+		})
+	}
 	if value, ok := nuo.mutation.VdiVisible(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeBool,
@@ -720,6 +783,19 @@ func (nuo *NetworkUpdateOne) sqlSave(ctx context.Context) (_node *Network, err e
 			Column: network.FieldTags,
 		})
 	}
+	if value, ok := nuo.mutation.Reserved(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: network.FieldReserved,
+		})
+	}
+	if nuo.mutation.ReservedCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: network.FieldReserved,
+		})
+	}
 	if nuo.mutation.NetworkToEnvironmentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
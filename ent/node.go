@@ -292,7 +292,7 @@ func (at *AgentTask) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     at.ID,
 		Type:   "AgentTask",
-		Fields: make([]*Field, 6),
+		Fields: make([]*Field, 8),
 		Edges:  make([]*Edge, 3),
 	}
 	var buf []byte
@@ -320,10 +320,26 @@ func (at *AgentTask) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "number",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(at.Output); err != nil {
+	if buf, err = json.Marshal(at.Timeout); err != nil {
 		return nil, err
 	}
 	node.Fields[3] = &Field{
+		Type:  "int",
+		Name:  "timeout",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(at.Attempt); err != nil {
+		return nil, err
+	}
+	node.Fields[4] = &Field{
+		Type:  "int",
+		Name:  "attempt",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(at.Output); err != nil {
+		return nil, err
+	}
+	node.Fields[5] = &Field{
 		Type:  "string",
 		Name:  "output",
 		Value: string(buf),
@@ -331,7 +347,7 @@ func (at *AgentTask) Node(ctx context.Context) (node *Node, err error) {
 	if buf, err = json.Marshal(at.State); err != nil {
 		return nil, err
 	}
-	node.Fields[4] = &Field{
+	node.Fields[6] = &Field{
 		Type:  "agenttask.State",
 		Name:  "state",
 		Value: string(buf),
@@ -339,7 +355,7 @@ func (at *AgentTask) Node(ctx context.Context) (node *Node, err error) {
 	if buf, err = json.Marshal(at.ErrorMessage); err != nil {
 		return nil, err
 	}
-	node.Fields[5] = &Field{
+	node.Fields[7] = &Field{
 		Type:  "string",
 		Name:  "error_message",
 		Value: string(buf),
@@ -625,7 +641,7 @@ func (bc *BuildCommit) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     bc.ID,
 		Type:   "BuildCommit",
-		Fields: make([]*Field, 3),
+		Fields: make([]*Field, 5),
 		Edges:  make([]*Edge, 2),
 	}
 	var buf []byte
@@ -653,6 +669,22 @@ func (bc *BuildCommit) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "state",
 		Value: string(buf),
 	}
+	if buf, err = json.Marshal(bc.CreatedBy); err != nil {
+		return nil, err
+	}
+	node.Fields[3] = &Field{
+		Type:  "string",
+		Name:  "created_by",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(bc.Approvals); err != nil {
+		return nil, err
+	}
+	node.Fields[4] = &Field{
+		Type:  "[]approval.Approval",
+		Name:  "approvals",
+		Value: string(buf),
+	}
 	node.Edges[0] = &Edge{
 		Type: "Build",
 		Name: "BuildCommitToBuild",
@@ -680,7 +712,7 @@ func (c *Command) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     c.ID,
 		Type:   "Command",
-		Fields: make([]*Field, 11),
+		Fields: make([]*Field, 12),
 		Edges:  make([]*Edge, 2),
 	}
 	var buf []byte
@@ -772,6 +804,14 @@ func (c *Command) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "tags",
 		Value: string(buf),
 	}
+	if buf, err = json.Marshal(c.Retry); err != nil {
+		return nil, err
+	}
+	node.Fields[11] = &Field{
+		Type:  "*retry.Policy",
+		Name:  "retry",
+		Value: string(buf),
+	}
 	node.Edges[0] = &Edge{
 		Type: "User",
 		Name: "CommandToUser",
@@ -1065,7 +1105,7 @@ func (e *Environment) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     e.ID,
 		Type:   "Environment",
-		Fields: make([]*Field, 11),
+		Fields: make([]*Field, 12),
 		Edges:  make([]*Edge, 17),
 	}
 	var buf []byte
@@ -1157,6 +1197,14 @@ func (e *Environment) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "tags",
 		Value: string(buf),
 	}
+	if buf, err = json.Marshal(e.ApprovalPolicy); err != nil {
+		return nil, err
+	}
+	node.Fields[11] = &Field{
+		Type:  "*approval.Policy",
+		Name:  "approval_policy",
+		Value: string(buf),
+	}
 	node.Edges[0] = &Edge{
 		Type: "User",
 		Name: "EnvironmentToUser",
@@ -1334,7 +1382,7 @@ func (fd *FileDelete) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     fd.ID,
 		Type:   "FileDelete",
-		Fields: make([]*Field, 3),
+		Fields: make([]*Field, 4),
 		Edges:  make([]*Edge, 1),
 	}
 	var buf []byte
@@ -1362,6 +1410,14 @@ func (fd *FileDelete) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "tags",
 		Value: string(buf),
 	}
+	if buf, err = json.Marshal(fd.Retry); err != nil {
+		return nil, err
+	}
+	node.Fields[3] = &Field{
+		Type:  "*retry.Policy",
+		Name:  "retry",
+		Value: string(buf),
+	}
 	node.Edges[0] = &Edge{
 		Type: "Environment",
 		Name: "FileDeleteToEnvironment",
@@ -1379,7 +1435,7 @@ func (fd *FileDownload) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     fd.ID,
 		Type:   "FileDownload",
-		Fields: make([]*Field, 10),
+		Fields: make([]*Field, 12),
 		Edges:  make([]*Edge, 1),
 	}
 	var buf []byte
@@ -1463,6 +1519,22 @@ func (fd *FileDownload) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "tags",
 		Value: string(buf),
 	}
+	if buf, err = json.Marshal(fd.Retry); err != nil {
+		return nil, err
+	}
+	node.Fields[10] = &Field{
+		Type:  "*retry.Policy",
+		Name:  "retry",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(fd.Sha256); err != nil {
+		return nil, err
+	}
+	node.Fields[11] = &Field{
+		Type:  "string",
+		Name:  "sha256",
+		Value: string(buf),
+	}
 	node.Edges[0] = &Edge{
 		Type: "Environment",
 		Name: "FileDownloadToEnvironment",
@@ -1480,7 +1552,7 @@ func (fe *FileExtract) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     fe.ID,
 		Type:   "FileExtract",
-		Fields: make([]*Field, 5),
+		Fields: make([]*Field, 6),
 		Edges:  make([]*Edge, 1),
 	}
 	var buf []byte
@@ -1524,6 +1596,14 @@ func (fe *FileExtract) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "tags",
 		Value: string(buf),
 	}
+	if buf, err = json.Marshal(fe.Retry); err != nil {
+		return nil, err
+	}
+	node.Fields[5] = &Field{
+		Type:  "*retry.Policy",
+		Name:  "retry",
+		Value: string(buf),
+	}
 	node.Edges[0] = &Edge{
 		Type: "Environment",
 		Name: "FileExtractToEnvironment",
@@ -1687,7 +1767,7 @@ func (h *Host) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     h.ID,
 		Type:   "Host",
-		Fields: make([]*Field, 14),
+		Fields: make([]*Field, 17),
 		Edges:  make([]*Edge, 6),
 	}
 	var buf []byte
@@ -1731,10 +1811,26 @@ func (h *Host) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "last_octet",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(h.InstanceSize); err != nil {
+	if buf, err = json.Marshal(h.Cpus); err != nil {
 		return nil, err
 	}
 	node.Fields[5] = &Field{
+		Type:  "int",
+		Name:  "cpus",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(h.MemoryMB); err != nil {
+		return nil, err
+	}
+	node.Fields[6] = &Field{
+		Type:  "int",
+		Name:  "memory_mb",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(h.InstanceSize); err != nil {
+		return nil, err
+	}
+	node.Fields[7] = &Field{
 		Type:  "string",
 		Name:  "instance_size",
 		Value: string(buf),
@@ -1742,7 +1838,7 @@ func (h *Host) Node(ctx context.Context) (node *Node, err error) {
 	if buf, err = json.Marshal(h.AllowMACChanges); err != nil {
 		return nil, err
 	}
-	node.Fields[6] = &Field{
+	node.Fields[8] = &Field{
 		Type:  "bool",
 		Name:  "allow_mac_changes",
 		Value: string(buf),
@@ -1750,7 +1846,7 @@ func (h *Host) Node(ctx context.Context) (node *Node, err error) {
 	if buf, err = json.Marshal(h.ExposedTCPPorts); err != nil {
 		return nil, err
 	}
-	node.Fields[7] = &Field{
+	node.Fields[9] = &Field{
 		Type:  "[]string",
 		Name:  "exposed_tcp_ports",
 		Value: string(buf),
@@ -1758,7 +1854,7 @@ func (h *Host) Node(ctx context.Context) (node *Node, err error) {
 	if buf, err = json.Marshal(h.ExposedUDPPorts); err != nil {
 		return nil, err
 	}
-	node.Fields[8] = &Field{
+	node.Fields[10] = &Field{
 		Type:  "[]string",
 		Name:  "exposed_udp_ports",
 		Value: string(buf),
@@ -1766,7 +1862,7 @@ func (h *Host) Node(ctx context.Context) (node *Node, err error) {
 	if buf, err = json.Marshal(h.OverridePassword); err != nil {
 		return nil, err
 	}
-	node.Fields[9] = &Field{
+	node.Fields[11] = &Field{
 		Type:  "string",
 		Name:  "override_password",
 		Value: string(buf),
@@ -1774,7 +1870,7 @@ func (h *Host) Node(ctx context.Context) (node *Node, err error) {
 	if buf, err = json.Marshal(h.Vars); err != nil {
 		return nil, err
 	}
-	node.Fields[10] = &Field{
+	node.Fields[12] = &Field{
 		Type:  "map[string]string",
 		Name:  "vars",
 		Value: string(buf),
@@ -1782,7 +1878,7 @@ func (h *Host) Node(ctx context.Context) (node *Node, err error) {
 	if buf, err = json.Marshal(h.UserGroups); err != nil {
 		return nil, err
 	}
-	node.Fields[11] = &Field{
+	node.Fields[13] = &Field{
 		Type:  "[]string",
 		Name:  "user_groups",
 		Value: string(buf),
@@ -1790,15 +1886,23 @@ func (h *Host) Node(ctx context.Context) (node *Node, err error) {
 	if buf, err = json.Marshal(h.ProvisionSteps); err != nil {
 		return nil, err
 	}
-	node.Fields[12] = &Field{
+	node.Fields[14] = &Field{
 		Type:  "[]string",
 		Name:  "provision_steps",
 		Value: string(buf),
 	}
+	if buf, err = json.Marshal(h.AdditionalDisks); err != nil {
+		return nil, err
+	}
+	node.Fields[15] = &Field{
+		Type:  "[]int",
+		Name:  "additional_disks",
+		Value: string(buf),
+	}
 	if buf, err = json.Marshal(h.Tags); err != nil {
 		return nil, err
 	}
-	node.Fields[13] = &Field{
+	node.Fields[16] = &Field{
 		Type:  "map[string]string",
 		Name:  "tags",
 		Value: string(buf),
@@ -2097,7 +2201,7 @@ func (n *Network) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     n.ID,
 		Type:   "Network",
-		Fields: make([]*Field, 6),
+		Fields: make([]*Field, 8),
 		Edges:  make([]*Edge, 3),
 	}
 	var buf []byte
@@ -2125,10 +2229,18 @@ func (n *Network) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "cidr",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(n.VdiVisible); err != nil {
+	if buf, err = json.Marshal(n.Ipv6Cidr); err != nil {
 		return nil, err
 	}
 	node.Fields[3] = &Field{
+		Type:  "string",
+		Name:  "ipv6_cidr",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(n.VdiVisible); err != nil {
+		return nil, err
+	}
+	node.Fields[4] = &Field{
 		Type:  "bool",
 		Name:  "vdi_visible",
 		Value: string(buf),
@@ -2136,7 +2248,7 @@ func (n *Network) Node(ctx context.Context) (node *Node, err error) {
 	if buf, err = json.Marshal(n.Vars); err != nil {
 		return nil, err
 	}
-	node.Fields[4] = &Field{
+	node.Fields[5] = &Field{
 		Type:  "map[string]string",
 		Name:  "vars",
 		Value: string(buf),
@@ -2144,11 +2256,19 @@ func (n *Network) Node(ctx context.Context) (node *Node, err error) {
 	if buf, err = json.Marshal(n.Tags); err != nil {
 		return nil, err
 	}
-	node.Fields[5] = &Field{
+	node.Fields[6] = &Field{
 		Type:  "map[string]string",
 		Name:  "tags",
 		Value: string(buf),
 	}
+	if buf, err = json.Marshal(n.Reserved); err != nil {
+		return nil, err
+	}
+	node.Fields[7] = &Field{
+		Type:  "[]string",
+		Name:  "reserved",
+		Value: string(buf),
+	}
 	node.Edges[0] = &Edge{
 		Type: "Environment",
 		Name: "NetworkToEnvironment",
@@ -2186,7 +2306,7 @@ func (pl *Plan) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     pl.ID,
 		Type:   "Plan",
-		Fields: make([]*Field, 3),
+		Fields: make([]*Field, 4),
 		Edges:  make([]*Edge, 9),
 	}
 	var buf []byte
@@ -2214,6 +2334,14 @@ func (pl *Plan) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "build_id",
 		Value: string(buf),
 	}
+	if buf, err = json.Marshal(pl.Checksum); err != nil {
+		return nil, err
+	}
+	node.Fields[3] = &Field{
+		Type:  "string",
+		Name:  "checksum",
+		Value: string(buf),
+	}
 	node.Edges[0] = &Edge{
 		Type: "Plan",
 		Name: "PrevPlan",
@@ -2358,7 +2486,7 @@ func (ph *ProvisionedHost) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     ph.ID,
 		Type:   "ProvisionedHost",
-		Fields: make([]*Field, 2),
+		Fields: make([]*Field, 3),
 		Edges:  make([]*Edge, 10),
 	}
 	var buf []byte
@@ -2370,10 +2498,18 @@ func (ph *ProvisionedHost) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "subnet_ip",
 		Value: string(buf),
 	}
-	if buf, err = json.Marshal(ph.AddonType); err != nil {
+	if buf, err = json.Marshal(ph.SubnetIpv6); err != nil {
 		return nil, err
 	}
 	node.Fields[1] = &Field{
+		Type:  "string",
+		Name:  "subnet_ipv6",
+		Value: string(buf),
+	}
+	if buf, err = json.Marshal(ph.AddonType); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
 		Type:  "provisionedhost.AddonType",
 		Name:  "addon_type",
 		Value: string(buf),
@@ -2485,7 +2621,7 @@ func (pn *ProvisionedNetwork) Node(ctx context.Context) (node *Node, err error)
 	node = &Node{
 		ID:     pn.ID,
 		Type:   "ProvisionedNetwork",
-		Fields: make([]*Field, 2),
+		Fields: make([]*Field, 3),
 		Edges:  make([]*Edge, 6),
 	}
 	var buf []byte
@@ -2505,6 +2641,14 @@ func (pn *ProvisionedNetwork) Node(ctx context.Context) (node *Node, err error)
 		Name:  "cidr",
 		Value: string(buf),
 	}
+	if buf, err = json.Marshal(pn.Ipv6Cidr); err != nil {
+		return nil, err
+	}
+	node.Fields[2] = &Field{
+		Type:  "string",
+		Name:  "ipv6_cidr",
+		Value: string(buf),
+	}
 	node.Edges[0] = &Edge{
 		Type: "Status",
 		Name: "ProvisionedNetworkToStatus",
@@ -2770,7 +2914,7 @@ func (s *Script) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     s.ID,
 		Type:   "Script",
-		Fields: make([]*Field, 14),
+		Fields: make([]*Field, 15),
 		Edges:  make([]*Edge, 3),
 	}
 	var buf []byte
@@ -2886,6 +3030,14 @@ func (s *Script) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "tags",
 		Value: string(buf),
 	}
+	if buf, err = json.Marshal(s.Retry); err != nil {
+		return nil, err
+	}
+	node.Fields[14] = &Field{
+		Type:  "*retry.Policy",
+		Name:  "retry",
+		Value: string(buf),
+	}
 	node.Edges[0] = &Edge{
 		Type: "User",
 		Name: "ScriptToUser",
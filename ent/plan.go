@@ -28,6 +28,8 @@ type Plan struct {
 	Type plan.Type `json:"type,omitempty"`
 	// BuildID holds the value of the "build_id" field.
 	BuildID string `json:"build_id,omitempty"`
+	// Checksum holds the value of the "checksum" field.
+	Checksum string `json:"checksum,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PlanQuery when eager-loading is set.
 	Edges PlanEdges `json:"edges"`
@@ -198,7 +200,7 @@ func (*Plan) scanValues(columns []string) ([]interface{}, error) {
 		switch columns[i] {
 		case plan.FieldStepNumber:
 			values[i] = new(sql.NullInt64)
-		case plan.FieldType, plan.FieldBuildID:
+		case plan.FieldType, plan.FieldBuildID, plan.FieldChecksum:
 			values[i] = new(sql.NullString)
 		case plan.FieldID:
 			values[i] = new(uuid.UUID)
@@ -243,6 +245,12 @@ func (pl *Plan) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				pl.BuildID = value.String
 			}
+		case plan.FieldChecksum:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field checksum", values[i])
+			} else if value.Valid {
+				pl.Checksum = value.String
+			}
 		case plan.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field plan_plan_to_build", values[i])
@@ -329,6 +337,8 @@ func (pl *Plan) String() string {
 	builder.WriteString(fmt.Sprintf("%v", pl.Type))
 	builder.WriteString(", build_id=")
 	builder.WriteString(pl.BuildID)
+	builder.WriteString(", checksum=")
+	builder.WriteString(pl.Checksum)
 	builder.WriteByte(')')
 	return builder.String()
 }
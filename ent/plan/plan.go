@@ -21,6 +21,8 @@ const (
 	FieldType = "type"
 	// FieldBuildID holds the string denoting the build_id field in the database.
 	FieldBuildID = "build_id"
+	// FieldChecksum holds the string denoting the checksum field in the database.
+	FieldChecksum = "checksum"
 	// EdgePrevPlan holds the string denoting the prevplan edge name in mutations.
 	EdgePrevPlan = "PrevPlan"
 	// EdgeNextPlan holds the string denoting the nextplan edge name in mutations.
@@ -102,6 +104,7 @@ var Columns = []string{
 	FieldStepNumber,
 	FieldType,
 	FieldBuildID,
+	FieldChecksum,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "plans"
@@ -135,6 +138,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultChecksum holds the default value on creation for the "checksum" field.
+	DefaultChecksum string
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
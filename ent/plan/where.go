@@ -106,6 +106,13 @@ func BuildID(v string) predicate.Plan {
 	})
 }
 
+// Checksum applies equality check predicate on the "checksum" field. It's identical to ChecksumEQ.
+func Checksum(v string) predicate.Plan {
+	return predicate.Plan(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldChecksum), v))
+	})
+}
+
 // StepNumberEQ applies the EQ predicate on the "step_number" field.
 func StepNumberEQ(v int) predicate.Plan {
 	return predicate.Plan(func(s *sql.Selector) {
@@ -341,6 +348,117 @@ func BuildIDContainsFold(v string) predicate.Plan {
 	})
 }
 
+// ChecksumEQ applies the EQ predicate on the "checksum" field.
+func ChecksumEQ(v string) predicate.Plan {
+	return predicate.Plan(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldChecksum), v))
+	})
+}
+
+// ChecksumNEQ applies the NEQ predicate on the "checksum" field.
+func ChecksumNEQ(v string) predicate.Plan {
+	return predicate.Plan(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldChecksum), v))
+	})
+}
+
+// ChecksumIn applies the In predicate on the "checksum" field.
+func ChecksumIn(vs ...string) predicate.Plan {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Plan(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldChecksum), v...))
+	})
+}
+
+// ChecksumNotIn applies the NotIn predicate on the "checksum" field.
+func ChecksumNotIn(vs ...string) predicate.Plan {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Plan(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldChecksum), v...))
+	})
+}
+
+// ChecksumGT applies the GT predicate on the "checksum" field.
+func ChecksumGT(v string) predicate.Plan {
+	return predicate.Plan(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldChecksum), v))
+	})
+}
+
+// ChecksumGTE applies the GTE predicate on the "checksum" field.
+func ChecksumGTE(v string) predicate.Plan {
+	return predicate.Plan(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldChecksum), v))
+	})
+}
+
+// ChecksumLT applies the LT predicate on the "checksum" field.
+func ChecksumLT(v string) predicate.Plan {
+	return predicate.Plan(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldChecksum), v))
+	})
+}
+
+// ChecksumLTE applies the LTE predicate on the "checksum" field.
+func ChecksumLTE(v string) predicate.Plan {
+	return predicate.Plan(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldChecksum), v))
+	})
+}
+
+// ChecksumContains applies the Contains predicate on the "checksum" field.
+func ChecksumContains(v string) predicate.Plan {
+	return predicate.Plan(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldChecksum), v))
+	})
+}
+
+// ChecksumHasPrefix applies the HasPrefix predicate on the "checksum" field.
+func ChecksumHasPrefix(v string) predicate.Plan {
+	return predicate.Plan(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldChecksum), v))
+	})
+}
+
+// ChecksumHasSuffix applies the HasSuffix predicate on the "checksum" field.
+func ChecksumHasSuffix(v string) predicate.Plan {
+	return predicate.Plan(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldChecksum), v))
+	})
+}
+
+// ChecksumEqualFold applies the EqualFold predicate on the "checksum" field.
+func ChecksumEqualFold(v string) predicate.Plan {
+	return predicate.Plan(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldChecksum), v))
+	})
+}
+
+// ChecksumContainsFold applies the ContainsFold predicate on the "checksum" field.
+func ChecksumContainsFold(v string) predicate.Plan {
+	return predicate.Plan(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldChecksum), v))
+	})
+}
+
 // HasPrevPlan applies the HasEdge predicate on the "PrevPlan" edge.
 func HasPrevPlan() predicate.Plan {
 	return predicate.Plan(func(s *sql.Selector) {
@@ -45,6 +45,20 @@ func (pc *PlanCreate) SetBuildID(s string) *PlanCreate {
 	return pc
 }
 
+// SetChecksum sets the "checksum" field.
+func (pc *PlanCreate) SetChecksum(s string) *PlanCreate {
+	pc.mutation.SetChecksum(s)
+	return pc
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (pc *PlanCreate) SetNillableChecksum(s *string) *PlanCreate {
+	if s != nil {
+		pc.SetChecksum(*s)
+	}
+	return pc
+}
+
 // SetID sets the "id" field.
 func (pc *PlanCreate) SetID(u uuid.UUID) *PlanCreate {
 	pc.mutation.SetID(u)
@@ -273,6 +287,10 @@ func (pc *PlanCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (pc *PlanCreate) defaults() {
+	if _, ok := pc.mutation.Checksum(); !ok {
+		v := plan.DefaultChecksum
+		pc.mutation.SetChecksum(v)
+	}
 	if _, ok := pc.mutation.ID(); !ok {
 		v := plan.DefaultID()
 		pc.mutation.SetID(v)
@@ -295,6 +313,9 @@ func (pc *PlanCreate) check() error {
 	if _, ok := pc.mutation.BuildID(); !ok {
 		return &ValidationError{Name: "build_id", err: errors.New(`ent: missing required field "build_id"`)}
 	}
+	if _, ok := pc.mutation.Checksum(); !ok {
+		return &ValidationError{Name: "checksum", err: errors.New(`ent: missing required field "checksum"`)}
+	}
 	if _, ok := pc.mutation.PlanToStatusID(); !ok {
 		return &ValidationError{Name: "PlanToStatus", err: errors.New("ent: missing required edge \"PlanToStatus\"")}
 	}
@@ -354,6 +375,14 @@ func (pc *PlanCreate) createSpec() (*Plan, *sqlgraph.CreateSpec) {
 		})
 		_node.BuildID = value
 	}
+	if value, ok := pc.mutation.Checksum(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: plan.FieldChecksum,
+		})
+		_node.Checksum = value
+	}
 	if nodes := pc.mutation.PrevPlanIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
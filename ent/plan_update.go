@@ -60,6 +60,20 @@ func (pu *PlanUpdate) SetBuildID(s string) *PlanUpdate {
 	return pu
 }
 
+// SetChecksum sets the "checksum" field.
+func (pu *PlanUpdate) SetChecksum(s string) *PlanUpdate {
+	pu.mutation.SetChecksum(s)
+	return pu
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (pu *PlanUpdate) SetNillableChecksum(s *string) *PlanUpdate {
+	if s != nil {
+		pu.SetChecksum(*s)
+	}
+	return pu
+}
+
 // AddPrevPlanIDs adds the "PrevPlan" edge to the Plan entity by IDs.
 func (pu *PlanUpdate) AddPrevPlanIDs(ids ...uuid.UUID) *PlanUpdate {
 	pu.mutation.AddPrevPlanIDs(ids...)
@@ -434,6 +448,13 @@ func (pu *PlanUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: plan.FieldBuildID,
 		})
 	}
+	if value, ok := pu.mutation.Checksum(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: plan.FieldChecksum,
+		})
+	}
 	if pu.mutation.PrevPlanCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -850,6 +871,20 @@ func (puo *PlanUpdateOne) SetBuildID(s string) *PlanUpdateOne {
 	return puo
 }
 
+// SetChecksum sets the "checksum" field.
+func (puo *PlanUpdateOne) SetChecksum(s string) *PlanUpdateOne {
+	puo.mutation.SetChecksum(s)
+	return puo
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (puo *PlanUpdateOne) SetNillableChecksum(s *string) *PlanUpdateOne {
+	if s != nil {
+		puo.SetChecksum(*s)
+	}
+	return puo
+}
+
 // AddPrevPlanIDs adds the "PrevPlan" edge to the Plan entity by IDs.
 func (puo *PlanUpdateOne) AddPrevPlanIDs(ids ...uuid.UUID) *PlanUpdateOne {
 	puo.mutation.AddPrevPlanIDs(ids...)
@@ -1248,6 +1283,13 @@ func (puo *PlanUpdateOne) sqlSave(ctx context.Context) (_node *Plan, err error)
 			Column: plan.FieldBuildID,
 		})
 	}
+	if value, ok := puo.mutation.Checksum(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: plan.FieldChecksum,
+		})
+	}
 	if puo.mutation.PrevPlanCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
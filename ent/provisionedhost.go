@@ -24,6 +24,8 @@ type ProvisionedHost struct {
 	ID uuid.UUID `json:"id,omitempty"`
 	// SubnetIP holds the value of the "subnet_ip" field.
 	SubnetIP string `json:"subnet_ip,omitempty"`
+	// SubnetIpv6 holds the value of the "subnet_ipv6" field.
+	SubnetIpv6 string `json:"subnet_ipv6,omitempty"`
 	// AddonType holds the value of the "addon_type" field.
 	AddonType *provisionedhost.AddonType `json:"addon_type,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -217,7 +219,7 @@ func (*ProvisionedHost) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case provisionedhost.FieldSubnetIP, provisionedhost.FieldAddonType:
+		case provisionedhost.FieldSubnetIP, provisionedhost.FieldSubnetIpv6, provisionedhost.FieldAddonType:
 			values[i] = new(sql.NullString)
 		case provisionedhost.FieldID:
 			values[i] = new(uuid.UUID)
@@ -260,6 +262,12 @@ func (ph *ProvisionedHost) assignValues(columns []string, values []interface{})
 			} else if value.Valid {
 				ph.SubnetIP = value.String
 			}
+		case provisionedhost.FieldSubnetIpv6:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subnet_ipv6", values[i])
+			} else if value.Valid {
+				ph.SubnetIpv6 = value.String
+			}
 		case provisionedhost.FieldAddonType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field addon_type", values[i])
@@ -389,6 +397,8 @@ func (ph *ProvisionedHost) String() string {
 	builder.WriteString(fmt.Sprintf("id=%v", ph.ID))
 	builder.WriteString(", subnet_ip=")
 	builder.WriteString(ph.SubnetIP)
+	builder.WriteString(", subnet_ipv6=")
+	builder.WriteString(ph.SubnetIpv6)
 	if v := ph.AddonType; v != nil {
 		builder.WriteString(", addon_type=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
@@ -17,6 +17,8 @@ const (
 	FieldID = "id"
 	// FieldSubnetIP holds the string denoting the subnet_ip field in the database.
 	FieldSubnetIP = "subnet_ip"
+	// FieldSubnetIpv6 holds the string denoting the subnet_ipv6 field in the database.
+	FieldSubnetIpv6 = "subnet_ipv6"
 	// FieldAddonType holds the string denoting the addon_type field in the database.
 	FieldAddonType = "addon_type"
 	// EdgeProvisionedHostToStatus holds the string denoting the provisionedhosttostatus edge name in mutations.
@@ -117,6 +119,7 @@ const (
 var Columns = []string{
 	FieldID,
 	FieldSubnetIP,
+	FieldSubnetIpv6,
 	FieldAddonType,
 }
 
@@ -147,6 +150,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultSubnetIpv6 holds the default value on creation for the "subnet_ipv6" field.
+	DefaultSubnetIpv6 string
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
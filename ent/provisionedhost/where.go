@@ -99,6 +99,13 @@ func SubnetIP(v string) predicate.ProvisionedHost {
 	})
 }
 
+// SubnetIpv6 applies equality check predicate on the "subnet_ipv6" field. It's identical to SubnetIpv6EQ.
+func SubnetIpv6(v string) predicate.ProvisionedHost {
+	return predicate.ProvisionedHost(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSubnetIpv6), v))
+	})
+}
+
 // SubnetIPEQ applies the EQ predicate on the "subnet_ip" field.
 func SubnetIPEQ(v string) predicate.ProvisionedHost {
 	return predicate.ProvisionedHost(func(s *sql.Selector) {
@@ -210,6 +217,117 @@ func SubnetIPContainsFold(v string) predicate.ProvisionedHost {
 	})
 }
 
+// SubnetIpv6EQ applies the EQ predicate on the "subnet_ipv6" field.
+func SubnetIpv6EQ(v string) predicate.ProvisionedHost {
+	return predicate.ProvisionedHost(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSubnetIpv6), v))
+	})
+}
+
+// SubnetIpv6NEQ applies the NEQ predicate on the "subnet_ipv6" field.
+func SubnetIpv6NEQ(v string) predicate.ProvisionedHost {
+	return predicate.ProvisionedHost(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSubnetIpv6), v))
+	})
+}
+
+// SubnetIpv6In applies the In predicate on the "subnet_ipv6" field.
+func SubnetIpv6In(vs ...string) predicate.ProvisionedHost {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProvisionedHost(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSubnetIpv6), v...))
+	})
+}
+
+// SubnetIpv6NotIn applies the NotIn predicate on the "subnet_ipv6" field.
+func SubnetIpv6NotIn(vs ...string) predicate.ProvisionedHost {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ProvisionedHost(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSubnetIpv6), v...))
+	})
+}
+
+// SubnetIpv6GT applies the GT predicate on the "subnet_ipv6" field.
+func SubnetIpv6GT(v string) predicate.ProvisionedHost {
+	return predicate.ProvisionedHost(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSubnetIpv6), v))
+	})
+}
+
+// SubnetIpv6GTE applies the GTE predicate on the "subnet_ipv6" field.
+func SubnetIpv6GTE(v string) predicate.ProvisionedHost {
+	return predicate.ProvisionedHost(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSubnetIpv6), v))
+	})
+}
+
+// SubnetIpv6LT applies the LT predicate on the "subnet_ipv6" field.
+func SubnetIpv6LT(v string) predicate.ProvisionedHost {
+	return predicate.ProvisionedHost(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSubnetIpv6), v))
+	})
+}
+
+// SubnetIpv6LTE applies the LTE predicate on the "subnet_ipv6" field.
+func SubnetIpv6LTE(v string) predicate.ProvisionedHost {
+	return predicate.ProvisionedHost(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSubnetIpv6), v))
+	})
+}
+
+// SubnetIpv6Contains applies the Contains predicate on the "subnet_ipv6" field.
+func SubnetIpv6Contains(v string) predicate.ProvisionedHost {
+	return predicate.ProvisionedHost(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldSubnetIpv6), v))
+	})
+}
+
+// SubnetIpv6HasPrefix applies the HasPrefix predicate on the "subnet_ipv6" field.
+func SubnetIpv6HasPrefix(v string) predicate.ProvisionedHost {
+	return predicate.ProvisionedHost(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldSubnetIpv6), v))
+	})
+}
+
+// SubnetIpv6HasSuffix applies the HasSuffix predicate on the "subnet_ipv6" field.
+func SubnetIpv6HasSuffix(v string) predicate.ProvisionedHost {
+	return predicate.ProvisionedHost(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldSubnetIpv6), v))
+	})
+}
+
+// SubnetIpv6EqualFold applies the EqualFold predicate on the "subnet_ipv6" field.
+func SubnetIpv6EqualFold(v string) predicate.ProvisionedHost {
+	return predicate.ProvisionedHost(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldSubnetIpv6), v))
+	})
+}
+
+// SubnetIpv6ContainsFold applies the ContainsFold predicate on the "subnet_ipv6" field.
+func SubnetIpv6ContainsFold(v string) predicate.ProvisionedHost {
+	return predicate.ProvisionedHost(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldSubnetIpv6), v))
+	})
+}
+
 // AddonTypeEQ applies the EQ predicate on the "addon_type" field.
 func AddonTypeEQ(v AddonType) predicate.ProvisionedHost {
 	return predicate.ProvisionedHost(func(s *sql.Selector) {
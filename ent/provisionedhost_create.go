@@ -35,6 +35,20 @@ func (phc *ProvisionedHostCreate) SetSubnetIP(s string) *ProvisionedHostCreate {
 	return phc
 }
 
+// SetSubnetIpv6 sets the "subnet_ipv6" field.
+func (phc *ProvisionedHostCreate) SetSubnetIpv6(s string) *ProvisionedHostCreate {
+	phc.mutation.SetSubnetIpv6(s)
+	return phc
+}
+
+// SetNillableSubnetIpv6 sets the "subnet_ipv6" field if the given value is not nil.
+func (phc *ProvisionedHostCreate) SetNillableSubnetIpv6(s *string) *ProvisionedHostCreate {
+	if s != nil {
+		phc.SetSubnetIpv6(*s)
+	}
+	return phc
+}
+
 // SetAddonType sets the "addon_type" field.
 func (phc *ProvisionedHostCreate) SetAddonType(pt provisionedhost.AddonType) *ProvisionedHostCreate {
 	phc.mutation.SetAddonType(pt)
@@ -272,6 +286,10 @@ func (phc *ProvisionedHostCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (phc *ProvisionedHostCreate) defaults() {
+	if _, ok := phc.mutation.SubnetIpv6(); !ok {
+		v := provisionedhost.DefaultSubnetIpv6
+		phc.mutation.SetSubnetIpv6(v)
+	}
 	if _, ok := phc.mutation.ID(); !ok {
 		v := provisionedhost.DefaultID()
 		phc.mutation.SetID(v)
@@ -283,6 +301,9 @@ func (phc *ProvisionedHostCreate) check() error {
 	if _, ok := phc.mutation.SubnetIP(); !ok {
 		return &ValidationError{Name: "subnet_ip", err: errors.New(`ent: missing required field "subnet_ip"`)}
 	}
+	if _, ok := phc.mutation.SubnetIpv6(); !ok {
+		return &ValidationError{Name: "subnet_ipv6", err: errors.New(`ent: missing required field "subnet_ipv6"`)}
+	}
 	if v, ok := phc.mutation.AddonType(); ok {
 		if err := provisionedhost.AddonTypeValidator(v); err != nil {
 			return &ValidationError{Name: "addon_type", err: fmt.Errorf(`ent: validator failed for field "addon_type": %w`, err)}
@@ -340,6 +361,14 @@ func (phc *ProvisionedHostCreate) createSpec() (*ProvisionedHost, *sqlgraph.Crea
 		})
 		_node.SubnetIP = value
 	}
+	if value, ok := phc.mutation.SubnetIpv6(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: provisionedhost.FieldSubnetIpv6,
+		})
+		_node.SubnetIpv6 = value
+	}
 	if value, ok := phc.mutation.AddonType(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeEnum,
@@ -43,6 +43,12 @@ func (phu *ProvisionedHostUpdate) SetSubnetIP(s string) *ProvisionedHostUpdate {
 	return phu
 }
 
+// SetSubnetIpv6 sets the "subnet_ipv6" field.
+func (phu *ProvisionedHostUpdate) SetSubnetIpv6(s string) *ProvisionedHostUpdate {
+	phu.mutation.SetSubnetIpv6(s)
+	return phu
+}
+
 // SetAddonType sets the "addon_type" field.
 func (phu *ProvisionedHostUpdate) SetAddonType(pt provisionedhost.AddonType) *ProvisionedHostUpdate {
 	phu.mutation.SetAddonType(pt)
@@ -426,6 +432,13 @@ func (phu *ProvisionedHostUpdate) sqlSave(ctx context.Context) (n int, err error
 			Column: provisionedhost.FieldSubnetIP,
 		})
 	}
+	if value, ok := phu.mutation.SubnetIpv6(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: provisionedhost.FieldSubnetIpv6,
+		})
+	}
 	if value, ok := phu.mutation.AddonType(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeEnum,
@@ -871,6 +884,12 @@ func (phuo *ProvisionedHostUpdateOne) SetSubnetIP(s string) *ProvisionedHostUpda
 	return phuo
 }
 
+// SetSubnetIpv6 sets the "subnet_ipv6" field.
+func (phuo *ProvisionedHostUpdateOne) SetSubnetIpv6(s string) *ProvisionedHostUpdateOne {
+	phuo.mutation.SetSubnetIpv6(s)
+	return phuo
+}
+
 // SetAddonType sets the "addon_type" field.
 func (phuo *ProvisionedHostUpdateOne) SetAddonType(pt provisionedhost.AddonType) *ProvisionedHostUpdateOne {
 	phuo.mutation.SetAddonType(pt)
@@ -1278,6 +1297,13 @@ func (phuo *ProvisionedHostUpdateOne) sqlSave(ctx context.Context) (_node *Provi
 			Column: provisionedhost.FieldSubnetIP,
 		})
 	}
+	if value, ok := phuo.mutation.SubnetIpv6(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: provisionedhost.FieldSubnetIpv6,
+		})
+	}
 	if value, ok := phuo.mutation.AddonType(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeEnum,
@@ -25,6 +25,8 @@ type ProvisionedNetwork struct {
 	Name string `json:"name,omitempty"`
 	// Cidr holds the value of the "cidr" field.
 	Cidr string `json:"cidr,omitempty"`
+	// Ipv6Cidr holds the value of the "ipv6_cidr" field.
+	Ipv6Cidr string `json:"ipv6_cidr,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ProvisionedNetworkQuery when eager-loading is set.
 	Edges ProvisionedNetworkEdges `json:"edges"`
@@ -152,7 +154,7 @@ func (*ProvisionedNetwork) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case provisionednetwork.FieldName, provisionednetwork.FieldCidr:
+		case provisionednetwork.FieldName, provisionednetwork.FieldCidr, provisionednetwork.FieldIpv6Cidr:
 			values[i] = new(sql.NullString)
 		case provisionednetwork.FieldID:
 			values[i] = new(uuid.UUID)
@@ -197,6 +199,12 @@ func (pn *ProvisionedNetwork) assignValues(columns []string, values []interface{
 			} else if value.Valid {
 				pn.Cidr = value.String
 			}
+		case provisionednetwork.FieldIpv6Cidr:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ipv6_cidr", values[i])
+			} else if value.Valid {
+				pn.Ipv6Cidr = value.String
+			}
 		case provisionednetwork.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field plan_plan_to_provisioned_network", values[i])
@@ -287,6 +295,8 @@ func (pn *ProvisionedNetwork) String() string {
 	builder.WriteString(pn.Name)
 	builder.WriteString(", cidr=")
 	builder.WriteString(pn.Cidr)
+	builder.WriteString(", ipv6_cidr=")
+	builder.WriteString(pn.Ipv6Cidr)
 	builder.WriteByte(')')
 	return builder.String()
 }
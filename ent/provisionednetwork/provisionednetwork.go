@@ -15,6 +15,8 @@ const (
 	FieldName = "name"
 	// FieldCidr holds the string denoting the cidr field in the database.
 	FieldCidr = "cidr"
+	// FieldIpv6Cidr holds the string denoting the ipv6_cidr field in the database.
+	FieldIpv6Cidr = "ipv6_cidr"
 	// EdgeProvisionedNetworkToStatus holds the string denoting the provisionednetworktostatus edge name in mutations.
 	EdgeProvisionedNetworkToStatus = "ProvisionedNetworkToStatus"
 	// EdgeProvisionedNetworkToNetwork holds the string denoting the provisionednetworktonetwork edge name in mutations.
@@ -78,6 +80,7 @@ var Columns = []string{
 	FieldID,
 	FieldName,
 	FieldCidr,
+	FieldIpv6Cidr,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "provisioned_networks"
@@ -105,6 +108,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultIpv6Cidr holds the default value on creation for the "ipv6_cidr" field.
+	DefaultIpv6Cidr string
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -38,6 +38,20 @@ func (pnc *ProvisionedNetworkCreate) SetCidr(s string) *ProvisionedNetworkCreate
 	return pnc
 }
 
+// SetIpv6Cidr sets the "ipv6_cidr" field.
+func (pnc *ProvisionedNetworkCreate) SetIpv6Cidr(s string) *ProvisionedNetworkCreate {
+	pnc.mutation.SetIpv6Cidr(s)
+	return pnc
+}
+
+// SetNillableIpv6Cidr sets the "ipv6_cidr" field if the given value is not nil.
+func (pnc *ProvisionedNetworkCreate) SetNillableIpv6Cidr(s *string) *ProvisionedNetworkCreate {
+	if s != nil {
+		pnc.SetIpv6Cidr(*s)
+	}
+	return pnc
+}
+
 // SetID sets the "id" field.
 func (pnc *ProvisionedNetworkCreate) SetID(u uuid.UUID) *ProvisionedNetworkCreate {
 	pnc.mutation.SetID(u)
@@ -225,6 +239,10 @@ func (pnc *ProvisionedNetworkCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (pnc *ProvisionedNetworkCreate) defaults() {
+	if _, ok := pnc.mutation.Ipv6Cidr(); !ok {
+		v := provisionednetwork.DefaultIpv6Cidr
+		pnc.mutation.SetIpv6Cidr(v)
+	}
 	if _, ok := pnc.mutation.ID(); !ok {
 		v := provisionednetwork.DefaultID()
 		pnc.mutation.SetID(v)
@@ -239,6 +257,9 @@ func (pnc *ProvisionedNetworkCreate) check() error {
 	if _, ok := pnc.mutation.Cidr(); !ok {
 		return &ValidationError{Name: "cidr", err: errors.New(`ent: missing required field "cidr"`)}
 	}
+	if _, ok := pnc.mutation.Ipv6Cidr(); !ok {
+		return &ValidationError{Name: "ipv6_cidr", err: errors.New(`ent: missing required field "ipv6_cidr"`)}
+	}
 	return nil
 }
 
@@ -287,6 +308,14 @@ func (pnc *ProvisionedNetworkCreate) createSpec() (*ProvisionedNetwork, *sqlgrap
 		})
 		_node.Cidr = value
 	}
+	if value, ok := pnc.mutation.Ipv6Cidr(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: provisionednetwork.FieldIpv6Cidr,
+		})
+		_node.Ipv6Cidr = value
+	}
 	if nodes := pnc.mutation.ProvisionedNetworkToStatusIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
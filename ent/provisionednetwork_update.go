@@ -45,6 +45,12 @@ func (pnu *ProvisionedNetworkUpdate) SetCidr(s string) *ProvisionedNetworkUpdate
 	return pnu
 }
 
+// SetIpv6Cidr sets the "ipv6_cidr" field.
+func (pnu *ProvisionedNetworkUpdate) SetIpv6Cidr(s string) *ProvisionedNetworkUpdate {
+	pnu.mutation.SetIpv6Cidr(s)
+	return pnu
+}
+
 // SetProvisionedNetworkToStatusID sets the "ProvisionedNetworkToStatus" edge to the Status entity by ID.
 func (pnu *ProvisionedNetworkUpdate) SetProvisionedNetworkToStatusID(id uuid.UUID) *ProvisionedNetworkUpdate {
 	pnu.mutation.SetProvisionedNetworkToStatusID(id)
@@ -297,6 +303,13 @@ func (pnu *ProvisionedNetworkUpdate) sqlSave(ctx context.Context) (n int, err er
 			Column: provisionednetwork.FieldCidr,
 		})
 	}
+	if value, ok := pnu.mutation.Ipv6Cidr(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: provisionednetwork.FieldIpv6Cidr,
+		})
+	}
 	if pnu.mutation.ProvisionedNetworkToStatusCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -557,6 +570,12 @@ func (pnuo *ProvisionedNetworkUpdateOne) SetCidr(s string) *ProvisionedNetworkUp
 	return pnuo
 }
 
+// SetIpv6Cidr sets the "ipv6_cidr" field.
+func (pnuo *ProvisionedNetworkUpdateOne) SetIpv6Cidr(s string) *ProvisionedNetworkUpdateOne {
+	pnuo.mutation.SetIpv6Cidr(s)
+	return pnuo
+}
+
 // SetProvisionedNetworkToStatusID sets the "ProvisionedNetworkToStatus" edge to the Status entity by ID.
 func (pnuo *ProvisionedNetworkUpdateOne) SetProvisionedNetworkToStatusID(id uuid.UUID) *ProvisionedNetworkUpdateOne {
 	pnuo.mutation.SetProvisionedNetworkToStatusID(id)
@@ -833,6 +852,13 @@ func (pnuo *ProvisionedNetworkUpdateOne) sqlSave(ctx context.Context) (_node *Pr
 			Column: provisionednetwork.FieldCidr,
 		})
 	}
+	if value, ok := pnuo.mutation.Ipv6Cidr(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: provisionednetwork.FieldIpv6Cidr,
+		})
+	}
 	if pnuo.mutation.ProvisionedNetworkToStatusCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -60,12 +60,20 @@ func init() {
 	agentstatus.DefaultID = agentstatusDescID.Default.(func() uuid.UUID)
 	agenttaskFields := schema.AgentTask{}.Fields()
 	_ = agenttaskFields
+	// agenttaskDescTimeout is the schema descriptor for timeout field.
+	agenttaskDescTimeout := agenttaskFields[4].Descriptor()
+	// agenttask.DefaultTimeout holds the default value on creation for the timeout field.
+	agenttask.DefaultTimeout = agenttaskDescTimeout.Default.(int)
+	// agenttaskDescAttempt is the schema descriptor for attempt field.
+	agenttaskDescAttempt := agenttaskFields[5].Descriptor()
+	// agenttask.DefaultAttempt holds the default value on creation for the attempt field.
+	agenttask.DefaultAttempt = agenttaskDescAttempt.Default.(int)
 	// agenttaskDescOutput is the schema descriptor for output field.
-	agenttaskDescOutput := agenttaskFields[4].Descriptor()
+	agenttaskDescOutput := agenttaskFields[6].Descriptor()
 	// agenttask.DefaultOutput holds the default value on creation for the output field.
 	agenttask.DefaultOutput = agenttaskDescOutput.Default.(string)
 	// agenttaskDescErrorMessage is the schema descriptor for error_message field.
-	agenttaskDescErrorMessage := agenttaskFields[6].Descriptor()
+	agenttaskDescErrorMessage := agenttaskFields[8].Descriptor()
 	// agenttask.DefaultErrorMessage holds the default value on creation for the error_message field.
 	agenttask.DefaultErrorMessage = agenttaskDescErrorMessage.Default.(string)
 	// agenttaskDescID is the schema descriptor for id field.
@@ -118,6 +126,10 @@ func init() {
 	build.DefaultID = buildDescID.Default.(func() uuid.UUID)
 	buildcommitFields := schema.BuildCommit{}.Fields()
 	_ = buildcommitFields
+	// buildcommitDescCreatedBy is the schema descriptor for created_by field.
+	buildcommitDescCreatedBy := buildcommitFields[4].Descriptor()
+	// buildcommit.DefaultCreatedBy holds the default value on creation for the created_by field.
+	buildcommit.DefaultCreatedBy = buildcommitDescCreatedBy.Default.(string)
 	// buildcommitDescID is the schema descriptor for id field.
 	buildcommitDescID := buildcommitFields[0].Descriptor()
 	// buildcommit.DefaultID holds the default value on creation for the id field.
@@ -178,6 +190,10 @@ func init() {
 	filedelete.DefaultID = filedeleteDescID.Default.(func() uuid.UUID)
 	filedownloadFields := schema.FileDownload{}.Fields()
 	_ = filedownloadFields
+	// filedownloadDescSha256 is the schema descriptor for sha256 field.
+	filedownloadDescSha256 := filedownloadFields[12].Descriptor()
+	// filedownload.DefaultSha256 holds the default value on creation for the sha256 field.
+	filedownload.DefaultSha256 = filedownloadDescSha256.Default.(string)
 	// filedownloadDescID is the schema descriptor for id field.
 	filedownloadDescID := filedownloadFields[0].Descriptor()
 	// filedownload.DefaultID holds the default value on creation for the id field.
@@ -230,12 +246,20 @@ func init() {
 	includednetwork.DefaultID = includednetworkDescID.Default.(func() uuid.UUID)
 	networkFields := schema.Network{}.Fields()
 	_ = networkFields
+	// networkDescIpv6Cidr is the schema descriptor for ipv6_cidr field.
+	networkDescIpv6Cidr := networkFields[4].Descriptor()
+	// network.DefaultIpv6Cidr holds the default value on creation for the ipv6_cidr field.
+	network.DefaultIpv6Cidr = networkDescIpv6Cidr.Default.(string)
 	// networkDescID is the schema descriptor for id field.
 	networkDescID := networkFields[0].Descriptor()
 	// network.DefaultID holds the default value on creation for the id field.
 	network.DefaultID = networkDescID.Default.(func() uuid.UUID)
 	planFields := schema.Plan{}.Fields()
 	_ = planFields
+	// planDescChecksum is the schema descriptor for checksum field.
+	planDescChecksum := planFields[4].Descriptor()
+	// plan.DefaultChecksum holds the default value on creation for the checksum field.
+	plan.DefaultChecksum = planDescChecksum.Default.(string)
 	// planDescID is the schema descriptor for id field.
 	planDescID := planFields[0].Descriptor()
 	// plan.DefaultID holds the default value on creation for the id field.
@@ -248,12 +272,20 @@ func init() {
 	plandiff.DefaultID = plandiffDescID.Default.(func() uuid.UUID)
 	provisionedhostFields := schema.ProvisionedHost{}.Fields()
 	_ = provisionedhostFields
+	// provisionedhostDescSubnetIpv6 is the schema descriptor for subnet_ipv6 field.
+	provisionedhostDescSubnetIpv6 := provisionedhostFields[2].Descriptor()
+	// provisionedhost.DefaultSubnetIpv6 holds the default value on creation for the subnet_ipv6 field.
+	provisionedhost.DefaultSubnetIpv6 = provisionedhostDescSubnetIpv6.Default.(string)
 	// provisionedhostDescID is the schema descriptor for id field.
 	provisionedhostDescID := provisionedhostFields[0].Descriptor()
 	// provisionedhost.DefaultID holds the default value on creation for the id field.
 	provisionedhost.DefaultID = provisionedhostDescID.Default.(func() uuid.UUID)
 	provisionednetworkFields := schema.ProvisionedNetwork{}.Fields()
 	_ = provisionednetworkFields
+	// provisionednetworkDescIpv6Cidr is the schema descriptor for ipv6_cidr field.
+	provisionednetworkDescIpv6Cidr := provisionednetworkFields[3].Descriptor()
+	// provisionednetwork.DefaultIpv6Cidr holds the default value on creation for the ipv6_cidr field.
+	provisionednetwork.DefaultIpv6Cidr = provisionednetworkDescIpv6Cidr.Default.(string)
 	// provisionednetworkDescID is the schema descriptor for id field.
 	provisionednetworkDescID := provisionednetworkFields[0].Descriptor()
 	// provisionednetwork.DefaultID holds the default value on creation for the id field.
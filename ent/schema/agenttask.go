@@ -33,8 +33,10 @@ func (AgentTask) Fields() []ent.Field {
 		),
 		field.String("args"),
 		field.Int("number"),
+		field.Int("timeout").Default(0),
+		field.Int("attempt").Default(1),
 		field.String("output").Default(""),
-		field.Enum("state").Values("AWAITING", "INPROGRESS", "FAILED", "COMPLETE"),
+		field.Enum("state").Values("AWAITING", "INPROGRESS", "FAILED", "COMPLETE", "CANCELLED"),
 		field.String("error_message").Default(""),
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/gen0cide/laforge/approval"
 	"github.com/google/uuid"
 )
 
@@ -21,6 +22,8 @@ func (BuildCommit) Fields() []ent.Field {
 		field.Enum("type").Values("ROOT", "REBUILD", "DELETE"),
 		field.Int("revision"),
 		field.Enum("state").Values("PLANNING", "INPROGRESS", "APPLIED", "CANCELLED", "APPROVED"),
+		field.String("created_by").Default(""),
+		field.JSON("approvals", []approval.Approval{}).Optional(),
 	}
 }
 
@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/gen0cide/laforge/approval"
 	"github.com/google/uuid"
 )
 
@@ -50,6 +51,8 @@ func (Environment) Fields() []ent.Field {
 			StructTag(`hcl:"config,optional"`),
 		field.JSON("tags", map[string]string{}).
 			StructTag(`hcl:"tags,optional"`),
+		field.JSON("approval_policy", &approval.Policy{}).Optional().
+			StructTag(`hcl:"approval_policy,block"`),
 	}
 }
 
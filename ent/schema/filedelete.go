@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/gen0cide/laforge/retry"
 	"github.com/google/uuid"
 )
 
@@ -23,6 +24,8 @@ func (FileDelete) Fields() []ent.Field {
 			StructTag(`hcl:"path,attr"`),
 		field.JSON("tags", map[string]string{}).
 			StructTag(`hcl:"tags,optional"`),
+		field.JSON("retry", &retry.Policy{}).Optional().
+			StructTag(`hcl:"retry,block"`),
 	}
 }
 
@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/gen0cide/laforge/retry"
 	"github.com/google/uuid"
 )
 
@@ -37,6 +38,10 @@ func (FileDownload) Fields() []ent.Field {
 			StructTag(`hcl:"abs_path,optional"`),
 		field.JSON("tags", map[string]string{}).
 			StructTag(`hcl:"tags,optional"`),
+		field.JSON("retry", &retry.Policy{}).Optional().
+			StructTag(`hcl:"retry,block"`),
+		field.String("sha256").Default("").
+			StructTag(`hcl:"sha256,optional"`),
 	}
 }
 
@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/gen0cide/laforge/retry"
 	"github.com/google/uuid"
 )
 
@@ -27,6 +28,8 @@ func (FileExtract) Fields() []ent.Field {
 			StructTag(`hcl:"type,attr"`),
 		field.JSON("tags", map[string]string{}).
 			StructTag(`hcl:"tags,optional"`),
+		field.JSON("retry", &retry.Policy{}).Optional().
+			StructTag(`hcl:"retry,block"`),
 	}
 }
 
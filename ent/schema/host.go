@@ -28,8 +28,12 @@ func (Host) Fields() []ent.Field {
 			StructTag(`hcl:"os,attr"`),
 		field.Int("last_octet").
 			StructTag(`hcl:"last_octet,attr"`),
+		field.Int("cpus").Optional().
+			StructTag(`hcl:"cpus,optional"`),
+		field.Int("memory_mb").Optional().
+			StructTag(`hcl:"memory_mb,optional"`),
 		field.String("instance_size").
-			StructTag(`hcl:"instance_size,attr"`),
+			StructTag(`hcl:"instance_size,optional"`),
 		field.Bool("allow_mac_changes").
 			StructTag(`hcl:"allow_mac_changes,optional"`),
 		field.JSON("exposed_tcp_ports", []string{}).
@@ -44,6 +48,8 @@ func (Host) Fields() []ent.Field {
 			StructTag(`hcl:"user_groups,optional"`),
 		field.JSON("provision_steps", []string{}).Optional().
 			StructTag(`hcl:"provision_steps,optional"`),
+		field.JSON("additional_disks", []int{}).Optional().
+			StructTag(`hcl:"additional_disks,optional"`),
 		field.JSON("tags", map[string]string{}).
 			StructTag(`hcl:"tags,optional"`),
 	}
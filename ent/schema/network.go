@@ -23,12 +23,16 @@ func (Network) Fields() []ent.Field {
 			StructTag(`hcl:"name,attr"`),
 		field.String("cidr").
 			StructTag(`hcl:"cidr,attr"`),
+		field.String("ipv6_cidr").Default("").
+			StructTag(`hcl:"ipv6_cidr,optional"`),
 		field.Bool("vdi_visible").
 			StructTag(`hcl:"vdi_visible,optional"`),
 		field.JSON("vars", map[string]string{}).
 			StructTag(`hcl:"vars,optional"`),
 		field.JSON("tags", map[string]string{}).
 			StructTag(`hcl:"tags,optional"`),
+		field.JSON("reserved", []string{}).Optional().
+			StructTag(`hcl:"reserved,optional"`),
 	}
 }
 
@@ -28,6 +28,7 @@ func (Plan) Fields() []ent.Field {
 				"execute_step",
 			),
 		field.String("build_id"),
+		field.String("checksum").Default(""),
 	}
 }
 
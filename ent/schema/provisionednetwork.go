@@ -20,6 +20,7 @@ func (ProvisionedNetwork) Fields() []ent.Field {
 			Default(uuid.New),
 		field.String("name"),
 		field.String("cidr"),
+		field.String("ipv6_cidr").Default(""),
 	}
 }
 
@@ -5,6 +5,7 @@ import (
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"github.com/gen0cide/laforge/retry"
 	"github.com/google/uuid"
 )
 
@@ -46,6 +47,8 @@ func (Script) Fields() []ent.Field {
 			StructTag(`hcl:"abs_path,optional"`),
 		field.JSON("tags", map[string]string{}).
 			StructTag(`hcl:"tags,optional"`),
+		field.JSON("retry", &retry.Policy{}).Optional().
+			StructTag(`hcl:"retry,block"`),
 	}
 }
 
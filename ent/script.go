@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/gen0cide/laforge/ent/environment"
 	"github.com/gen0cide/laforge/ent/script"
+	"github.com/gen0cide/laforge/retry"
 	"github.com/google/uuid"
 )
 
@@ -46,6 +47,8 @@ type Script struct {
 	AbsPath string `json:"abs_path,omitempty" hcl:"abs_path,optional"`
 	// Tags holds the value of the "tags" field.
 	Tags map[string]string `json:"tags,omitempty" hcl:"tags,optional"`
+	// Retry holds the value of the "retry" field.
+	Retry *retry.Policy `json:"retry,omitempty" hcl:"retry,block"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ScriptQuery when eager-loading is set.
 	Edges ScriptEdges `json:"edges"`
@@ -111,7 +114,7 @@ func (*Script) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case script.FieldArgs, script.FieldVars, script.FieldTags:
+		case script.FieldArgs, script.FieldVars, script.FieldTags, script.FieldRetry:
 			values[i] = new([]byte)
 		case script.FieldIgnoreErrors, script.FieldDisabled:
 			values[i] = new(sql.NullBool)
@@ -234,6 +237,14 @@ func (s *Script) assignValues(columns []string, values []interface{}) error {
 					return fmt.Errorf("unmarshal field tags: %w", err)
 				}
 			}
+		case script.FieldRetry:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field retry", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.Retry); err != nil {
+					return fmt.Errorf("unmarshal field retry: %w", err)
+				}
+			}
 		case script.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field environment_environment_to_script", values[i])
@@ -312,6 +323,8 @@ func (s *Script) String() string {
 	builder.WriteString(s.AbsPath)
 	builder.WriteString(", tags=")
 	builder.WriteString(fmt.Sprintf("%v", s.Tags))
+	builder.WriteString(", retry=")
+	builder.WriteString(fmt.Sprintf("%v", s.Retry))
 	builder.WriteByte(')')
 	return builder.String()
 }
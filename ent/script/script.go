@@ -39,6 +39,8 @@ const (
 	FieldAbsPath = "abs_path"
 	// FieldTags holds the string denoting the tags field in the database.
 	FieldTags = "tags"
+	// FieldRetry holds the string denoting the retry field in the database.
+	FieldRetry = "retry"
 	// EdgeScriptToUser holds the string denoting the scripttouser edge name in mutations.
 	EdgeScriptToUser = "ScriptToUser"
 	// EdgeScriptToFinding holds the string denoting the scripttofinding edge name in mutations.
@@ -87,6 +89,7 @@ var Columns = []string{
 	FieldVars,
 	FieldAbsPath,
 	FieldTags,
+	FieldRetry,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "scripts"
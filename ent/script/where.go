@@ -1126,6 +1126,20 @@ func AbsPathContainsFold(v string) predicate.Script {
 	})
 }
 
+// RetryIsNil applies the IsNil predicate on the "retry" field.
+func RetryIsNil() predicate.Script {
+	return predicate.Script(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldRetry)))
+	})
+}
+
+// RetryNotNil applies the NotNil predicate on the "retry" field.
+func RetryNotNil() predicate.Script {
+	return predicate.Script(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldRetry)))
+	})
+}
+
 // HasScriptToUser applies the HasEdge predicate on the "ScriptToUser" edge.
 func HasScriptToUser() predicate.Script {
 	return predicate.Script(func(s *sql.Selector) {
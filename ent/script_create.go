@@ -13,6 +13,7 @@ import (
 	"github.com/gen0cide/laforge/ent/finding"
 	"github.com/gen0cide/laforge/ent/script"
 	"github.com/gen0cide/laforge/ent/user"
+	"github.com/gen0cide/laforge/retry"
 	"github.com/google/uuid"
 )
 
@@ -107,6 +108,12 @@ func (sc *ScriptCreate) SetTags(m map[string]string) *ScriptCreate {
 	return sc
 }
 
+// SetRetry sets the "retry" field.
+func (sc *ScriptCreate) SetRetry(r *retry.Policy) *ScriptCreate {
+	sc.mutation.SetRetry(r)
+	return sc
+}
+
 // SetID sets the "id" field.
 func (sc *ScriptCreate) SetID(u uuid.UUID) *ScriptCreate {
 	sc.mutation.SetID(u)
@@ -427,6 +434,14 @@ func (sc *ScriptCreate) createSpec() (*Script, *sqlgraph.CreateSpec) {
 		})
 		_node.Tags = value
 	}
+	if value, ok := sc.mutation.Retry(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: script.FieldRetry,
+		})
+		_node.Retry = value
+	}
 	if nodes := sc.mutation.ScriptToUserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
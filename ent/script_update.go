@@ -14,6 +14,7 @@ import (
 	"github.com/gen0cide/laforge/ent/predicate"
 	"github.com/gen0cide/laforge/ent/script"
 	"github.com/gen0cide/laforge/ent/user"
+	"github.com/gen0cide/laforge/retry"
 	"github.com/google/uuid"
 )
 
@@ -128,6 +129,18 @@ func (su *ScriptUpdate) SetTags(m map[string]string) *ScriptUpdate {
 	return su
 }
 
+// SetRetry sets the "retry" field.
+func (su *ScriptUpdate) SetRetry(r *retry.Policy) *ScriptUpdate {
+	su.mutation.SetRetry(r)
+	return su
+}
+
+// ClearRetry clears the value of the "retry" field.
+func (su *ScriptUpdate) ClearRetry() *ScriptUpdate {
+	su.mutation.ClearRetry()
+	return su
+}
+
 // AddScriptToUserIDs adds the "ScriptToUser" edge to the User entity by IDs.
 func (su *ScriptUpdate) AddScriptToUserIDs(ids ...uuid.UUID) *ScriptUpdate {
 	su.mutation.AddScriptToUserIDs(ids...)
@@ -414,6 +427,19 @@ func (su *ScriptUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: script.FieldTags,
 		})
 	}
+	if value, ok := su.mutation.Retry(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: script.FieldRetry,
+		})
+	}
+	if su.mutation.RetryCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: script.FieldRetry,
+		})
+	}
 	if su.mutation.ScriptToUserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -674,6 +700,18 @@ func (suo *ScriptUpdateOne) SetTags(m map[string]string) *ScriptUpdateOne {
 	return suo
 }
 
+// SetRetry sets the "retry" field.
+func (suo *ScriptUpdateOne) SetRetry(r *retry.Policy) *ScriptUpdateOne {
+	suo.mutation.SetRetry(r)
+	return suo
+}
+
+// ClearRetry clears the value of the "retry" field.
+func (suo *ScriptUpdateOne) ClearRetry() *ScriptUpdateOne {
+	suo.mutation.ClearRetry()
+	return suo
+}
+
 // AddScriptToUserIDs adds the "ScriptToUser" edge to the User entity by IDs.
 func (suo *ScriptUpdateOne) AddScriptToUserIDs(ids ...uuid.UUID) *ScriptUpdateOne {
 	suo.mutation.AddScriptToUserIDs(ids...)
@@ -984,6 +1022,19 @@ func (suo *ScriptUpdateOne) sqlSave(ctx context.Context) (_node *Script, err err
 			Column: script.FieldTags,
 		})
 	}
+	if value, ok := suo.mutation.Retry(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: script.FieldRetry,
+		})
+	}
+	if suo.mutation.RetryCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: script.FieldRetry,
+		})
+	}
 	if suo.mutation.ScriptToUserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -43,6 +43,7 @@ require (
 	github.com/mattn/go-sqlite3 v1.14.8
 	github.com/mattn/go-zglob v0.0.3
 	github.com/mholt/archiver v3.1.1+incompatible
+	github.com/miekg/dns v1.1.43
 	github.com/mitchellh/go-wordwrap v1.0.1 // indirect
 	github.com/nwaples/rardecode v1.1.0 // indirect
 	github.com/pierrec/lz4 v2.6.0+incompatible // indirect
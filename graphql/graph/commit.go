@@ -0,0 +1,49 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/gen0cide/laforge/approval"
+	"github.com/gen0cide/laforge/ent"
+	"github.com/gen0cide/laforge/ent/buildcommit"
+	"github.com/gen0cide/laforge/ent/predicate"
+)
+
+// checkNoPendingCommit errors if the latest commit of the build is still being reviewed or applied
+func checkNoPendingCommit(ctx context.Context, entBuild *ent.Build) error {
+	entCommit, err := entBuild.QueryBuildToLatestBuildCommit().Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed querying latest commit of build: %v", err)
+	}
+	switch entCommit.State {
+	case buildcommit.StatePLANNING, buildcommit.StateAPPROVED, buildcommit.StateINPROGRESS:
+		return fmt.Errorf("build has a %s commit that is %s, wait for it to finish first", entCommit.Type, entCommit.State)
+	}
+	return nil
+}
+
+// approvalsUnchanged matches a commit whose approvals are still the ones it was read with, approvals only
+// ever get appended so an approval made in between always changes them
+func approvalsUnchanged(approvals []approval.Approval) (predicate.BuildCommit, error) {
+	if len(approvals) == 0 {
+		return buildcommit.Or(buildcommit.ApprovalsIsNil(), approvalsEQ([]byte("null")), approvalsEQ([]byte("[]"))), nil
+	}
+	// Encoded the same way ent stores the field
+	value, err := json.Marshal(approvals)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding approvals: %v", err)
+	}
+	return approvalsEQ(value), nil
+}
+
+func approvalsEQ(value []byte) predicate.BuildCommit {
+	return predicate.BuildCommit(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(buildcommit.FieldApprovals), value))
+	})
+}
@@ -0,0 +1,30 @@
+package graph
+
+import (
+	"time"
+
+	"github.com/gen0cide/laforge/graphql/graph/model"
+	"github.com/gen0cide/laforge/planner"
+)
+
+// driftReportToModel converts a planner drift report into its GraphQL representation
+func driftReportToModel(report *planner.DriftReport) *model.DriftReport {
+	drift := make([]*model.DriftEntry, 0, len(report.Drift))
+	for _, entry := range report.Drift {
+		drift = append(drift, &model.DriftEntry{
+			ProvisionedHost:    entry.ProvisionedHost,
+			ProvisionedNetwork: entry.ProvisionedNetwork,
+			TeamNumber:         entry.TeamNumber,
+			Kind:               model.DriftKind(entry.Kind),
+			Detail:             entry.Detail,
+		})
+	}
+	return &model.DriftReport{
+		BuildID:         report.BuildID.String(),
+		CheckedAt:       report.CheckedAt.Format(time.RFC3339),
+		CheckedHosts:    report.CheckedHosts,
+		CheckedNetworks: report.CheckedNetworks,
+		Errors:          report.Errors,
+		Drift:           drift,
+	}
+}
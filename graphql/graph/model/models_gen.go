@@ -15,16 +15,66 @@ type AgentStatusBatch struct {
 	PageInfo      *LaForgePageInfo   `json:"pageInfo"`
 }
 
+type BuildProgress struct {
+	Progress                  *ProgressRollup               `json:"progress"`
+	Teams                     []*TeamProgress               `json:"teams"`
+	Networks                  []*ProvisionedNetworkProgress `json:"networks"`
+	Hosts                     []*ProvisionedHostProgress    `json:"hosts"`
+	EstimatedSecondsRemaining int                           `json:"estimatedSecondsRemaining"`
+	Eta                       *string                       `json:"eta"`
+	CriticalPath              []*ent.Plan                   `json:"criticalPath"`
+}
+
+type DriftEntry struct {
+	ProvisionedHost    *ent.ProvisionedHost    `json:"provisionedHost"`
+	ProvisionedNetwork *ent.ProvisionedNetwork `json:"provisionedNetwork"`
+	TeamNumber         int                     `json:"teamNumber"`
+	Kind               DriftKind               `json:"kind"`
+	Detail             string                  `json:"detail"`
+}
+
+type DriftReport struct {
+	BuildID         string        `json:"buildID"`
+	CheckedAt       string        `json:"checkedAt"`
+	CheckedHosts    int           `json:"checkedHosts"`
+	CheckedNetworks int           `json:"checkedNetworks"`
+	Errors          []string      `json:"errors"`
+	Drift           []*DriftEntry `json:"drift"`
+}
+
 type LaForgePageInfo struct {
 	Total      int `json:"total"`
 	NextOffset int `json:"nextOffset"`
 }
 
+type ProgressRollup struct {
+	Total           int     `json:"total"`
+	Completed       int     `json:"completed"`
+	Failed          int     `json:"failed"`
+	InProgress      int     `json:"inProgress"`
+	PercentComplete float64 `json:"percentComplete"`
+}
+
+type ProvisionedHostProgress struct {
+	ProvisionedHost *ent.ProvisionedHost `json:"provisionedHost"`
+	Progress        *ProgressRollup      `json:"progress"`
+}
+
+type ProvisionedNetworkProgress struct {
+	ProvisionedNetwork *ent.ProvisionedNetwork `json:"provisionedNetwork"`
+	Progress           *ProgressRollup         `json:"progress"`
+}
+
 type StatusBatch struct {
 	Statuses []*ent.Status    `json:"statuses"`
 	PageInfo *LaForgePageInfo `json:"pageInfo"`
 }
 
+type TeamProgress struct {
+	TeamNumber int             `json:"teamNumber"`
+	Progress   *ProgressRollup `json:"progress"`
+}
+
 type ConfigMap struct {
 	Key   string `json:"key"`
 	Value string `json:"value"`
@@ -108,6 +158,7 @@ const (
 	AgentTaskStateInprogress AgentTaskState = "INPROGRESS"
 	AgentTaskStateFailed     AgentTaskState = "FAILED"
 	AgentTaskStateComplete   AgentTaskState = "COMPLETE"
+	AgentTaskStateCancelled  AgentTaskState = "CANCELLED"
 )
 
 var AllAgentTaskState = []AgentTaskState{
@@ -115,11 +166,12 @@ var AllAgentTaskState = []AgentTaskState{
 	AgentTaskStateInprogress,
 	AgentTaskStateFailed,
 	AgentTaskStateComplete,
+	AgentTaskStateCancelled,
 }
 
 func (e AgentTaskState) IsValid() bool {
 	switch e {
-	case AgentTaskStateAwaiting, AgentTaskStateInprogress, AgentTaskStateFailed, AgentTaskStateComplete:
+	case AgentTaskStateAwaiting, AgentTaskStateInprogress, AgentTaskStateFailed, AgentTaskStateComplete, AgentTaskStateCancelled:
 		return true
 	}
 	return false
@@ -236,6 +288,47 @@ func (e BuildCommitType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type DriftKind string
+
+const (
+	DriftKindMissing    DriftKind = "MISSING"
+	DriftKindPoweredOff DriftKind = "POWERED_OFF"
+)
+
+var AllDriftKind = []DriftKind{
+	DriftKindMissing,
+	DriftKindPoweredOff,
+}
+
+func (e DriftKind) IsValid() bool {
+	switch e {
+	case DriftKindMissing, DriftKindPoweredOff:
+		return true
+	}
+	return false
+}
+
+func (e DriftKind) String() string {
+	return string(e)
+}
+
+func (e *DriftKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DriftKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DriftKind", str)
+	}
+	return nil
+}
+
+func (e DriftKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type FindingDifficulty string
 
 const (
@@ -332,6 +425,49 @@ func (e FindingSeverity) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type PlanGraphFormat string
+
+const (
+	PlanGraphFormatDot     PlanGraphFormat = "DOT"
+	PlanGraphFormatMermaid PlanGraphFormat = "MERMAID"
+	PlanGraphFormatJSON    PlanGraphFormat = "JSON"
+)
+
+var AllPlanGraphFormat = []PlanGraphFormat{
+	PlanGraphFormatDot,
+	PlanGraphFormatMermaid,
+	PlanGraphFormatJSON,
+}
+
+func (e PlanGraphFormat) IsValid() bool {
+	switch e {
+	case PlanGraphFormatDot, PlanGraphFormatMermaid, PlanGraphFormatJSON:
+		return true
+	}
+	return false
+}
+
+func (e PlanGraphFormat) String() string {
+	return string(e)
+}
+
+func (e *PlanGraphFormat) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PlanGraphFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PlanGraphFormat", str)
+	}
+	return nil
+}
+
+func (e PlanGraphFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type PlanType string
 
 const (
@@ -0,0 +1,95 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gen0cide/laforge/ent"
+	"github.com/gen0cide/laforge/ent/plan"
+	"github.com/gen0cide/laforge/ent/provisionedhost"
+	"github.com/gen0cide/laforge/ent/provisionednetwork"
+	"github.com/gen0cide/laforge/graphql/graph/model"
+	"github.com/gen0cide/laforge/planner"
+	"github.com/google/uuid"
+)
+
+func progressRollupToModel(rollup *planner.ProgressRollup) *model.ProgressRollup {
+	return &model.ProgressRollup{
+		Total:           rollup.Total,
+		Completed:       rollup.Completed,
+		Failed:          rollup.Failed,
+		InProgress:      rollup.InProgress,
+		PercentComplete: rollup.PercentComplete,
+	}
+}
+
+// buildProgressToModel converts a planner build progress into its GraphQL representation
+func buildProgressToModel(ctx context.Context, client *ent.Client, progress *planner.BuildProgress) (*model.BuildProgress, error) {
+	result := &model.BuildProgress{
+		Progress:                  progressRollupToModel(&progress.Build),
+		Teams:                     make([]*model.TeamProgress, 0, len(progress.Teams)),
+		Networks:                  make([]*model.ProvisionedNetworkProgress, 0, len(progress.Networks)),
+		Hosts:                     make([]*model.ProvisionedHostProgress, 0, len(progress.Hosts)),
+		EstimatedSecondsRemaining: int(progress.EstimatedRemaining.Seconds()),
+		CriticalPath:              []*ent.Plan{},
+	}
+	if !progress.ETA.IsZero() {
+		eta := progress.ETA.Format(time.RFC3339)
+		result.Eta = &eta
+	}
+
+	for teamNumber, rollup := range progress.Teams {
+		result.Teams = append(result.Teams, &model.TeamProgress{
+			TeamNumber: teamNumber,
+			Progress:   progressRollupToModel(rollup),
+		})
+	}
+	sort.Slice(result.Teams, func(i, j int) bool { return result.Teams[i].TeamNumber < result.Teams[j].TeamNumber })
+
+	networkIDs := make([]uuid.UUID, 0, len(progress.Networks))
+	for id := range progress.Networks {
+		networkIDs = append(networkIDs, id)
+	}
+	entNetworks, err := client.ProvisionedNetwork.Query().Where(provisionednetwork.IDIn(networkIDs...)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying provisioned networks: %v", err)
+	}
+	for _, entNetwork := range entNetworks {
+		result.Networks = append(result.Networks, &model.ProvisionedNetworkProgress{
+			ProvisionedNetwork: entNetwork,
+			Progress:           progressRollupToModel(progress.Networks[entNetwork.ID]),
+		})
+	}
+
+	hostIDs := make([]uuid.UUID, 0, len(progress.Hosts))
+	for id := range progress.Hosts {
+		hostIDs = append(hostIDs, id)
+	}
+	entHosts, err := client.ProvisionedHost.Query().Where(provisionedhost.IDIn(hostIDs...)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying provisioned hosts: %v", err)
+	}
+	for _, entHost := range entHosts {
+		result.Hosts = append(result.Hosts, &model.ProvisionedHostProgress{
+			ProvisionedHost: entHost,
+			Progress:        progressRollupToModel(progress.Hosts[entHost.ID]),
+		})
+	}
+
+	entPlans, err := client.Plan.Query().Where(plan.IDIn(progress.CriticalPath...)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying critical path plans: %v", err)
+	}
+	plansByID := make(map[uuid.UUID]*ent.Plan, len(entPlans))
+	for _, entPlan := range entPlans {
+		plansByID[entPlan.ID] = entPlan
+	}
+	for _, id := range progress.CriticalPath {
+		if entPlan, ok := plansByID[id]; ok {
+			result.CriticalPath = append(result.CriticalPath, entPlan)
+		}
+	}
+	return result, nil
+}
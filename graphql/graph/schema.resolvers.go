@@ -10,7 +10,9 @@ import (
 	"os"
 	"path"
 	"strings"
+	"time"
 
+	"github.com/gen0cide/laforge/approval"
 	"github.com/gen0cide/laforge/ent"
 	"github.com/gen0cide/laforge/ent/agentstatus"
 	"github.com/gen0cide/laforge/ent/agenttask"
@@ -84,6 +86,14 @@ func (r *buildResolver) ID(ctx context.Context, obj *ent.Build) (string, error)
 	return obj.ID.String(), nil
 }
 
+func (r *buildResolver) Progress(ctx context.Context, obj *ent.Build) (*model.BuildProgress, error) {
+	progress, err := planner.GetBuildProgress(ctx, r.client, obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed computing build progress: %v", err)
+	}
+	return buildProgressToModel(ctx, r.client, progress)
+}
+
 func (r *buildCommitResolver) ID(ctx context.Context, obj *ent.BuildCommit) (string, error) {
 	return obj.ID.String(), nil
 }
@@ -381,7 +391,7 @@ func (r *mutationResolver) LoadEnvironment(ctx context.Context, envFilePath stri
 	return results, nil
 }
 
-func (r *mutationResolver) CreateBuild(ctx context.Context, envUUID string, renderFiles bool) (*ent.Build, error) {
+func (r *mutationResolver) CreateBuild(ctx context.Context, envUUID string, renderFiles bool, filter *planner.BuildFilter) (*ent.Build, error) {
 	currentUser, err := auth.ForContext(ctx)
 	if err != nil {
 		return nil, err
@@ -398,6 +408,10 @@ func (r *mutationResolver) CreateBuild(ctx context.Context, envUUID string, rend
 	if err != nil {
 		return nil, fmt.Errorf("failed querying Environment: %v", err)
 	}
+	err = filter.Validate(ctx, entEnvironment)
+	if err != nil {
+		return nil, fmt.Errorf("invalid build filter: %v", err)
+	}
 	planner.RenderFiles = renderFiles
 	if renderFiles {
 		planner.RenderFilesTaskStatus, planner.RenderFilesTask, err = utils.CreateServerTask(ctx, r.client, r.rdb, currentUser, servertask.TypeRENDERFILES)
@@ -406,7 +420,7 @@ func (r *mutationResolver) CreateBuild(ctx context.Context, envUUID string, rend
 		planner.RenderFilesTaskStatus = nil
 	}
 
-	return planner.CreateBuild(ctx, r.client, r.rdb, currentUser, entEnvironment)
+	return planner.CreateBuild(ctx, r.client, r.rdb, currentUser, entEnvironment, filter)
 }
 
 func (r *mutationResolver) DeleteUser(ctx context.Context, userUUID string) (bool, error) {
@@ -424,7 +438,7 @@ func (r *mutationResolver) DeleteUser(ctx context.Context, userUUID string) (boo
 	return true, err
 }
 
-func (r *mutationResolver) ExecutePlan(ctx context.Context, buildUUID string) (*ent.Build, error) {
+func (r *mutationResolver) ExecutePlan(ctx context.Context, buildUUID string, filter *planner.BuildFilter) (*ent.Build, error) {
 	currentUser, err := auth.ForContext(ctx)
 	if err != nil {
 		return nil, err
@@ -446,6 +460,10 @@ func (r *mutationResolver) ExecutePlan(ctx context.Context, buildUUID string) (*
 	if err != nil {
 		return nil, fmt.Errorf("failed to query environment from build: %v", err)
 	}
+	err = filter.Validate(ctx, entEnvironment)
+	if err != nil {
+		return nil, fmt.Errorf("invalid build filter: %v", err)
+	}
 
 	taskStatus, serverTask, err := utils.CreateServerTask(ctx, r.client, r.rdb, currentUser, servertask.TypeEXECUTEBUILD)
 	if err != nil {
@@ -466,7 +484,7 @@ func (r *mutationResolver) ExecutePlan(ctx context.Context, buildUUID string) (*
 		return nil, err
 	}
 
-	go planner.StartBuild(r.client, logger, currentUser, serverTask, taskStatus, b)
+	go planner.StartBuild(r.client, logger, currentUser, serverTask, taskStatus, b, filter)
 
 	return b, nil
 }
@@ -527,6 +545,202 @@ func (r *mutationResolver) DeleteBuild(ctx context.Context, buildUUID string) (b
 	return false, nil
 }
 
+func (r *mutationResolver) ScaleBuild(ctx context.Context, buildUUID string, teamCount int) (bool, error) {
+	currentUser, err := auth.ForContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	uuid, err := uuid.Parse(buildUUID)
+	if err != nil {
+		return false, fmt.Errorf("failed casting UUID to UUID: %v", err)
+	}
+
+	b, err := r.client.Build.Query().Where(build.IDEQ(uuid)).Only(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed querying Build: %v", err)
+	}
+	err = checkNoPendingCommit(ctx, b)
+	if err != nil {
+		return false, err
+	}
+
+	entEnvironment, err := b.QueryBuildToEnvironment().Only(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to query environment from build: %v", err)
+	}
+	err = planner.CheckTeamCidrs(ctx, entEnvironment, teamCount)
+	if err != nil {
+		return false, err
+	}
+
+	taskStatus, serverTask, err := utils.CreateServerTask(ctx, r.client, r.rdb, currentUser, servertask.TypeREBUILD)
+	if err != nil {
+		return false, fmt.Errorf("error creating server task: %v", err)
+	}
+	serverTask, err = r.client.ServerTask.UpdateOne(serverTask).SetServerTaskToBuild(b).SetServerTaskToEnvironment(entEnvironment).Save(ctx)
+	if err != nil {
+		taskStatus, serverTask, err = utils.FailServerTask(ctx, r.client, r.rdb, taskStatus, serverTask)
+		if err != nil {
+			return false, fmt.Errorf("error failing scale build server task: %v", err)
+		}
+		return false, fmt.Errorf("error assigning environment and build to scale build server task: %v", err)
+	}
+	r.rdb.Publish(ctx, "updatedServerTask", serverTask.ID.String())
+
+	logger, err := logging.CreateLoggerForServerTask(serverTask)
+	if err != nil {
+		return false, err
+	}
+
+	spawnedScale := make(chan bool, 1)
+	go planner.ScaleBuild(r.client, r.rdb, logger, currentUser, serverTask, taskStatus, b, teamCount, spawnedScale)
+
+	if <-spawnedScale {
+		return true, nil
+	}
+	taskStatus, serverTask, err = utils.FailServerTask(ctx, r.client, r.rdb, taskStatus, serverTask)
+	if err != nil {
+		return false, fmt.Errorf("error failing scale build server task: %v", err)
+	}
+	return false, nil
+}
+
+func (r *mutationResolver) RemoveTeam(ctx context.Context, buildUUID string, teamNumber int) (bool, error) {
+	currentUser, err := auth.ForContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	uuid, err := uuid.Parse(buildUUID)
+	if err != nil {
+		return false, fmt.Errorf("failed casting UUID to UUID: %v", err)
+	}
+
+	b, err := r.client.Build.Query().Where(build.IDEQ(uuid)).Only(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed querying Build: %v", err)
+	}
+	teamExists, err := b.QueryBuildToTeam().Where(team.TeamNumberEQ(teamNumber)).Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed querying team: %v", err)
+	}
+	if !teamExists {
+		return false, fmt.Errorf("build has no team %d", teamNumber)
+	}
+	err = checkNoPendingCommit(ctx, b)
+	if err != nil {
+		return false, err
+	}
+
+	entEnvironment, err := b.QueryBuildToEnvironment().Only(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to query environment from build: %v", err)
+	}
+
+	taskStatus, serverTask, err := utils.CreateServerTask(ctx, r.client, r.rdb, currentUser, servertask.TypeDELETEBUILD)
+	if err != nil {
+		return false, fmt.Errorf("error creating server task: %v", err)
+	}
+	serverTask, err = r.client.ServerTask.UpdateOne(serverTask).SetServerTaskToBuild(b).SetServerTaskToEnvironment(entEnvironment).Save(ctx)
+	if err != nil {
+		taskStatus, serverTask, err = utils.FailServerTask(ctx, r.client, r.rdb, taskStatus, serverTask)
+		if err != nil {
+			return false, fmt.Errorf("error failing remove team server task: %v", err)
+		}
+		return false, fmt.Errorf("error assigning environment and build to remove team server task: %v", err)
+	}
+	r.rdb.Publish(ctx, "updatedServerTask", serverTask.ID.String())
+
+	logger, err := logging.CreateLoggerForServerTask(serverTask)
+	if err != nil {
+		return false, err
+	}
+
+	spawnedRemove := make(chan bool, 1)
+	go planner.RemoveTeam(r.client, r.rdb, logger, currentUser, serverTask, taskStatus, b, teamNumber, spawnedRemove)
+
+	if <-spawnedRemove {
+		return true, nil
+	}
+	taskStatus, serverTask, err = utils.FailServerTask(ctx, r.client, r.rdb, taskStatus, serverTask)
+	if err != nil {
+		return false, fmt.Errorf("error failing remove team server task: %v", err)
+	}
+	return false, nil
+}
+
+func (r *mutationResolver) RebuildChanges(ctx context.Context, buildUUID string) (bool, error) {
+	currentUser, err := auth.ForContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	uuid, err := uuid.Parse(buildUUID)
+	if err != nil {
+		return false, fmt.Errorf("failed casting UUID to UUID: %v", err)
+	}
+
+	b, err := r.client.Build.Query().Where(build.IDEQ(uuid)).Only(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed querying Build: %v", err)
+	}
+	err = checkNoPendingCommit(ctx, b)
+	if err != nil {
+		return false, err
+	}
+
+	entEnvironment, err := b.QueryBuildToEnvironment().Only(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to query environment from build: %v", err)
+	}
+
+	changes, err := planner.DiffBuild(ctx, r.client, b)
+	if err != nil {
+		return false, fmt.Errorf("failed diffing build against environment: %v", err)
+	}
+	// Nothing to rebuild, the build is up to date with the environment
+	if len(changes) == 0 {
+		err = b.Update().SetEnvironmentRevision(entEnvironment.Revision).Exec(ctx)
+		if err != nil {
+			return false, fmt.Errorf("failed updating environment revision of build: %v", err)
+		}
+		r.rdb.Publish(ctx, "updatedBuild", b.ID.String())
+		return false, nil
+	}
+
+	taskStatus, serverTask, err := utils.CreateServerTask(ctx, r.client, r.rdb, currentUser, servertask.TypeREBUILD)
+	if err != nil {
+		return false, fmt.Errorf("error creating server task: %v", err)
+	}
+	serverTask, err = r.client.ServerTask.UpdateOne(serverTask).SetServerTaskToBuild(b).SetServerTaskToEnvironment(entEnvironment).Save(ctx)
+	if err != nil {
+		taskStatus, serverTask, err = utils.FailServerTask(ctx, r.client, r.rdb, taskStatus, serverTask)
+		if err != nil {
+			return false, fmt.Errorf("error failing rebuild changes server task: %v", err)
+		}
+		return false, fmt.Errorf("error assigning environment and build to rebuild changes server task: %v", err)
+	}
+	r.rdb.Publish(ctx, "updatedServerTask", serverTask.ID.String())
+
+	logger, err := logging.CreateLoggerForServerTask(serverTask)
+	if err != nil {
+		return false, err
+	}
+
+	spawnedRebuild := make(chan bool, 1)
+	go planner.RebuildChanges(r.client, r.rdb, logger, currentUser, serverTask, taskStatus, b, changes, spawnedRebuild)
+
+	if <-spawnedRebuild {
+		return true, nil
+	}
+	taskStatus, serverTask, err = utils.FailServerTask(ctx, r.client, r.rdb, taskStatus, serverTask)
+	if err != nil {
+		return false, fmt.Errorf("error failing rebuild changes server task: %v", err)
+	}
+	return false, nil
+}
+
 func (r *mutationResolver) CreateTask(ctx context.Context, proHostUUID string, command model.AgentCommand, args string) (bool, error) {
 	uuid, err := uuid.Parse(proHostUUID)
 
@@ -613,14 +827,63 @@ func (r *mutationResolver) Rebuild(ctx context.Context, rootPlans []*string) (bo
 }
 
 func (r *mutationResolver) ApproveCommit(ctx context.Context, commitUUID string) (bool, error) {
+	currentUser, err := auth.ForContext(ctx)
+	if err != nil {
+		return false, fmt.Errorf("error getting auth user from context: %v", err)
+	}
 	uuid, err := uuid.Parse(commitUUID)
 	if err != nil {
 		return false, err
 	}
-	err = r.client.BuildCommit.UpdateOneID(uuid).SetState(buildcommit.StateAPPROVED).Exec(ctx)
+	entBuildCommit, err := r.client.BuildCommit.Get(ctx, uuid)
+	if err != nil {
+		return false, fmt.Errorf("failed querying build commit: %v", err)
+	}
+	if entBuildCommit.State != buildcommit.StatePLANNING {
+		return false, fmt.Errorf("build commit is %s, only commits waiting for review can be approved", entBuildCommit.State)
+	}
+	entEnvironment, err := entBuildCommit.QueryBuildCommitToBuild().QueryBuildToEnvironment().Only(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed querying environment from build commit: %v", err)
+	}
+	policy := entEnvironment.ApprovalPolicy
+	err = policy.Authorize(string(currentUser.Role), entBuildCommit.CreatedBy == currentUser.ID.String())
 	if err != nil {
 		return false, err
 	}
+	for _, entApproval := range entBuildCommit.Approvals {
+		if entApproval.UserID == currentUser.ID.String() {
+			return false, fmt.Errorf("build commit has already been approved by %s", currentUser.Username)
+		}
+	}
+
+	approvals := append(entBuildCommit.Approvals, approval.Approval{
+		UserID:     currentUser.ID.String(),
+		Username:   currentUser.Username,
+		Role:       string(currentUser.Role),
+		ApprovedAt: time.Now(),
+	})
+	unchanged, err := approvalsUnchanged(entBuildCommit.Approvals)
+	if err != nil {
+		return false, err
+	}
+	// Only record the approval if the commit is still in review with the approvals read above, so
+	// concurrent approvals can't overwrite each other or approve the commit twice
+	commitUpdate := r.client.BuildCommit.Update().Where(
+		buildcommit.IDEQ(entBuildCommit.ID),
+		buildcommit.StateEQ(buildcommit.StatePLANNING),
+		unchanged,
+	).SetApprovals(approvals)
+	if len(approvals) >= policy.RequiredApprovals() {
+		commitUpdate = commitUpdate.SetState(buildcommit.StateAPPROVED)
+	}
+	updated, err := commitUpdate.Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed approving build commit: %v", err)
+	}
+	if updated == 0 {
+		return false, fmt.Errorf("build commit was updated while approving it, try again")
+	}
 	r.rdb.Publish(ctx, "updatedBuildCommit", commitUUID)
 	return true, nil
 }
@@ -638,6 +901,77 @@ func (r *mutationResolver) CancelCommit(ctx context.Context, commitUUID string)
 	return true, nil
 }
 
+func (r *mutationResolver) CancelBuildCommit(ctx context.Context, commitUUID string) (bool, error) {
+	uuid, err := uuid.Parse(commitUUID)
+	if err != nil {
+		return false, err
+	}
+	entBuildCommit, err := r.client.BuildCommit.Get(ctx, uuid)
+	if err != nil {
+		return false, fmt.Errorf("failed querying build commit: %v", err)
+	}
+	if entBuildCommit.State != buildcommit.StateINPROGRESS {
+		return false, fmt.Errorf("build commit is %s, only commits in progress can be cancelled", entBuildCommit.State)
+	}
+	err = planner.CancelBuildCommit(r.client, entBuildCommit)
+	if err != nil {
+		return false, fmt.Errorf("failed cancelling build commit: %v", err)
+	}
+	return true, nil
+}
+
+func (r *mutationResolver) ResumeBuildCommit(ctx context.Context, commitUUID string) (bool, error) {
+	currentUser, err := auth.ForContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	uuid, err := uuid.Parse(commitUUID)
+	if err != nil {
+		return false, err
+	}
+	entBuildCommit, err := r.client.BuildCommit.Get(ctx, uuid)
+	if err != nil {
+		return false, fmt.Errorf("failed querying build commit: %v", err)
+	}
+	taskType := servertask.TypeEXECUTEBUILD
+	if entBuildCommit.Type == buildcommit.TypeREBUILD {
+		taskType = servertask.TypeREBUILD
+	}
+
+	b, err := planner.ResumeCancelledCommit(ctx, r.client, entBuildCommit)
+	if err != nil {
+		return false, err
+	}
+	env, err := b.QueryBuildToEnvironment().Only(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to query environment from build: %v", err)
+	}
+
+	taskStatus, serverTask, err := utils.CreateServerTask(ctx, r.client, r.rdb, currentUser, taskType)
+	if err != nil {
+		return false, fmt.Errorf("error creating server task: %v", err)
+	}
+	serverTask, err = r.client.ServerTask.UpdateOne(serverTask).SetServerTaskToBuild(b).SetServerTaskToEnvironment(env).Save(ctx)
+	if err != nil {
+		taskStatus, serverTask, err = utils.FailServerTask(ctx, r.client, r.rdb, taskStatus, serverTask)
+		if err != nil {
+			return false, fmt.Errorf("error failing resume build server task: %v", err)
+		}
+		return false, fmt.Errorf("error assigning environment and build to resume build server task: %v", err)
+	}
+	r.rdb.Publish(ctx, "updatedServerTask", serverTask.ID.String())
+
+	logger, err := logging.CreateLoggerForServerTask(serverTask)
+	if err != nil {
+		return false, err
+	}
+
+	go planner.ResumeBuild(r.client, logger, serverTask, taskStatus, b)
+
+	return true, nil
+}
+
 func (r *mutationResolver) CreateAgentTasks(ctx context.Context, hostHclid string, command model.AgentCommand, buildUUID string, args []string, teams []int) ([]*ent.AgentTask, error) {
 	uuid, err := uuid.Parse(buildUUID)
 
@@ -990,6 +1324,28 @@ func (r *mutationResolver) ModifyAdminPassword(ctx context.Context, userID strin
 	return true, nil
 }
 
+func (r *mutationResolver) RefreshBuild(ctx context.Context, buildUUID string) (*model.DriftReport, error) {
+	uuid, err := uuid.Parse(buildUUID)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed casting UUID to UUID: %v", err)
+	}
+
+	b, err := r.client.Build.Query().Where(build.IDEQ(uuid)).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying Build: %v", err)
+	}
+
+	logger := logging.Logger{
+		Log: logrus.StandardLogger(),
+	}
+	report, err := planner.RefreshBuild(ctx, r.client, r.rdb, &logger, b)
+	if err != nil {
+		return nil, fmt.Errorf("failed refreshing Build: %v", err)
+	}
+	return driftReportToModel(report), nil
+}
+
 func (r *networkResolver) ID(ctx context.Context, obj *ent.Network) (string, error) {
 	return obj.ID.String(), nil
 }
@@ -1331,6 +1687,42 @@ func (r *queryResolver) ViewAgentTask(ctx context.Context, taskID string) (*ent.
 	return r.client.AgentTask.Get(ctx, uuid)
 }
 
+func (r *queryResolver) GetDriftReport(ctx context.Context, buildUUID string) (*model.DriftReport, error) {
+	uuid, err := uuid.Parse(buildUUID)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed casting UUID to UUID: %v", err)
+	}
+
+	report, exists := planner.LastDriftReport(uuid)
+	if !exists {
+		return nil, nil
+	}
+	return driftReportToModel(report), nil
+}
+
+func (r *queryResolver) GetPlanGraph(ctx context.Context, buildUUID string, format model.PlanGraphFormat, filter *planner.BuildFilter) (string, error) {
+	uuid, err := uuid.Parse(buildUUID)
+
+	if err != nil {
+		return "", fmt.Errorf("failed casting UUID to UUID: %v", err)
+	}
+
+	entBuild, err := r.client.Build.Get(ctx, uuid)
+	if err != nil {
+		return "", fmt.Errorf("failed querying build: %v", err)
+	}
+	entEnvironment, err := entBuild.QueryBuildToEnvironment().Only(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to query environment from build: %v", err)
+	}
+	err = filter.Validate(ctx, entEnvironment)
+	if err != nil {
+		return "", fmt.Errorf("invalid build filter: %v", err)
+	}
+	return planner.ExportPlanGraph(ctx, entBuild, filter, format.String())
+}
+
 func (r *repositoryResolver) ID(ctx context.Context, obj *ent.Repository) (string, error) {
 	return obj.ID.String(), nil
 }
@@ -58,9 +58,10 @@ func (p *program) Start(s service.Service) error {
 	return nil
 }
 
-// ExecuteCommand Runs the Command that is inputted and either returns the error or output
-func ExecuteCommand(command string, args ...string) (string, error) {
-	return SystemExecuteCommand(command, args...)
+// ExecuteCommand Runs the Command that is inputted and either returns the error or output.
+// The command (and everything it started) is killed once it runs longer than timeout, 0 means no limit.
+func ExecuteCommand(timeout time.Duration, command string, args ...string) (string, error) {
+	return SystemExecuteCommand(timeout, command, args...)
 }
 
 // DeleteObject Deletes the Object that is inputted and either returns the error or nothing
@@ -196,7 +197,8 @@ func RequestTask(c pb.LaforgeClient) {
 			taskArgs := strings.Split(r.GetArgs(), "💔")
 			command := taskArgs[0]
 			args := taskArgs[1:]
-			taskoutput, taskerr := ExecuteCommand(command, args...)
+			timeout := time.Duration(r.GetTimeout()) * time.Second
+			taskoutput, taskerr := ExecuteCommand(timeout, command, args...)
 			taskoutput = strings.ReplaceAll(taskoutput, "\n", "🔥")
 			// logger.Infof("Command Output: %s", output)
 			RequestTaskStatusRequest(taskoutput, taskerr, r.Id, c)
@@ -3,6 +3,7 @@
 package main
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"net/http"
@@ -26,7 +27,7 @@ func RebootSystem() {
 func CreateSystemUser(username string, password string) error {
 	_, err := user.Lookup(username)
 	if err != nil {
-		ExecuteCommand("useradd", username)
+		ExecuteCommand(0, "useradd", username)
 		ChangeSystemUserPassword(username, password)
 	}
 	return nil
@@ -56,7 +57,7 @@ func ChangeSystemUserPassword(username string, password string) error {
 
 // AddSystemUserGroup Change user password.
 func AddSystemUserGroup(groupname string, username string) error {
-	ExecuteCommand("usermod", "-a", "-G", groupname, username)
+	ExecuteCommand(0, "usermod", "-a", "-G", groupname, username)
 	return nil
 }
 
@@ -96,7 +97,7 @@ func SystemDownloadFile(path, url string) error {
 }
 
 // SystemExecuteCommand Runs the Command that is inputted and either returns the error or output
-func SystemExecuteCommand(command string, args ...string) (string, error) {
+func SystemExecuteCommand(timeout time.Duration, command string, args ...string) (string, error) {
 	var err error
 	_, err = os.Stat(command)
 	// output := ""
@@ -109,8 +110,31 @@ func SystemExecuteCommand(command string, args ...string) (string, error) {
 	}
 	// Execute the command
 	cmd := exec.Command(command, args...)
-	out, err := cmd.CombinedOutput()
-	return string(out), err
+	if timeout <= 0 {
+		out, err := cmd.CombinedOutput()
+		return string(out), err
+	}
+	// Run it in its own process group so the whole group can be killed on timeout
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	err = cmd.Start()
+	if err != nil {
+		return "", err
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- cmd.Wait()
+	}()
+	select {
+	case err = <-done:
+		return out.String(), err
+	case <-time.After(timeout):
+		syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+		<-done
+		return out.String(), fmt.Errorf("timed out after %v", timeout)
+	}
 	// retryCount := 5
 	// for i := 0; i < retryCount; i++ {
 	// 	// Get the data
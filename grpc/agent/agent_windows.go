@@ -4,11 +4,14 @@
 package main
 
 import (
+	"bytes"
+	"fmt"
 	"io"
 	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -26,7 +29,7 @@ func RebootSystem() {
 
 	r1, _, _ := exitwin.Call(0x02, 0)
 	if r1 != 1 {
-		ExecuteCommand("cmd", "/C", "shutdown", "/r", "/f")
+		ExecuteCommand(0, "cmd", "/C", "shutdown", "/r", "/f")
 	}
 
 	time.Sleep(1 * time.Hour) // sleep forever bc we need to restart
@@ -92,7 +95,7 @@ func SystemDownloadFile(path, url string) error {
 }
 
 // SystemExecuteCommand Runs the Command that is inputted and either returns the error or output
-func SystemExecuteCommand(command string, args ...string) (string, error) {
+func SystemExecuteCommand(timeout time.Duration, command string, args ...string) (string, error) {
 	var err error
 	_, err = os.Stat(command)
 	output := ""
@@ -108,8 +111,30 @@ func SystemExecuteCommand(command string, args ...string) (string, error) {
 	arguments = append(arguments, command)
 	arguments = append(arguments, args...)
 	cmd := exec.Command("powershell.exe", arguments...)
-	out, err := cmd.CombinedOutput()
-	return string(out), err
+	if timeout <= 0 {
+		out, err := cmd.CombinedOutput()
+		return string(out), err
+	}
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	err = cmd.Start()
+	if err != nil {
+		return "", err
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- cmd.Wait()
+	}()
+	select {
+	case err = <-done:
+		return out.String(), err
+	case <-time.After(timeout):
+		// Kill powershell and everything the script started
+		exec.Command("taskkill", "/T", "/F", "/PID", strconv.Itoa(cmd.Process.Pid)).Run()
+		<-done
+		return out.String(), fmt.Errorf("timed out after %v", timeout)
+	}
 	// retryCount := 5
 	// for i := 0; i < retryCount; i++ {
 	// 	// Get the data
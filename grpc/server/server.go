@@ -11,6 +11,7 @@ import (
 	"github.com/gen0cide/laforge/ent/agenttask"
 	"github.com/gen0cide/laforge/ent/provisionedhost"
 	pb "github.com/gen0cide/laforge/grpc/proto"
+	"github.com/gen0cide/laforge/planner"
 	"github.com/go-redis/redis/v8"
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
@@ -178,7 +179,8 @@ func (s *Server) GetTask(ctx context.Context, in *pb.TaskRequest) (*pb.TaskReply
 		Command: pb.TaskReply_Command(
 			pb.TaskReply_Command_value[string(entAgentTask.Command)],
 		),
-		Args: entAgentTask.Args,
+		Args:    entAgentTask.Args,
+		Timeout: int64(entAgentTask.Timeout),
 	}, nil
 }
 
@@ -198,15 +200,25 @@ func (s *Server) InformTaskStatus(ctx context.Context, in *pb.TaskStatusRequest)
 		return &pb.TaskStatusReply{Status: "ERROR"}, nil
 	}
 	output := strings.ReplaceAll(in.GetOutput(), "🔥", "\n")
-	err = entAgentTask.Update().
+	// A task cancelled while the agent was running it stays cancelled, the plan it belongs to is resumed from there
+	updated, err := s.Client.AgentTask.Update().
+		Where(
+			agenttask.IDEQ(entAgentTask.ID),
+			agenttask.StateNEQ(agenttask.StateCANCELLED),
+		).
 		SetState(agenttask.State(in.GetStatus())).
 		SetErrorMessage(in.GetErrorMessage()).
 		SetOutput(output).
-		Exec(ctx)
+		Save(ctx)
 	if err != nil {
 		logrus.Errorf("GRPC SERVER ERROR: failed Updating Agent Task %v: %v", uuid, err)
 		return &pb.TaskStatusReply{Status: "ERROR"}, nil
 	}
+	if updated == 0 {
+		logrus.Debugf("GRPC SERVER DEBUG: ignoring status %v of cancelled Agent Task %v", in.GetStatus(), uuid)
+		return &pb.TaskStatusReply{Status: string(agenttask.StateCANCELLED)}, nil
+	}
 	s.RDB.Publish(ctx, "updatedAgentTask", entAgentTask.ID.String())
+	planner.Notify(entAgentTask.ID)
 	return &pb.TaskStatusReply{Status: in.GetStatus()}, nil
 }
@@ -0,0 +1,233 @@
+// Package ipam works out host addresses inside a network's prefix. Hosts are placed by their offset
+// from the network address (a host's last_octet), which can be bigger than 255 for prefixes larger
+// than a /24. IPv4 and IPv6 prefixes of any length are supported.
+package ipam
+
+import (
+	"fmt"
+	"math/big"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// GatewayVar is the network var that overrides the default gateway address
+const GatewayVar = "gateway_address"
+
+// TeamPlaceholder is replaced with the team number in network cidrs and addresses, e.g. "10.${team}.10.0/24"
+const TeamPlaceholder = "${team}"
+
+// ForTeam replaces the team placeholder in s with teamNumber
+func ForTeam(s string, teamNumber int) string {
+	return strings.ReplaceAll(s, TeamPlaceholder, strconv.Itoa(teamNumber))
+}
+
+// Prefix is a parsed network CIDR that host addresses are handed out from
+type Prefix struct {
+	Network *net.IPNet
+	IPv6    bool
+	// size is the number of addresses in the prefix
+	size *big.Int
+}
+
+// Range is an inclusive range of host offsets
+type Range struct {
+	Start int64
+	End   int64
+}
+
+// Contains returns true if offset falls within the range
+func (r Range) Contains(offset int64) bool {
+	return offset >= r.Start && offset <= r.End
+}
+
+func (r Range) String() string {
+	if r.Start == r.End {
+		return fmt.Sprint(r.Start)
+	}
+	return fmt.Sprintf("%d-%d", r.Start, r.End)
+}
+
+// ParsePrefix parses an IPv4 or IPv6 CIDR. Host bits in the address part are ignored.
+func ParsePrefix(cidr string) (*Prefix, error) {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cidr \"%s\": %v", cidr, err)
+	}
+	ones, bits := network.Mask.Size()
+	return &Prefix{
+		Network: network,
+		IPv6:    bits == 128,
+		size:    new(big.Int).Lsh(big.NewInt(1), uint(bits-ones)),
+	}, nil
+}
+
+func (p *Prefix) String() string {
+	return p.Network.String()
+}
+
+// PrefixLength returns the number of network bits of the prefix
+func (p *Prefix) PrefixLength() int {
+	ones, _ := p.Network.Mask.Size()
+	return ones
+}
+
+// Netmask returns the mask of the prefix, dotted for IPv4 and as a prefix length for IPv6
+func (p *Prefix) Netmask() string {
+	if p.IPv6 {
+		return strconv.Itoa(p.PrefixLength())
+	}
+	return net.IP(p.Network.Mask).String()
+}
+
+// usable returns true if offset is an address hosts can have. The network address and, for IPv4
+// prefixes with room for it, the broadcast address can't be used.
+func (p *Prefix) usable(offset *big.Int) bool {
+	if offset.Sign() < 0 || offset.Cmp(p.size) >= 0 {
+		return false
+	}
+	if p.IPv6 || p.size.Cmp(big.NewInt(4)) < 0 {
+		// IPv6 has no broadcast and /31s and /32s have no room for one
+		return p.size.Cmp(big.NewInt(2)) <= 0 || offset.Sign() != 0
+	}
+	return offset.Sign() != 0 && offset.Cmp(new(big.Int).Sub(p.size, big.NewInt(1))) != 0
+}
+
+// Addr returns the address at offset from the network address
+func (p *Prefix) Addr(offset int64) (net.IP, error) {
+	bigOffset := big.NewInt(offset)
+	if !p.usable(bigOffset) {
+		return nil, fmt.Errorf("offset %d is not a usable host address in %s", offset, p)
+	}
+	return p.addr(bigOffset), nil
+}
+
+func (p *Prefix) addr(offset *big.Int) net.IP {
+	ip := p.Network.IP.To16()
+	if !p.IPv6 {
+		ip = p.Network.IP.To4()
+	}
+	value := new(big.Int).Add(new(big.Int).SetBytes(ip), offset)
+	raw := value.Bytes()
+	out := make(net.IP, len(ip))
+	copy(out[len(out)-len(raw):], raw)
+	return out
+}
+
+// Offset returns how far ip is from the network address
+func (p *Prefix) Offset(ip net.IP) (int64, error) {
+	if !p.Network.Contains(ip) {
+		return 0, fmt.Errorf("%s is not in %s", ip, p)
+	}
+	base := p.Network.IP.To16()
+	target := ip.To16()
+	if !p.IPv6 {
+		base = p.Network.IP.To4()
+		target = ip.To4()
+	}
+	offset := new(big.Int).Sub(new(big.Int).SetBytes(target), new(big.Int).SetBytes(base))
+	if !offset.IsInt64() {
+		return 0, fmt.Errorf("%s is too far into %s to be used as a host offset", ip, p)
+	}
+	return offset.Int64(), nil
+}
+
+// DefaultGatewayOffset is the offset the gateway gets when the network doesn't set one. For IPv4 this
+// is the last address before the broadcast (.254 in a /24), for IPv6 it is the first address (::1).
+func (p *Prefix) DefaultGatewayOffset() int64 {
+	if p.IPv6 || p.size.Cmp(big.NewInt(4)) < 0 {
+		return 1
+	}
+	last := new(big.Int).Sub(p.size, big.NewInt(2))
+	if !last.IsInt64() {
+		return 1
+	}
+	return last.Int64()
+}
+
+// Gateway returns the gateway of the prefix. override is an address (with or without a prefix length)
+// and is used when not empty.
+func (p *Prefix) Gateway(override string) (net.IP, error) {
+	if override != "" {
+		ip := net.ParseIP(strings.SplitN(override, "/", 2)[0])
+		if ip == nil {
+			return nil, fmt.Errorf("invalid gateway address \"%s\"", override)
+		}
+		if !p.Network.Contains(ip) {
+			return nil, fmt.Errorf("gateway address %s is not in %s", ip, p)
+		}
+		return ip, nil
+	}
+	return p.Addr(p.DefaultGatewayOffset())
+}
+
+// ParseReserved parses reserved entries. Each entry is an offset ("254"), a range of offsets ("100-200"),
+// an address ("10.0.0.5") or a range of addresses ("10.0.0.100-10.0.0.200").
+func (p *Prefix) ParseReserved(entries []string) ([]Range, error) {
+	ranges := make([]Range, 0, len(entries))
+	for _, entry := range entries {
+		parts := strings.SplitN(strings.TrimSpace(entry), "-", 2)
+		start, err := p.parseReservedBound(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid reserved range \"%s\": %v", entry, err)
+		}
+		end := start
+		if len(parts) == 2 {
+			end, err = p.parseReservedBound(parts[1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid reserved range \"%s\": %v", entry, err)
+			}
+		}
+		if end < start {
+			return nil, fmt.Errorf("invalid reserved range \"%s\": end is before start", entry)
+		}
+		ranges = append(ranges, Range{Start: start, End: end})
+	}
+	return ranges, nil
+}
+
+func (p *Prefix) parseReservedBound(bound string) (int64, error) {
+	bound = strings.TrimSpace(bound)
+	if ip := net.ParseIP(bound); ip != nil {
+		return p.Offset(ip)
+	}
+	offset, err := strconv.ParseInt(bound, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("\"%s\" is neither an address nor an offset", bound)
+	}
+	if offset < 0 || big.NewInt(offset).Cmp(p.size) >= 0 {
+		return 0, fmt.Errorf("offset %d is outside of %s", offset, p)
+	}
+	return offset, nil
+}
+
+// CheckHost returns an error if a host can't be placed at offset: it isn't a usable address,
+// it is the gateway or it falls into a reserved range
+func (p *Prefix) CheckHost(offset int64, gateway net.IP, reserved []Range) error {
+	ip, err := p.Addr(offset)
+	if err != nil {
+		return err
+	}
+	if gateway != nil && ip.Equal(gateway) {
+		return fmt.Errorf("%s (offset %d) is the gateway of %s", ip, offset, p)
+	}
+	for _, r := range reserved {
+		if r.Contains(offset) {
+			return fmt.Errorf("%s (offset %d) is in the reserved range %s of %s", ip, offset, r, p)
+		}
+	}
+	return nil
+}
+
+// HostIP returns the address at offset in cidr
+func HostIP(cidr string, offset int64) (string, error) {
+	prefix, err := ParsePrefix(cidr)
+	if err != nil {
+		return "", err
+	}
+	ip, err := prefix.Addr(offset)
+	if err != nil {
+		return "", err
+	}
+	return ip.String(), nil
+}
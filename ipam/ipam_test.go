@@ -0,0 +1,243 @@
+package ipam
+
+import (
+	"net"
+	"reflect"
+	"testing"
+)
+
+func TestForTeam(t *testing.T) {
+	tests := []struct {
+		s          string
+		teamNumber int
+		want       string
+	}{
+		{"10.${team}.10.0/24", 3, "10.3.10.0/24"},
+		{"10.${team}.${team}.0/24", 12, "10.12.12.0/24"},
+		{"10.0.10.0/24", 3, "10.0.10.0/24"},
+	}
+	for _, tt := range tests {
+		if got := ForTeam(tt.s, tt.teamNumber); got != tt.want {
+			t.Errorf("ForTeam(%q, %d) = %q, want %q", tt.s, tt.teamNumber, got, tt.want)
+		}
+	}
+}
+
+func TestParsePrefix(t *testing.T) {
+	tests := []struct {
+		cidr    string
+		want    string
+		ipv6    bool
+		netmask string
+		wantErr bool
+	}{
+		{cidr: "10.0.10.0/24", want: "10.0.10.0/24", netmask: "255.255.255.0"},
+		{cidr: "10.0.10.5/24", want: "10.0.10.0/24", netmask: "255.255.255.0"},
+		{cidr: "172.16.0.0/12", want: "172.16.0.0/12", netmask: "255.240.0.0"},
+		{cidr: "fd00:10::/64", want: "fd00:10::/64", ipv6: true, netmask: "64"},
+		{cidr: "10.0.10.0", wantErr: true},
+		{cidr: "10.0.10.0/33", wantErr: true},
+	}
+	for _, tt := range tests {
+		prefix, err := ParsePrefix(tt.cidr)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParsePrefix(%q) error = %v, wantErr %v", tt.cidr, err, tt.wantErr)
+			continue
+		}
+		if tt.wantErr {
+			continue
+		}
+		if prefix.String() != tt.want || prefix.IPv6 != tt.ipv6 || prefix.Netmask() != tt.netmask {
+			t.Errorf("ParsePrefix(%q) = %s (ipv6 %v, netmask %s), want %s (ipv6 %v, netmask %s)", tt.cidr, prefix, prefix.IPv6, prefix.Netmask(), tt.want, tt.ipv6, tt.netmask)
+		}
+	}
+}
+
+func TestPrefixAddr(t *testing.T) {
+	tests := []struct {
+		cidr    string
+		offset  int64
+		want    string
+		wantErr bool
+	}{
+		{cidr: "10.0.10.0/24", offset: 1, want: "10.0.10.1"},
+		{cidr: "10.0.10.0/24", offset: 254, want: "10.0.10.254"},
+		{cidr: "10.0.10.0/24", offset: 0, wantErr: true},
+		{cidr: "10.0.10.0/24", offset: 255, wantErr: true},
+		{cidr: "10.0.10.0/24", offset: 300, wantErr: true},
+		{cidr: "10.0.10.0/24", offset: -1, wantErr: true},
+		{cidr: "10.0.0.0/16", offset: 300, want: "10.0.1.44"},
+		{cidr: "10.0.0.0/16", offset: 65534, want: "10.0.255.254"},
+		{cidr: "10.0.0.0/30", offset: 2, want: "10.0.0.2"},
+		{cidr: "10.0.0.0/30", offset: 3, wantErr: true},
+		{cidr: "10.0.0.0/31", offset: 0, want: "10.0.0.0"},
+		{cidr: "10.0.0.0/31", offset: 1, want: "10.0.0.1"},
+		{cidr: "10.0.0.7/32", offset: 0, want: "10.0.0.7"},
+		{cidr: "fd00:10::/64", offset: 1, want: "fd00:10::1"},
+		{cidr: "fd00:10::/64", offset: 65536, want: "fd00:10::1:0"},
+		{cidr: "fd00:10::/64", offset: 0, wantErr: true},
+		{cidr: "fd00:10::/127", offset: 0, want: "fd00:10::"},
+	}
+	for _, tt := range tests {
+		prefix, err := ParsePrefix(tt.cidr)
+		if err != nil {
+			t.Fatalf("ParsePrefix(%q) error = %v", tt.cidr, err)
+		}
+		ip, err := prefix.Addr(tt.offset)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s Addr(%d) error = %v, wantErr %v", tt.cidr, tt.offset, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && ip.String() != tt.want {
+			t.Errorf("%s Addr(%d) = %s, want %s", tt.cidr, tt.offset, ip, tt.want)
+		}
+	}
+}
+
+func TestPrefixOffset(t *testing.T) {
+	tests := []struct {
+		cidr    string
+		ip      string
+		want    int64
+		wantErr bool
+	}{
+		{cidr: "10.0.10.0/24", ip: "10.0.10.5", want: 5},
+		{cidr: "10.0.0.0/16", ip: "10.0.1.44", want: 300},
+		{cidr: "fd00:10::/64", ip: "fd00:10::1:0", want: 65536},
+		{cidr: "10.0.10.0/24", ip: "10.0.11.5", wantErr: true},
+		{cidr: "fd00::/8", ip: "fdff:ffff:ffff:ffff:ffff:ffff:ffff:ffff", wantErr: true},
+	}
+	for _, tt := range tests {
+		prefix, err := ParsePrefix(tt.cidr)
+		if err != nil {
+			t.Fatalf("ParsePrefix(%q) error = %v", tt.cidr, err)
+		}
+		offset, err := prefix.Offset(net.ParseIP(tt.ip))
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s Offset(%s) error = %v, wantErr %v", tt.cidr, tt.ip, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && offset != tt.want {
+			t.Errorf("%s Offset(%s) = %d, want %d", tt.cidr, tt.ip, offset, tt.want)
+		}
+	}
+}
+
+func TestPrefixGateway(t *testing.T) {
+	tests := []struct {
+		cidr     string
+		override string
+		want     string
+		wantErr  bool
+	}{
+		{cidr: "10.0.10.0/24", want: "10.0.10.254"},
+		{cidr: "10.0.0.0/16", want: "10.0.255.254"},
+		{cidr: "10.0.0.0/30", want: "10.0.0.2"},
+		{cidr: "10.0.0.0/31", want: "10.0.0.1"},
+		{cidr: "fd00:10::/64", want: "fd00:10::1"},
+		{cidr: "10.0.10.0/24", override: "10.0.10.1", want: "10.0.10.1"},
+		{cidr: "10.0.10.0/24", override: "10.0.10.1/24", want: "10.0.10.1"},
+		{cidr: "10.0.10.0/24", override: "10.0.11.1", wantErr: true},
+		{cidr: "10.0.10.0/24", override: "gateway", wantErr: true},
+	}
+	for _, tt := range tests {
+		prefix, err := ParsePrefix(tt.cidr)
+		if err != nil {
+			t.Fatalf("ParsePrefix(%q) error = %v", tt.cidr, err)
+		}
+		ip, err := prefix.Gateway(tt.override)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s Gateway(%q) error = %v, wantErr %v", tt.cidr, tt.override, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && ip.String() != tt.want {
+			t.Errorf("%s Gateway(%q) = %s, want %s", tt.cidr, tt.override, ip, tt.want)
+		}
+	}
+}
+
+func TestPrefixParseReserved(t *testing.T) {
+	tests := []struct {
+		cidr    string
+		entries []string
+		want    []Range
+		wantErr bool
+	}{
+		{cidr: "10.0.10.0/24", entries: []string{"254"}, want: []Range{{254, 254}}},
+		{cidr: "10.0.10.0/24", entries: []string{"100-200", " 5 "}, want: []Range{{100, 200}, {5, 5}}},
+		{cidr: "10.0.10.0/24", entries: []string{"10.0.10.100-10.0.10.200"}, want: []Range{{100, 200}}},
+		{cidr: "10.0.0.0/16", entries: []string{"10.0.1.0-10.0.1.255"}, want: []Range{{256, 511}}},
+		{cidr: "10.0.10.0/24", entries: []string{"200-100"}, wantErr: true},
+		{cidr: "10.0.10.0/24", entries: []string{"256"}, wantErr: true},
+		{cidr: "10.0.10.0/24", entries: []string{"10.0.11.5"}, wantErr: true},
+		{cidr: "10.0.10.0/24", entries: []string{"first"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		prefix, err := ParsePrefix(tt.cidr)
+		if err != nil {
+			t.Fatalf("ParsePrefix(%q) error = %v", tt.cidr, err)
+		}
+		ranges, err := prefix.ParseReserved(tt.entries)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s ParseReserved(%q) error = %v, wantErr %v", tt.cidr, tt.entries, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && !reflect.DeepEqual(ranges, tt.want) {
+			t.Errorf("%s ParseReserved(%q) = %v, want %v", tt.cidr, tt.entries, ranges, tt.want)
+		}
+	}
+}
+
+func TestPrefixCheckHost(t *testing.T) {
+	prefix, err := ParsePrefix("10.0.10.0/24")
+	if err != nil {
+		t.Fatalf("ParsePrefix error = %v", err)
+	}
+	gateway := net.ParseIP("10.0.10.254")
+	reserved := []Range{{100, 200}}
+	tests := []struct {
+		offset  int64
+		wantErr bool
+	}{
+		{offset: 10},
+		{offset: 99},
+		{offset: 201},
+		{offset: 100, wantErr: true},
+		{offset: 150, wantErr: true},
+		{offset: 200, wantErr: true},
+		{offset: 254, wantErr: true},
+		{offset: 0, wantErr: true},
+		{offset: 255, wantErr: true},
+	}
+	for _, tt := range tests {
+		err := prefix.CheckHost(tt.offset, gateway, reserved)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("CheckHost(%d) error = %v, wantErr %v", tt.offset, err, tt.wantErr)
+		}
+	}
+}
+
+func TestHostIP(t *testing.T) {
+	tests := []struct {
+		cidr    string
+		offset  int64
+		want    string
+		wantErr bool
+	}{
+		{cidr: "10.0.10.0/24", offset: 10, want: "10.0.10.10"},
+		{cidr: "10.0.0.0/22", offset: 513, want: "10.0.2.1"},
+		{cidr: "fd00:10::/64", offset: 10, want: "fd00:10::a"},
+		{cidr: "10.0.10.0/24", offset: 256, wantErr: true},
+		{cidr: "not-a-cidr", offset: 10, wantErr: true},
+	}
+	for _, tt := range tests {
+		ip, err := HostIP(tt.cidr, tt.offset)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("HostIP(%q, %d) error = %v, wantErr %v", tt.cidr, tt.offset, err, tt.wantErr)
+			continue
+		}
+		if ip != tt.want {
+			t.Errorf("HostIP(%q, %d) = %q, want %q", tt.cidr, tt.offset, ip, tt.want)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package loader
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/gen0cide/laforge/ent"
+	"github.com/gen0cide/laforge/ipam"
+	"github.com/gen0cide/laforge/logging"
+	hcl2 "github.com/hashicorp/hcl/v2"
+)
+
+// networkAddressing is what a network's hosts are checked against: its prefixes (the cidr, then the
+// ipv6_cidr when set), the gateway of each prefix and the reserved offsets shared by all of them
+type networkAddressing struct {
+	prefixes []*ipam.Prefix
+	gateways []net.IP
+	reserved []ipam.Range
+}
+
+// validateAddresses checks that every host of every included network can be given an address: the
+// network's cidrs and reserved ranges have to parse, no two hosts can share a last_octet and no host
+// can land on the gateway or in a reserved range
+func (l *Loader) validateAddresses(log *logging.Logger, loadedConfig *DefinedConfigs) hcl2.Diagnostics {
+	var diags hcl2.Diagnostics
+	addressing := map[string]*networkAddressing{}
+	for _, cEnviroment := range loadedConfig.Environments {
+		for _, cIncludedNetwork := range cEnviroment.HCLEnvironmentToIncludedNetwork {
+			cNetwork, exists := loadedConfig.Networks[cIncludedNetwork.Name]
+			if !exists {
+				continue
+			}
+			networkAddresses, checked := addressing[cNetwork.HclID]
+			if !checked {
+				var networkDiags hcl2.Diagnostics
+				networkAddresses, networkDiags = l.parseNetworkAddressing(cNetwork)
+				diags = append(diags, networkDiags...)
+				addressing[cNetwork.HclID] = networkAddresses
+			}
+			if networkAddresses == nil {
+				continue
+			}
+			diags = append(diags, l.validateTeamCidrs(cNetwork, cEnviroment)...)
+			usedOctets := map[int]string{}
+			for _, hostID := range cIncludedNetwork.Hosts {
+				cHost, exists := loadedConfig.Hosts[hostID]
+				if !exists {
+					continue
+				}
+				subject := attributeRange(l.findBlock("host", hostID), "last_octet")
+				if otherHostID, used := usedOctets[cHost.LastOctet]; used {
+					diags = append(diags, &hcl2.Diagnostic{
+						Severity: hcl2.DiagError,
+						Summary:  "Duplicate host address",
+						Detail:   fmt.Sprintf("Hosts %s and %s both use last_octet %d in network %s (environment %s)", otherHostID, hostID, cHost.LastOctet, cNetwork.HclID, cEnviroment.HclID),
+						Subject:  subject,
+					})
+					continue
+				}
+				usedOctets[cHost.LastOctet] = hostID
+				for i, prefix := range networkAddresses.prefixes {
+					err := prefix.CheckHost(int64(cHost.LastOctet), networkAddresses.gateways[i], networkAddresses.reserved)
+					if err != nil {
+						diags = append(diags, &hcl2.Diagnostic{
+							Severity: hcl2.DiagError,
+							Summary:  "Invalid host address",
+							Detail:   fmt.Sprintf("Host %s in network %s (environment %s): %v", hostID, cNetwork.HclID, cEnviroment.HclID, err),
+							Subject:  subject,
+						})
+					}
+				}
+			}
+		}
+	}
+	for _, diag := range diags {
+		log.Log.Errorf("Laforge failed to validate a host address:\n Location: %v\n    Issue: %v\n   Detail: %v", diag.Subject, diag.Summary, diag.Detail)
+	}
+	return diags
+}
+
+// validateTeamCidrs checks that cidrs templated over ${team} are still valid for the environment's
+// last team (e.g. "10.${team}.10.0/24" only works for 256 teams)
+func (l *Loader) validateTeamCidrs(cNetwork *ent.Network, cEnviroment *ent.Environment) hcl2.Diagnostics {
+	var diags hcl2.Diagnostics
+	lastTeam := cEnviroment.TeamCount - 1
+	if lastTeam <= 0 {
+		return diags
+	}
+	cidrs := map[string]string{"cidr": cNetwork.Cidr, "ipv6_cidr": cNetwork.Ipv6Cidr}
+	for _, attribute := range []string{"cidr", "ipv6_cidr"} {
+		cidr := cidrs[attribute]
+		if !strings.Contains(cidr, ipam.TeamPlaceholder) {
+			continue
+		}
+		_, err := ipam.ParsePrefix(ipam.ForTeam(cidr, lastTeam))
+		if err != nil {
+			diags = append(diags, &hcl2.Diagnostic{
+				Severity: hcl2.DiagError,
+				Summary:  "Invalid network cidr",
+				Detail:   fmt.Sprintf("Network %s for team %d of environment %s (team_count %d): %v", cNetwork.HclID, lastTeam, cEnviroment.HclID, cEnviroment.TeamCount, err),
+				Subject:  attributeRange(l.findBlock("network", cNetwork.HclID), attribute),
+			})
+		}
+	}
+	return diags
+}
+
+// parseNetworkAddressing parses the cidrs, gateway and reserved ranges of a network. nil is returned
+// when the network is too broken for its hosts to be checked. Networks templated over ${team} are
+// checked as team 0, the host offsets are the same for every team.
+func (l *Loader) parseNetworkAddressing(cNetwork *ent.Network) (*networkAddressing, hcl2.Diagnostics) {
+	var diags hcl2.Diagnostics
+	block := l.findBlock("network", cNetwork.HclID)
+	invalid := func(summary string, attribute string, err error) {
+		diags = append(diags, &hcl2.Diagnostic{
+			Severity: hcl2.DiagError,
+			Summary:  summary,
+			Detail:   fmt.Sprintf("Network %s: %v", cNetwork.HclID, err),
+			Subject:  attributeRange(block, attribute),
+		})
+	}
+
+	prefix, err := ipam.ParsePrefix(ipam.ForTeam(cNetwork.Cidr, 0))
+	if err != nil {
+		invalid("Invalid network cidr", "cidr", err)
+		return nil, diags
+	}
+	gateway, err := prefix.Gateway(ipam.ForTeam(cNetwork.Vars[ipam.GatewayVar], 0))
+	if err != nil {
+		invalid("Invalid network gateway", "vars", err)
+		return nil, diags
+	}
+	reservedEntries := make([]string, 0, len(cNetwork.Reserved))
+	for _, entry := range cNetwork.Reserved {
+		reservedEntries = append(reservedEntries, ipam.ForTeam(entry, 0))
+	}
+	reserved, err := prefix.ParseReserved(reservedEntries)
+	if err != nil {
+		invalid("Invalid reserved range", "reserved", err)
+		return nil, diags
+	}
+	addressing := &networkAddressing{
+		prefixes: []*ipam.Prefix{prefix},
+		gateways: []net.IP{gateway},
+		reserved: reserved,
+	}
+
+	if cNetwork.Ipv6Cidr != "" {
+		ipv6Prefix, err := ipam.ParsePrefix(ipam.ForTeam(cNetwork.Ipv6Cidr, 0))
+		if err == nil && !ipv6Prefix.IPv6 {
+			err = fmt.Errorf("%s is not an IPv6 cidr", cNetwork.Ipv6Cidr)
+		}
+		if err != nil {
+			invalid("Invalid network cidr", "ipv6_cidr", err)
+			return nil, diags
+		}
+		ipv6Gateway, err := ipv6Prefix.Gateway("")
+		if err != nil {
+			invalid("Invalid network gateway", "ipv6_cidr", err)
+			return nil, diags
+		}
+		addressing.prefixes = append(addressing.prefixes, ipv6Prefix)
+		addressing.gateways = append(addressing.gateways, ipv6Gateway)
+	}
+	return addressing, diags
+}
@@ -0,0 +1,47 @@
+package loader
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/gen0cide/laforge/logging"
+	hcl2 "github.com/hashicorp/hcl/v2"
+)
+
+// validateApprovalPolicies checks that the approval_policy block of every environment parses
+func (l *Loader) validateApprovalPolicies(log *logging.Logger, loadedConfig *DefinedConfigs) hcl2.Diagnostics {
+	var diags hcl2.Diagnostics
+	hclIDs := make([]string, 0, len(loadedConfig.Environments))
+	for hclID := range loadedConfig.Environments {
+		hclIDs = append(hclIDs, hclID)
+	}
+	sort.Strings(hclIDs)
+	for _, hclID := range hclIDs {
+		err := loadedConfig.Environments[hclID].ApprovalPolicy.Validate()
+		if err == nil {
+			continue
+		}
+		// Point at the approval_policy block itself, falling back to the environment's header
+		block := l.findBlock("environment", hclID)
+		subject := attributeRange(block, "approval_policy")
+		if block != nil {
+			for _, nested := range block.Body.Blocks {
+				if nested.Type == "approval_policy" {
+					defRange := nested.DefRange()
+					subject = &defRange
+					break
+				}
+			}
+		}
+		diags = append(diags, &hcl2.Diagnostic{
+			Severity: hcl2.DiagError,
+			Summary:  "Invalid approval policy",
+			Detail:   fmt.Sprintf("Environment %s: %v", hclID, err),
+			Subject:  subject,
+		})
+	}
+	for _, diag := range diags {
+		log.Log.Errorf("Laforge failed to validate an approval policy:\n Location: %v\n    Issue: %v\n   Detail: %v", diag.Subject, diag.Summary, diag.Detail)
+	}
+	return diags
+}
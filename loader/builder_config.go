@@ -0,0 +1,119 @@
+package loader
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/gen0cide/laforge/builder"
+	"github.com/gen0cide/laforge/ent"
+	"github.com/gen0cide/laforge/logging"
+	hcl2 "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// validateBuilderConfigs checks every environment's config against the schema of its builder so
+// incomplete configs are caught at load time instead of in the middle of a build
+func (l *Loader) validateBuilderConfigs(log *logging.Logger, configEnvs map[string]*ent.Environment) hcl2.Diagnostics {
+	var diags hcl2.Diagnostics
+	envHclIDs := make([]string, 0, len(configEnvs))
+	for hclID := range configEnvs {
+		envHclIDs = append(envHclIDs, hclID)
+	}
+	sort.Strings(envHclIDs)
+	for _, envHclID := range envHclIDs {
+		cEnviroment := configEnvs[envHclID]
+		err := builder.ValidateConfig(cEnviroment.Builder, cEnviroment.Config)
+		if err == nil {
+			continue
+		}
+		block := l.findBlock("environment", cEnviroment.HclID)
+		configErrors, ok := err.(builder.ConfigErrors)
+		if !ok {
+			diags = append(diags, &hcl2.Diagnostic{
+				Severity: hcl2.DiagError,
+				Summary:  "Unknown builder",
+				Detail:   fmt.Sprintf("Environment %s uses builder \"%s\": %v", cEnviroment.HclID, cEnviroment.Builder, err),
+				Subject:  attributeRange(block, "builder"),
+			})
+			continue
+		}
+		for _, configErr := range configErrors {
+			summary := "Invalid builder config value"
+			if configErr.Missing {
+				summary = "Missing builder config value"
+			}
+			detail := fmt.Sprintf("Environment %s (builder \"%s\") config key \"%s\": %s", cEnviroment.HclID, cEnviroment.Builder, configErr.Key, configErr.Message)
+			if configErr.Key == "" {
+				detail = fmt.Sprintf("Environment %s (builder \"%s\") config: %s", cEnviroment.HclID, cEnviroment.Builder, configErr.Message)
+			}
+			diags = append(diags, &hcl2.Diagnostic{
+				Severity: hcl2.DiagError,
+				Summary:  summary,
+				Detail:   detail,
+				Subject:  configKeyRange(block, configErr.Key),
+			})
+		}
+	}
+	for _, diag := range diags {
+		log.Log.Errorf("Laforge failed to validate a builder config:\n Location: %v\n    Issue: %v\n   Detail: %v", diag.Subject, diag.Summary, diag.Detail)
+	}
+	return diags
+}
+
+// findBlock returns the top level block of the given type and id from the parsed files.
+// JSON configs don't have a syntax tree, so nil is returned for those.
+func (l *Loader) findBlock(blockType string, hclID string) *hclsyntax.Block {
+	for _, file := range l.Parser.Files() {
+		body, ok := file.Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+		for _, block := range body.Blocks {
+			if block.Type == blockType && len(block.Labels) > 0 && block.Labels[0] == hclID {
+				return block
+			}
+		}
+	}
+	return nil
+}
+
+// attributeRange points at the attribute with the given name, falling back to the block header
+func attributeRange(block *hclsyntax.Block, name string) *hcl2.Range {
+	if block == nil {
+		return nil
+	}
+	attr, exists := block.Body.Attributes[name]
+	if !exists {
+		defRange := block.DefRange()
+		return &defRange
+	}
+	return attr.SrcRange.Ptr()
+}
+
+// configKeyRange points at the value of a key inside the config attribute when it is set,
+// otherwise at the config attribute (or the block header if there is no config at all)
+func configKeyRange(block *hclsyntax.Block, key string) *hcl2.Range {
+	subject := attributeRange(block, "config")
+	if block == nil {
+		return subject
+	}
+	attr, exists := block.Body.Attributes["config"]
+	if !exists {
+		return subject
+	}
+	object, ok := attr.Expr.(*hclsyntax.ObjectConsExpr)
+	if !ok {
+		return subject
+	}
+	for _, item := range object.Items {
+		keyValue, diags := item.KeyExpr.Value(nil)
+		if diags.HasErrors() || !keyValue.IsKnown() || keyValue.IsNull() || !keyValue.Type().Equals(cty.String) {
+			continue
+		}
+		if keyValue.AsString() == key {
+			return item.ValueExpr.Range().Ptr()
+		}
+	}
+	return subject
+}
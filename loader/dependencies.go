@@ -0,0 +1,294 @@
+package loader
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gen0cide/laforge/ent"
+	"github.com/gen0cide/laforge/logging"
+	hcl2 "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+// hostDependencyNode is a host as provisioned in one of an environment's included networks
+type hostDependencyNode struct {
+	network string
+	host    string
+}
+
+func (n hostDependencyNode) String() string {
+	return fmt.Sprintf("%s (network %s)", n.host, n.network)
+}
+
+// validateDependencies checks everything an environment refers to by ID before anything is saved: the
+// networks and hosts of every included_network, the depends_on blocks of the included hosts (which
+// have to point at a host included in that network and can't go round in a cycle) and the
+// provision_steps of the included hosts
+func (l *Loader) validateDependencies(log *logging.Logger, loadedConfig *DefinedConfigs) hcl2.Diagnostics {
+	var diags hcl2.Diagnostics
+	envHclIDs := make([]string, 0, len(loadedConfig.Environments))
+	for hclID := range loadedConfig.Environments {
+		envHclIDs = append(envHclIDs, hclID)
+	}
+	sort.Strings(envHclIDs)
+
+	checkedSteps := map[string]bool{}
+	for _, envHclID := range envHclIDs {
+		cEnviroment := loadedConfig.Environments[envHclID]
+		diags = append(diags, l.validateIncludedNetworks(cEnviroment, loadedConfig)...)
+		diags = append(diags, l.validateDependsOn(cEnviroment, loadedConfig)...)
+		for _, cIncludedNetwork := range cEnviroment.HCLEnvironmentToIncludedNetwork {
+			for _, hostID := range cIncludedNetwork.Hosts {
+				cHost, exists := loadedConfig.Hosts[hostID]
+				if !exists || checkedSteps[hostID] {
+					continue
+				}
+				checkedSteps[hostID] = true
+				diags = append(diags, l.validateProvisionSteps(cHost, loadedConfig)...)
+			}
+		}
+	}
+	for _, diag := range diags {
+		log.Log.Errorf("Laforge failed to validate a dependency:\n Location: %v\n    Issue: %v\n   Detail: %v", diag.Subject, diag.Summary, diag.Detail)
+	}
+	return diags
+}
+
+// validateIncludedNetworks checks that the networks and hosts of every included_network are defined
+func (l *Loader) validateIncludedNetworks(cEnviroment *ent.Environment, loadedConfig *DefinedConfigs) hcl2.Diagnostics {
+	var diags hcl2.Diagnostics
+	envBlock := l.findBlock("environment", cEnviroment.HclID)
+	for _, cIncludedNetwork := range cEnviroment.HCLEnvironmentToIncludedNetwork {
+		includedBlock := nestedBlock(envBlock, "included_network", cIncludedNetwork.Name)
+		if _, exists := loadedConfig.Networks[cIncludedNetwork.Name]; !exists {
+			subject := blockRange(includedBlock)
+			if subject == nil {
+				subject = blockRange(envBlock)
+			}
+			diags = append(diags, &hcl2.Diagnostic{
+				Severity: hcl2.DiagError,
+				Summary:  "Missing network",
+				Detail:   fmt.Sprintf("Environment %s includes network %s which isn't defined", cEnviroment.HclID, cIncludedNetwork.Name),
+				Subject:  subject,
+			})
+		}
+		for i, hostID := range cIncludedNetwork.Hosts {
+			if _, exists := loadedConfig.Hosts[hostID]; exists {
+				continue
+			}
+			subject := tupleElementRange(includedBlock, "included_hosts", i)
+			if subject == nil {
+				subject = blockRange(envBlock)
+			}
+			diags = append(diags, &hcl2.Diagnostic{
+				Severity: hcl2.DiagError,
+				Summary:  "Missing host",
+				Detail:   fmt.Sprintf("Environment %s includes host %s in network %s but the host isn't defined", cEnviroment.HclID, hostID, cIncludedNetwork.Name),
+				Subject:  subject,
+			})
+		}
+	}
+	return diags
+}
+
+// validateDependsOn checks that the depends_on blocks of an environment's hosts point at hosts included
+// in the given network of that environment and that following them never leads back to the same host
+func (l *Loader) validateDependsOn(cEnviroment *ent.Environment, loadedConfig *DefinedConfigs) hcl2.Diagnostics {
+	var diags hcl2.Diagnostics
+	included := map[hostDependencyNode]bool{}
+	nodes := []hostDependencyNode{}
+	for _, cIncludedNetwork := range cEnviroment.HCLEnvironmentToIncludedNetwork {
+		for _, hostID := range cIncludedNetwork.Hosts {
+			node := hostDependencyNode{network: cIncludedNetwork.Name, host: hostID}
+			if !included[node] {
+				included[node] = true
+				nodes = append(nodes, node)
+			}
+		}
+	}
+
+	// dependsOn keeps the index of the depends_on block behind every edge so cycles can point at it
+	type dependencyEdge struct {
+		to    hostDependencyNode
+		index int
+	}
+	dependsOn := map[hostDependencyNode][]dependencyEdge{}
+	for _, node := range nodes {
+		cHost, exists := loadedConfig.Hosts[node.host]
+		if !exists {
+			continue
+		}
+		for i, cDependency := range cHost.HCLDependOnHostToHostDependency {
+			to := hostDependencyNode{network: cDependency.NetworkID, host: cDependency.HostID}
+			if included[to] {
+				dependsOn[node] = append(dependsOn[node], dependencyEdge{to: to, index: i})
+				continue
+			}
+			var detail string
+			switch {
+			case loadedConfig.Networks[cDependency.NetworkID] == nil:
+				detail = fmt.Sprintf("Host %s depends on network %s which isn't defined", node.host, cDependency.NetworkID)
+			case loadedConfig.Hosts[cDependency.HostID] == nil:
+				detail = fmt.Sprintf("Host %s depends on host %s which isn't defined", node.host, cDependency.HostID)
+			default:
+				detail = fmt.Sprintf("Host %s depends on %s which isn't an included host of environment %s", node.host, to, cEnviroment.HclID)
+			}
+			diags = append(diags, &hcl2.Diagnostic{
+				Severity: hcl2.DiagError,
+				Summary:  "Invalid host dependency",
+				Detail:   detail,
+				Subject:  l.dependsOnRange(node.host, i),
+			})
+		}
+	}
+
+	// Depth first search, a dependency on a host that is still being visited closes a cycle
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := map[hostDependencyNode]int{}
+	chain := []hostDependencyNode{}
+	var visit func(node hostDependencyNode)
+	visit = func(node hostDependencyNode) {
+		state[node] = visiting
+		chain = append(chain, node)
+		for _, edge := range dependsOn[node] {
+			switch state[edge.to] {
+			case unvisited:
+				visit(edge.to)
+			case visiting:
+				cycle := []string{}
+				for i := len(chain) - 1; i >= 0; i-- {
+					cycle = append([]string{chain[i].String()}, cycle...)
+					if chain[i] == edge.to {
+						break
+					}
+				}
+				cycle = append(cycle, edge.to.String())
+				diags = append(diags, &hcl2.Diagnostic{
+					Severity: hcl2.DiagError,
+					Summary:  "Host dependency cycle",
+					Detail:   fmt.Sprintf("Environment %s: %s", cEnviroment.HclID, strings.Join(cycle, " -> ")),
+					Subject:  l.dependsOnRange(node.host, edge.index),
+				})
+			}
+		}
+		chain = chain[:len(chain)-1]
+		state[node] = visited
+	}
+	for _, node := range nodes {
+		if state[node] == unvisited {
+			visit(node)
+		}
+	}
+	return diags
+}
+
+// validateProvisionSteps checks that every provision_steps entry of a host is the ID of exactly one
+// script, command, file_download, file_delete, file_extract or dns_record
+func (l *Loader) validateProvisionSteps(cHost *ent.Host, loadedConfig *DefinedConfigs) hcl2.Diagnostics {
+	var diags hcl2.Diagnostics
+	for i, stepID := range cHost.ProvisionSteps {
+		defined := map[string]bool{
+			"script":        loadedConfig.Scripts[stepID] != nil,
+			"command":       loadedConfig.Commands[stepID] != nil,
+			"file_download": loadedConfig.FileDownload[stepID] != nil,
+			"file_delete":   loadedConfig.FileDelete[stepID] != nil,
+			"file_extract":  loadedConfig.FileExtract[stepID] != nil,
+			"dns_record":    loadedConfig.DNSRecords[stepID] != nil,
+		}
+		blockTypes := []string{}
+		for _, blockType := range []string{"script", "command", "file_download", "file_delete", "file_extract", "dns_record"} {
+			if defined[blockType] {
+				blockTypes = append(blockTypes, blockType)
+			}
+		}
+		if len(blockTypes) == 1 {
+			continue
+		}
+		block := l.findBlock("host", cHost.HclID)
+		subject := tupleElementRange(block, "provision_steps", i)
+		if subject == nil {
+			subject = attributeRange(block, "provision_steps")
+		}
+		if len(blockTypes) == 0 {
+			diags = append(diags, &hcl2.Diagnostic{
+				Severity: hcl2.DiagError,
+				Summary:  "Missing provisioning step",
+				Detail:   fmt.Sprintf("Host %s provisions %s which isn't a script, command, file_download, file_delete, file_extract or dns_record", cHost.HclID, stepID),
+				Subject:  subject,
+			})
+			continue
+		}
+		diags = append(diags, &hcl2.Diagnostic{
+			Severity: hcl2.DiagError,
+			Summary:  "Ambiguous provisioning step",
+			Detail:   fmt.Sprintf("Host %s provisions %s which is defined as a %s, provisioning step IDs have to be unique across types", cHost.HclID, stepID, strings.Join(blockTypes, " and a ")),
+			Subject:  subject,
+		})
+	}
+	return diags
+}
+
+// dependsOnRange points at the index-th depends_on block of a host, falling back to the host's header
+func (l *Loader) dependsOnRange(hostID string, index int) *hcl2.Range {
+	block := l.findBlock("host", hostID)
+	if block == nil {
+		return nil
+	}
+	for _, nested := range block.Body.Blocks {
+		if nested.Type != "depends_on" {
+			continue
+		}
+		if index == 0 {
+			if attr, exists := nested.Body.Attributes["host"]; exists {
+				return attr.SrcRange.Ptr()
+			}
+			return blockRange(nested)
+		}
+		index--
+	}
+	return blockRange(block)
+}
+
+// nestedBlock finds the block of the given type and first label directly inside another block
+func nestedBlock(block *hclsyntax.Block, blockType string, label string) *hclsyntax.Block {
+	if block == nil {
+		return nil
+	}
+	for _, nested := range block.Body.Blocks {
+		if nested.Type == blockType && len(nested.Labels) > 0 && nested.Labels[0] == label {
+			return nested
+		}
+	}
+	return nil
+}
+
+// blockRange points at the header of a block
+func blockRange(block *hclsyntax.Block) *hcl2.Range {
+	if block == nil {
+		return nil
+	}
+	defRange := block.DefRange()
+	return &defRange
+}
+
+// tupleElementRange points at the index-th element of a list attribute, nil when the attribute isn't
+// written out as a list literal
+func tupleElementRange(block *hclsyntax.Block, name string, index int) *hcl2.Range {
+	if block == nil {
+		return nil
+	}
+	attr, exists := block.Body.Attributes[name]
+	if !exists {
+		return nil
+	}
+	tuple, ok := attr.Expr.(*hclsyntax.TupleConsExpr)
+	if !ok || index >= len(tuple.Exprs) {
+		return nil
+	}
+	return tuple.Exprs[index].Range().Ptr()
+}
@@ -0,0 +1,148 @@
+package loader
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gen0cide/laforge/ent"
+	hcl2parse "github.com/hashicorp/hcl/v2/hclparse"
+)
+
+func TestValidateDependsOn(t *testing.T) {
+	// dep is a depends_on block, "network/host"
+	type dep struct {
+		network string
+		host    string
+	}
+	tests := []struct {
+		name string
+		// included lists the hosts of every included_network
+		included map[string][]string
+		// dependsOn lists the depends_on blocks of every host
+		dependsOn map[string][]dep
+		want      []string
+	}{
+		{
+			name:     "no dependencies",
+			included: map[string][]string{"net1": {"a", "b"}},
+		},
+		{
+			name:      "chain",
+			included:  map[string][]string{"net1": {"a", "b", "c"}},
+			dependsOn: map[string][]dep{"a": {{"net1", "b"}}, "b": {{"net1", "c"}}},
+		},
+		{
+			name:     "diamond",
+			included: map[string][]string{"net1": {"a", "b", "c", "d"}},
+			dependsOn: map[string][]dep{
+				"a": {{"net1", "b"}, {"net1", "c"}},
+				"b": {{"net1", "d"}},
+				"c": {{"net1", "d"}},
+			},
+		},
+		{
+			name:      "across networks",
+			included:  map[string][]string{"net1": {"a"}, "net2": {"b"}},
+			dependsOn: map[string][]dep{"a": {{"net2", "b"}}},
+		},
+		{
+			name:      "self dependency",
+			included:  map[string][]string{"net1": {"a"}},
+			dependsOn: map[string][]dep{"a": {{"net1", "a"}}},
+			want:      []string{"Host dependency cycle"},
+		},
+		{
+			name:      "two host cycle",
+			included:  map[string][]string{"net1": {"a", "b"}},
+			dependsOn: map[string][]dep{"a": {{"net1", "b"}}, "b": {{"net1", "a"}}},
+			want:      []string{"Host dependency cycle"},
+		},
+		{
+			name:      "three host cycle",
+			included:  map[string][]string{"net1": {"a", "b", "c"}},
+			dependsOn: map[string][]dep{"a": {{"net1", "b"}}, "b": {{"net1", "c"}}, "c": {{"net1", "a"}}},
+			want:      []string{"Host dependency cycle"},
+		},
+		{
+			name:      "cycle across networks",
+			included:  map[string][]string{"net1": {"a"}, "net2": {"b"}},
+			dependsOn: map[string][]dep{"a": {{"net2", "b"}}, "b": {{"net1", "a"}}},
+			want:      []string{"Host dependency cycle"},
+		},
+		{
+			name:      "same host in another network isn't a cycle",
+			included:  map[string][]string{"net1": {"a", "b"}, "net2": {"a"}},
+			dependsOn: map[string][]dep{"b": {{"net2", "a"}}},
+		},
+		{
+			name:      "host not included",
+			included:  map[string][]string{"net1": {"a"}},
+			dependsOn: map[string][]dep{"a": {{"net1", "b"}}},
+			want:      []string{"Invalid host dependency"},
+		},
+		{
+			name:      "host included in another network",
+			included:  map[string][]string{"net1": {"a"}, "net2": {"b"}},
+			dependsOn: map[string][]dep{"a": {{"net1", "b"}}},
+			want:      []string{"Invalid host dependency"},
+		},
+		{
+			name:      "undefined network",
+			included:  map[string][]string{"net1": {"a", "b"}},
+			dependsOn: map[string][]dep{"a": {{"missing", "b"}}},
+			want:      []string{"Invalid host dependency"},
+		},
+		{
+			name:      "undefined host",
+			included:  map[string][]string{"net1": {"a"}},
+			dependsOn: map[string][]dep{"a": {{"net1", "missing"}}},
+			want:      []string{"Invalid host dependency"},
+		},
+		{
+			name:      "dangling reference and cycle",
+			included:  map[string][]string{"net1": {"a", "b"}},
+			dependsOn: map[string][]dep{"a": {{"net1", "b"}, {"net1", "missing"}}, "b": {{"net1", "a"}}},
+			want:      []string{"Invalid host dependency", "Host dependency cycle"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loadedConfig := &DefinedConfigs{
+				Hosts:    map[string]*ent.Host{},
+				Networks: map[string]*ent.Network{},
+			}
+			env := &ent.Environment{HclID: "env"}
+			for _, networkID := range []string{"net1", "net2"} {
+				hosts, exists := tt.included[networkID]
+				loadedConfig.Networks[networkID] = &ent.Network{HclID: networkID}
+				if !exists {
+					continue
+				}
+				env.HCLEnvironmentToIncludedNetwork = append(env.HCLEnvironmentToIncludedNetwork, &ent.IncludedNetwork{Name: networkID, Hosts: hosts})
+				for _, hostID := range hosts {
+					loadedConfig.Hosts[hostID] = &ent.Host{HclID: hostID}
+				}
+			}
+			for hostID, deps := range tt.dependsOn {
+				cHost := loadedConfig.Hosts[hostID]
+				for _, d := range deps {
+					cHost.HCLDependOnHostToHostDependency = append(cHost.HCLDependOnHostToHostDependency, &ent.HostDependency{NetworkID: d.network, HostID: d.host})
+				}
+			}
+
+			l := &Loader{Parser: hcl2parse.NewParser()}
+			diags := l.validateDependsOn(env, loadedConfig)
+			got := []string{}
+			for _, diag := range diags {
+				got = append(got, diag.Summary)
+			}
+			want := tt.want
+			if want == nil {
+				want = []string{}
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("validateDependsOn() = %v, want %v", diags, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package loader
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/gen0cide/laforge/logging"
+	hcl2 "github.com/hashicorp/hcl/v2"
+)
+
+// sha256Pattern matches the hex encoded SHA-256 remote sources can be pinned to
+var sha256Pattern = regexp.MustCompile(`^[0-9a-fA-F]{64}$`)
+
+// validateFileDownloads checks that the perms of every file_download are an octal file mode, that the
+// sha256 pins are SHA-256 hashes and that only local sources are templated (remote sources are served as
+// they were fetched)
+func (l *Loader) validateFileDownloads(log *logging.Logger, loadedConfig *DefinedConfigs) hcl2.Diagnostics {
+	var diags hcl2.Diagnostics
+	hclIDs := make([]string, 0, len(loadedConfig.FileDownload))
+	for hclID := range loadedConfig.FileDownload {
+		hclIDs = append(hclIDs, hclID)
+	}
+	sort.Strings(hclIDs)
+	for _, hclID := range hclIDs {
+		cFileDownload := loadedConfig.FileDownload[hclID]
+		if cFileDownload.Perms != "" {
+			mode, err := strconv.ParseUint(cFileDownload.Perms, 8, 32)
+			if err == nil && mode > 07777 {
+				err = fmt.Errorf("out of range")
+			}
+			if err != nil {
+				diags = append(diags, &hcl2.Diagnostic{
+					Severity: hcl2.DiagError,
+					Summary:  "Invalid file permissions",
+					Detail:   fmt.Sprintf("file_download %s: perms %q isn't an octal file mode (ex. \"0644\")", hclID, cFileDownload.Perms),
+					Subject:  attributeRange(l.findBlock("file_download", hclID), "perms"),
+				})
+			}
+		}
+		if cFileDownload.Sha256 != "" && !sha256Pattern.MatchString(cFileDownload.Sha256) {
+			diags = append(diags, &hcl2.Diagnostic{
+				Severity: hcl2.DiagError,
+				Summary:  "Invalid file checksum",
+				Detail:   fmt.Sprintf("file_download %s: sha256 %q isn't a hex encoded SHA-256", hclID, cFileDownload.Sha256),
+				Subject:  attributeRange(l.findBlock("file_download", hclID), "sha256"),
+			})
+		}
+		if cFileDownload.Template && cFileDownload.SourceType == "remote" {
+			diags = append(diags, &hcl2.Diagnostic{
+				Severity: hcl2.DiagError,
+				Summary:  "Invalid file template",
+				Detail:   fmt.Sprintf("file_download %s: only local sources can be rendered as templates", hclID),
+				Subject:  attributeRange(l.findBlock("file_download", hclID), "template"),
+			})
+		}
+	}
+	for _, diag := range diags {
+		log.Log.Errorf("Laforge failed to validate a file download:\n Location: %v\n    Issue: %v\n   Detail: %v", diag.Subject, diag.Summary, diag.Detail)
+	}
+	return diags
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/gen0cide/laforge/ent/includednetwork"
 	"github.com/gen0cide/laforge/ent/network"
 	"github.com/gen0cide/laforge/ent/script"
+	"github.com/gen0cide/laforge/ipam"
 	"github.com/gen0cide/laforge/loader/include"
 	"github.com/gen0cide/laforge/logging"
 	hcl2 "github.com/hashicorp/hcl/v2"
@@ -34,8 +35,17 @@ import (
 	_ "github.com/mattn/go-sqlite3"
 	zglob "github.com/mattn/go-zglob"
 	"github.com/sirupsen/logrus"
+	"github.com/zclconf/go-cty/cty"
 )
 
+// configEvalContext passes ${team} through the decoder untouched so network cidrs can be templated
+// per team (e.g. "10.${team}.10.0/24"). The planner fills in the team number.
+var configEvalContext = &hcl2.EvalContext{
+	Variables: map[string]cty.Value{
+		"team": cty.StringVal(ipam.TeamPlaceholder),
+	},
+}
+
 // Include defines a named include type
 type Include struct {
 	Path string `hcl:"path,attr"`
@@ -209,7 +219,7 @@ func (l *Loader) Bind(log *logging.Logger) (*DefinedConfigs, error) {
 				filenames = append([]string{name}, filenames...)
 			}
 			newLF := &DefinedConfigs{}
-			diags := gohcl2.DecodeBody(f.Body, nil, newLF)
+			diags := gohcl2.DecodeBody(f.Body, configEvalContext, newLF)
 			if diags.HasErrors() {
 				for _, e := range diags.Errs() {
 					ne, ok := e.(*hcl2.Diagnostic)
@@ -335,10 +345,18 @@ func (l *Loader) merger(filenames []string) (*DefinedConfigs, error) {
 			}
 		}
 		for _, x := range element.DefinedFileDelete {
-			element.FileDelete[x.HclID] = x
+			_, found := combinedConfigs.FileDelete[x.HclID]
+			if !found {
+				combinedConfigs.FileDelete[x.HclID] = x
+				continue
+			}
 		}
 		for _, x := range element.DefinedFileExtract {
-			element.FileExtract[x.HclID] = x
+			_, found := combinedConfigs.FileExtract[x.HclID]
+			if !found {
+				combinedConfigs.FileExtract[x.HclID] = x
+				continue
+			}
 		}
 		for _, x := range element.DefinedIdentities {
 			_, found := combinedConfigs.Identities[x.HclID]
@@ -360,6 +378,15 @@ func LoadEnvironment(ctx context.Context, client *ent.Client, log *logging.Logge
 		log.Log.Errorf("Unable to Load ENV Config: %v Err: %v", filePath, err)
 		return nil, err
 	}
+	diags := tloader.validateBuilderConfigs(log, loadedConfig.Environments)
+	diags = append(diags, tloader.validateAddresses(log, loadedConfig)...)
+	diags = append(diags, tloader.validateRetryPolicies(log, loadedConfig)...)
+	diags = append(diags, tloader.validateApprovalPolicies(log, loadedConfig)...)
+	diags = append(diags, tloader.validateDependencies(log, loadedConfig)...)
+	diags = append(diags, tloader.validateFileDownloads(log, loadedConfig)...)
+	if diags.HasErrors() {
+		return nil, diags
+	}
 	log.Log.Infof("Loading environment from: %s", filePath)
 	return createEnviroments(ctx, client, log, loadedConfig.Environments, loadedConfig)
 }
@@ -434,6 +461,7 @@ func createEnviroments(ctx context.Context, client *ent.Client, log *logging.Log
 					SetName(cEnviroment.Name).
 					SetRevision(cEnviroment.Revision).
 					SetTags(cEnviroment.Tags).
+					SetApprovalPolicy(cEnviroment.ApprovalPolicy).
 					SetTeamCount(cEnviroment.TeamCount).
 					AddEnvironmentToCompetition(returnedCompetitions...).
 					AddEnvironmentToScript(returnedScripts...).
@@ -474,6 +502,7 @@ func createEnviroments(ctx context.Context, client *ent.Client, log *logging.Log
 			SetName(cEnviroment.Name).
 			SetRevision(entEnvironment.Revision + 1).
 			SetTags(cEnviroment.Tags).
+			SetApprovalPolicy(cEnviroment.ApprovalPolicy).
 			SetTeamCount(cEnviroment.TeamCount).
 			ClearEnvironmentToCompetition().
 			ClearEnvironmentToScript().
@@ -626,7 +655,9 @@ func createHosts(ctx context.Context, client *ent.Client, log *logging.Logger, c
 		if err != nil {
 			if err == err.(*ent.NotFoundError) {
 				entHost, err = client.Host.Create().
+					SetAdditionalDisks(cHost.AdditionalDisks).
 					SetAllowMACChanges(cHost.AllowMACChanges).
+					SetCpus(cHost.Cpus).
 					SetDescription(cHost.Description).
 					SetExposedTCPPorts(cHost.ExposedTCPPorts).
 					SetExposedUDPPorts(cHost.ExposedUDPPorts).
@@ -634,6 +665,7 @@ func createHosts(ctx context.Context, client *ent.Client, log *logging.Logger, c
 					SetHostname(cHost.Hostname).
 					SetInstanceSize(cHost.InstanceSize).
 					SetLastOctet(cHost.LastOctet).
+					SetMemoryMB(cHost.MemoryMB).
 					SetOS(cHost.OS).
 					SetOverridePassword(cHost.OverridePassword).
 					SetProvisionSteps(cHost.ProvisionSteps).
@@ -651,7 +683,9 @@ func createHosts(ctx context.Context, client *ent.Client, log *logging.Logger, c
 			}
 		} else {
 			entHost, err = entHost.Update().
+				SetAdditionalDisks(cHost.AdditionalDisks).
 				SetAllowMACChanges(cHost.AllowMACChanges).
+				SetCpus(cHost.Cpus).
 				SetDescription(cHost.Description).
 				SetExposedTCPPorts(cHost.ExposedTCPPorts).
 				SetExposedUDPPorts(cHost.ExposedUDPPorts).
@@ -659,6 +693,7 @@ func createHosts(ctx context.Context, client *ent.Client, log *logging.Logger, c
 				SetHostname(cHost.Hostname).
 				SetInstanceSize(cHost.InstanceSize).
 				SetLastOctet(cHost.LastOctet).
+				SetMemoryMB(cHost.MemoryMB).
 				SetOS(cHost.OS).
 				SetOverridePassword(cHost.OverridePassword).
 				SetProvisionSteps(cHost.ProvisionSteps).
@@ -715,8 +750,10 @@ func createNetworks(ctx context.Context, client *ent.Client, log *logging.Logger
 			if err == err.(*ent.NotFoundError) {
 				createdQuery := client.Network.Create().
 					SetCidr(cNetwork.Cidr).
+					SetIpv6Cidr(cNetwork.Ipv6Cidr).
 					SetHclID(cNetwork.HclID).
 					SetName(cNetwork.Name).
+					SetReserved(cNetwork.Reserved).
 					SetTags(cNetwork.Tags).
 					SetVars(cNetwork.Vars).
 					SetVdiVisible(cNetwork.VdiVisible)
@@ -726,8 +763,10 @@ func createNetworks(ctx context.Context, client *ent.Client, log *logging.Logger
 		}
 		entNetwork, err = entNetwork.Update().
 			SetCidr(cNetwork.Cidr).
+			SetIpv6Cidr(cNetwork.Ipv6Cidr).
 			SetHclID(cNetwork.HclID).
 			SetName(cNetwork.Name).
+			SetReserved(cNetwork.Reserved).
 			SetTags(cNetwork.Tags).
 			SetVars(cNetwork.Vars).
 			SetVdiVisible(cNetwork.VdiVisible).
@@ -783,6 +822,7 @@ func createScripts(ctx context.Context, client *ent.Client, log *logging.Logger,
 					SetDisabled(cScript.Disabled).
 					SetVars(cScript.Vars).
 					SetTags(cScript.Tags).
+					SetRetry(cScript.Retry).
 					SetAbsPath(cScript.AbsPath).
 					AddScriptToFinding(returnedFindings...)
 				bulk = append(bulk, createdQuery)
@@ -803,6 +843,7 @@ func createScripts(ctx context.Context, client *ent.Client, log *logging.Logger,
 			SetDisabled(cScript.Disabled).
 			SetVars(cScript.Vars).
 			SetTags(cScript.Tags).
+			SetRetry(cScript.Retry).
 			SetAbsPath(cScript.AbsPath).
 			ClearScriptToFinding().
 			Save(ctx)
@@ -854,6 +895,7 @@ func createCommands(ctx context.Context, client *ent.Client, log *logging.Logger
 					SetName(cCommand.Name).
 					SetProgram(cCommand.Program).
 					SetTags(cCommand.Tags).
+					SetRetry(cCommand.Retry).
 					SetTimeout(cCommand.Timeout).
 					SetVars(cCommand.Vars)
 				bulk = append(bulk, createdQuery)
@@ -870,6 +912,7 @@ func createCommands(ctx context.Context, client *ent.Client, log *logging.Logger
 			SetName(cCommand.Name).
 			SetProgram(cCommand.Program).
 			SetTags(cCommand.Tags).
+			SetRetry(cCommand.Retry).
 			SetTimeout(cCommand.Timeout).
 			SetVars(cCommand.Vars).
 			Save(ctx)
@@ -970,7 +1013,9 @@ func createFileDownload(ctx context.Context, client *ent.Client, log *logging.Lo
 					SetDisabled(cFileDownload.Disabled).
 					SetMd5(cFileDownload.Md5).
 					SetAbsPath(cFileDownload.AbsPath).
-					SetTags(cFileDownload.Tags)
+					SetTags(cFileDownload.Tags).
+					SetRetry(cFileDownload.Retry).
+					SetSha256(cFileDownload.Sha256)
 				bulk = append(bulk, createdQuery)
 				continue
 			}
@@ -986,6 +1031,8 @@ func createFileDownload(ctx context.Context, client *ent.Client, log *logging.Lo
 			SetMd5(cFileDownload.Md5).
 			SetAbsPath(cFileDownload.AbsPath).
 			SetTags(cFileDownload.Tags).
+			SetRetry(cFileDownload.Retry).
+			SetSha256(cFileDownload.Sha256).
 			Save(ctx)
 		if err != nil {
 			log.Log.Errorf("Failed to Update File Download %v. Err: %v", cFileDownload.HclID, err)
@@ -1022,7 +1069,8 @@ func createFileDelete(ctx context.Context, client *ent.Client, log *logging.Logg
 				createdQuery := client.FileDelete.Create().
 					SetHclID(cFileDelete.HclID).
 					SetPath(cFileDelete.Path).
-					SetTags(cFileDelete.Tags)
+					SetTags(cFileDelete.Tags).
+					SetRetry(cFileDelete.Retry)
 				bulk = append(bulk, createdQuery)
 				continue
 			}
@@ -1031,6 +1079,7 @@ func createFileDelete(ctx context.Context, client *ent.Client, log *logging.Logg
 			SetHclID(cFileDelete.HclID).
 			SetPath(cFileDelete.Path).
 			SetTags(cFileDelete.Tags).
+			SetRetry(cFileDelete.Retry).
 			Save(ctx)
 		if err != nil {
 			log.Log.Errorf("Failed to Update File Delete %v. Err: %v", cFileDelete.HclID, err)
@@ -1069,6 +1118,7 @@ func createFileExtract(ctx context.Context, client *ent.Client, log *logging.Log
 					SetHclID(cFileExtract.HclID).
 					SetSource(cFileExtract.Source).
 					SetTags(cFileExtract.Tags).
+					SetRetry(cFileExtract.Retry).
 					SetType(cFileExtract.Type)
 				bulk = append(bulk, createdQuery)
 				continue
@@ -1079,6 +1129,7 @@ func createFileExtract(ctx context.Context, client *ent.Client, log *logging.Log
 			SetHclID(cFileExtract.HclID).
 			SetSource(cFileExtract.Source).
 			SetTags(cFileExtract.Tags).
+			SetRetry(cFileExtract.Retry).
 			SetType(cFileExtract.Type).
 			Save(ctx)
 		if err != nil {
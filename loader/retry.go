@@ -0,0 +1,73 @@
+package loader
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/gen0cide/laforge/logging"
+	"github.com/gen0cide/laforge/retry"
+	hcl2 "github.com/hashicorp/hcl/v2"
+)
+
+// validateRetryPolicies checks that the retry block of every script, command and file step parses
+func (l *Loader) validateRetryPolicies(log *logging.Logger, loadedConfig *DefinedConfigs) hcl2.Diagnostics {
+	var diags hcl2.Diagnostics
+	policies := map[string]map[string]*retry.Policy{
+		"script":        {},
+		"command":       {},
+		"file_download": {},
+		"file_delete":   {},
+		"file_extract":  {},
+	}
+	for hclID, cScript := range loadedConfig.Scripts {
+		policies["script"][hclID] = cScript.Retry
+	}
+	for hclID, cCommand := range loadedConfig.Commands {
+		policies["command"][hclID] = cCommand.Retry
+	}
+	for hclID, cFileDownload := range loadedConfig.FileDownload {
+		policies["file_download"][hclID] = cFileDownload.Retry
+	}
+	for hclID, cFileDelete := range loadedConfig.FileDelete {
+		policies["file_delete"][hclID] = cFileDelete.Retry
+	}
+	for hclID, cFileExtract := range loadedConfig.FileExtract {
+		policies["file_extract"][hclID] = cFileExtract.Retry
+	}
+
+	for _, blockType := range []string{"script", "command", "file_download", "file_delete", "file_extract"} {
+		hclIDs := make([]string, 0, len(policies[blockType]))
+		for hclID := range policies[blockType] {
+			hclIDs = append(hclIDs, hclID)
+		}
+		sort.Strings(hclIDs)
+		for _, hclID := range hclIDs {
+			err := policies[blockType][hclID].Validate()
+			if err == nil {
+				continue
+			}
+			// Point at the retry block itself, falling back to the step's header
+			block := l.findBlock(blockType, hclID)
+			subject := attributeRange(block, "retry")
+			if block != nil {
+				for _, nested := range block.Body.Blocks {
+					if nested.Type == "retry" {
+						defRange := nested.DefRange()
+						subject = &defRange
+						break
+					}
+				}
+			}
+			diags = append(diags, &hcl2.Diagnostic{
+				Severity: hcl2.DiagError,
+				Summary:  "Invalid retry policy",
+				Detail:   fmt.Sprintf("%s %s: %v", blockType, hclID, err),
+				Subject:  subject,
+			})
+		}
+	}
+	for _, diag := range diags {
+		log.Log.Errorf("Laforge failed to validate a retry policy:\n Location: %v\n    Issue: %v\n   Detail: %v", diag.Subject, diag.Summary, diag.Detail)
+	}
+	return diags
+}
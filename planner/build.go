@@ -15,19 +15,32 @@ import (
 	"github.com/gen0cide/laforge/ent/network"
 	"github.com/gen0cide/laforge/ent/plan"
 	"github.com/gen0cide/laforge/ent/provisionednetwork"
-	"github.com/gen0cide/laforge/ent/provisioningstep"
 	"github.com/gen0cide/laforge/ent/status"
 	"github.com/gen0cide/laforge/logging"
+	"github.com/gen0cide/laforge/retry"
 	"github.com/gen0cide/laforge/server/utils"
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 )
 
-func StartBuild(client *ent.Client, logger *logging.Logger, currentUser *ent.AuthUser, serverTask *ent.ServerTask, taskStatus *ent.Status, entBuild *ent.Build) error {
+func StartBuild(client *ent.Client, logger *logging.Logger, currentUser *ent.AuthUser, serverTask *ent.ServerTask, taskStatus *ent.Status, entBuild *ent.Build, filter *BuildFilter) error {
 	logger.Log.Debug("BUILDER | START BUILD")
 	ctx := context.Background()
 	defer ctx.Done()
 
 	entPlans, err := entBuild.QueryBuildToPlan().Where(plan.HasPlanToStatusWith(status.StateEQ(status.StatePLANNING))).All(ctx)
+	if err == nil && filter != nil {
+		// The plans left out stay PLANNING, the build walk doesn't go past them
+		var planIDs map[uuid.UUID]bool
+		planIDs, err = filter.filterPlans(ctx, entBuild)
+		filteredPlans := []*ent.Plan{}
+		for _, entPlan := range entPlans {
+			if planIDs[entPlan.ID] {
+				filteredPlans = append(filteredPlans, entPlan)
+			}
+		}
+		entPlans = filteredPlans
+	}
 
 	if err != nil {
 		taskStatus, serverTask, err = utils.FailServerTask(ctx, client, rdb, taskStatus, serverTask)
@@ -56,7 +69,7 @@ func StartBuild(client *ent.Client, logger *logging.Logger, currentUser *ent.Aut
 			ctx := context.Background()
 			defer ctx.Done()
 			entStatus.Update().SetState(status.StateAWAITING).Save(ctx)
-			rdb.Publish(ctx, "updatedStatus", entStatus.ID.String())
+			publishStatus(ctx, entStatus.ID)
 		}(&wg, entStatus)
 
 		wg.Add(1)
@@ -77,7 +90,7 @@ func StartBuild(client *ent.Client, logger *logging.Logger, currentUser *ent.Aut
 					return
 				}
 				entStatus.Update().SetState(status.StateAWAITING).Save(ctx)
-				rdb.Publish(ctx, "updatedStatus", entStatus.ID.String())
+				publishStatus(ctx, entStatus.ID)
 			case plan.TypeProvisionHost:
 				entProHost, err := entPlan.QueryPlanToProvisionedHost().Only(ctx)
 				if err != nil {
@@ -90,7 +103,7 @@ func StartBuild(client *ent.Client, logger *logging.Logger, currentUser *ent.Aut
 					return
 				}
 				entStatus.Update().SetState(status.StateAWAITING).Save(ctx)
-				rdb.Publish(ctx, "updatedStatus", entStatus.ID.String())
+				publishStatus(ctx, entStatus.ID)
 			case plan.TypeExecuteStep:
 				entProvisioningStep, err := entPlan.QueryPlanToProvisioningStep().Only(ctx)
 				if err != nil {
@@ -103,7 +116,7 @@ func StartBuild(client *ent.Client, logger *logging.Logger, currentUser *ent.Aut
 					return
 				}
 				entStatus.Update().SetState(status.StateAWAITING).Save(ctx)
-				rdb.Publish(ctx, "updatedStatus", entStatus.ID.String())
+				publishStatus(ctx, entStatus.ID)
 			case plan.TypeStartTeam:
 				entTeam, err := entPlan.QueryPlanToTeam().Only(ctx)
 				if err != nil {
@@ -116,7 +129,7 @@ func StartBuild(client *ent.Client, logger *logging.Logger, currentUser *ent.Aut
 					return
 				}
 				entStatus.Update().SetState(status.StateAWAITING).Save(ctx)
-				rdb.Publish(ctx, "updatedStatus", entStatus.ID.String())
+				publishStatus(ctx, entStatus.ID)
 			case plan.TypeStartBuild:
 				entBuild, err := entPlan.QueryPlanToBuild().Only(ctx)
 				if err != nil {
@@ -129,7 +142,7 @@ func StartBuild(client *ent.Client, logger *logging.Logger, currentUser *ent.Aut
 					return
 				}
 				entStatus.Update().SetState(status.StateAWAITING).Save(ctx)
-				rdb.Publish(ctx, "updatedStatus", entStatus.ID.String())
+				publishStatus(ctx, entStatus.ID)
 			default:
 				break
 			}
@@ -138,7 +151,12 @@ func StartBuild(client *ent.Client, logger *logging.Logger, currentUser *ent.Aut
 
 	wg.Wait()
 
-	rootPlans, err := entBuild.QueryBuildToPlan().Where(plan.TypeEQ(plan.TypeStartBuild)).All(ctx)
+	// Start from the AWAITING plans whose parents are all done, like ResumeBuild. The root plan is already COMPLETE
+	// when a filter executes more of a build that was partially executed before.
+	rootPlans, err := entBuild.QueryBuildToPlan().Where(
+		plan.HasPlanToStatusWith(status.StateEQ(status.StateAWAITING)),
+		plan.Not(plan.HasPrevPlanWith(plan.HasPlanToStatusWith(status.StateNEQ(status.StateCOMPLETE)))),
+	).All(ctx)
 	if err != nil {
 		taskStatus, serverTask, err = utils.FailServerTask(ctx, client, rdb, taskStatus, serverTask)
 		if err != nil {
@@ -183,13 +201,19 @@ func StartBuild(client *ent.Client, logger *logging.Logger, currentUser *ent.Aut
 	}
 	rdb.Publish(ctx, "updatedBuildCommit", entRootCommit.ID.String())
 
+	buildCtx, buildDone := commitContext(entRootCommit)
+	defer buildDone()
 	for _, entPlan := range rootPlans {
 		wg.Add(1)
-		go buildRoutine(client, logger, &genericBuilder, ctx, entPlan, &wg)
+		go buildRoutine(client, logger, &genericBuilder, buildCtx, entPlan, &wg)
 	}
 
 	wg.Wait()
 
+	if buildCtx.Err() != nil {
+		return cancelledCommit(client, logger, serverTask, taskStatus, entRootCommit)
+	}
+
 	taskStatus, serverTask, err = utils.CompleteServerTask(ctx, client, rdb, taskStatus, serverTask)
 	if err != nil {
 		logger.Log.Errorf("error completing execute build server task: %v", err)
@@ -235,6 +259,11 @@ func buildRoutine(client *ent.Client, logger *logging.Logger, builder *builder.B
 		return
 	}
 
+	// The build was cancelled, the node stays AWAITING for whenever it is resumed
+	if ctx.Err() != nil {
+		return
+	}
+
 	prevNodes, err := entPlan.QueryPrevPlan().All(ctx)
 
 	if err != nil {
@@ -248,54 +277,63 @@ func buildRoutine(client *ent.Client, logger *logging.Logger, builder *builder.B
 
 	parentNodeFailed := false
 
-	entStatus, err = entStatus.Update().SetState(status.StatePARENTAWAITING).Save(ctx)
+	// Only move on from AWAITING so a node reached by two routines at once (from two parents, or from a
+	// resumed build) is only built once
+	claimed, err := client.Status.Update().Where(
+		status.IDEQ(entStatus.ID),
+		status.StateEQ(status.StateAWAITING),
+	).SetState(status.StatePARENTAWAITING).Save(ctx)
 	if err != nil {
 		logger.Log.WithFields(logrus.Fields{
 			"plan": entPlan.ID,
 		}).Error("BUILDER | failed to set PARENTAWAITING status. EXITING")
 		return
 	}
+	if claimed == 0 {
+		logger.Log.WithFields(logrus.Fields{
+			"plan": entPlan.ID,
+		}).Debugf("BUILDER | node already claimed. EXITING")
+		return
+	}
 
+	prevNodeIDs := make([]uuid.UUID, 0, len(prevNodes))
 	for _, prevNode := range prevNodes {
-		for {
-
-			if parentNodeFailed {
-				break
-			}
-
-			prevCompletedStatus, err := prevNode.QueryPlanToStatus().Where(
-				status.StateNEQ(
-					status.StateCOMPLETE,
-				),
-			).Exist(ctx)
-
-			if err != nil {
-				logger.Log.Errorf("Failed to Query Status %v. Err: %v", prevNode, err)
-				return
-			}
-
-			prevFailedStatus, err := prevNode.QueryPlanToStatus().Where(
-				status.StateEQ(
-					status.StateFAILED,
-				),
-			).Exist(ctx)
-
-			if err != nil {
-				logger.Log.Errorf("Failed to Query Status %v. Err: %v", prevNode, err)
-				return
-			}
-
-			if !prevCompletedStatus {
-				break
-			}
+		prevNodeIDs = append(prevNodeIDs, prevNode.ID)
+	}
+	prevStatusIDs, err := client.Status.Query().Where(
+		status.HasStatusToPlanWith(
+			plan.IDIn(prevNodeIDs...),
+		),
+	).IDs(ctx)
+	if err != nil {
+		logger.Log.Errorf("Failed to Query Status %v. Err: %v", prevNodes, err)
+		return
+	}
 
-			if prevFailedStatus {
+	err = waitForUpdates(ctx, prevStatusIDs, func() (bool, error) {
+		prevStatuses, err := client.Status.Query().Where(status.IDIn(prevStatusIDs...)).All(ctx)
+		if err != nil {
+			return false, err
+		}
+		allComplete := true
+		for _, prevStatus := range prevStatuses {
+			if prevStatus.State == status.StateFAILED {
 				parentNodeFailed = true
-				break
+				return true, nil
+			}
+			if prevStatus.State != status.StateCOMPLETE {
+				allComplete = false
 			}
-
-			time.Sleep(time.Second)
 		}
+		return allComplete, nil
+	})
+	if err != nil && ctx.Err() != nil {
+		resetCancelledPlan(client, logger, entPlan)
+		return
+	}
+	if err != nil {
+		logger.Log.Errorf("Failed to Query Status %v. Err: %v", prevNodes, err)
+		return
 	}
 	logger.Log.WithFields(logrus.Fields{
 		"plan": entPlan.ID,
@@ -307,8 +345,8 @@ func buildRoutine(client *ent.Client, logger *logging.Logger, builder *builder.B
 		return
 	}
 
-	entStatus.Update().SetState(status.StateINPROGRESS).Save(ctx)
-	rdb.Publish(ctx, "updatedStatus", entStatus.ID.String())
+	entStatus.Update().SetState(status.StateINPROGRESS).SetStartedAt(time.Now()).Save(ctx)
+	publishStatus(ctx, entStatus.ID)
 
 	var planErr error = nil
 	switch entPlan.Type {
@@ -329,7 +367,7 @@ func buildRoutine(client *ent.Client, logger *logging.Logger, builder *builder.B
 				logger.Log.Errorf("Error while setting Provisioned Network status to FAILED: %v", saveErr)
 				return
 			}
-			rdb.Publish(ctx, "updatedStatus", networkStatus.ID.String())
+			publishStatus(ctx, networkStatus.ID)
 			planErr = fmt.Errorf("parent node for Provionded Network has failed")
 		} else {
 			planErr = buildNetwork(client, logger, builder, ctx, entProNetwork)
@@ -351,7 +389,7 @@ func buildRoutine(client *ent.Client, logger *logging.Logger, builder *builder.B
 				logger.Log.Errorf("Error while setting Provisioned Network status to FAILED: %v", saveErr)
 				return
 			}
-			rdb.Publish(ctx, "updatedStatus", hostStatus.ID.String())
+			publishStatus(ctx, hostStatus.ID)
 			planErr = fmt.Errorf("parent node for Provionded Host has failed")
 		} else {
 			planErr = buildHost(client, logger, builder, ctx, entProHost)
@@ -373,7 +411,7 @@ func buildRoutine(client *ent.Client, logger *logging.Logger, builder *builder.B
 				logger.Log.Errorf("error while trying to set ent.ProvisioningStep.Status.State to status.StateFAILED: %v", err)
 				return
 			}
-			rdb.Publish(ctx, "updatedStatus", stepStatus.ID.String())
+			publishStatus(ctx, stepStatus.ID)
 			planErr = fmt.Errorf("parent node for Provisioning Step has failed")
 		} else {
 			planErr = execStep(client, logger, ctx, entProvisioningStep)
@@ -391,13 +429,16 @@ func buildRoutine(client *ent.Client, logger *logging.Logger, builder *builder.B
 			),
 		).First(ctx)
 
-		if err != nil {
+		if err != nil && !ent.IsNotFound(err) {
 			logger.Log.Errorf("failed to query provisioned network from entTeam: %v", err)
 			return
 		}
-		err = (*builder).DeployNetwork(ctx, entProNetwork)
-		if err != nil {
-			logger.Log.Error("failed to pre-create Tier-1 network (%s). continuing anyways: %v", err)
+		// Environments without a vdi network (ex. simulated ones) have nothing to pre-create
+		if err == nil {
+			err = (*builder).DeployNetwork(ctx, entProNetwork)
+			if err != nil {
+				logger.Log.Errorf("failed to pre-create Tier-1 network (%s). continuing anyways: %v", entProNetwork.Name, err)
+			}
 		}
 		// TODO: END REMOVE ME
 		entStatus, err := entTeam.TeamToStatus(ctx)
@@ -406,7 +447,7 @@ func buildRoutine(client *ent.Client, logger *logging.Logger, builder *builder.B
 			return
 		}
 		entStatus.Update().SetState(status.StateCOMPLETE).Save(ctx)
-		rdb.Publish(ctx, "updatedStatus", entStatus.ID.String())
+		publishStatus(ctx, entStatus.ID)
 	case plan.TypeStartBuild:
 		entBuild, err := entPlan.QueryPlanToBuild().Only(ctx)
 		if err != nil {
@@ -419,22 +460,33 @@ func buildRoutine(client *ent.Client, logger *logging.Logger, builder *builder.B
 			return
 		}
 		entStatus.Update().SetState(status.StateCOMPLETE).Save(ctx)
-		rdb.Publish(ctx, "updatedStatus", entStatus.ID.String())
+		publishStatus(ctx, entStatus.ID)
 	default:
 		break
 	}
 
+	if ctx.Err() != nil {
+		// Cancelled mid-way, don't fail the node (or start its children) so the build can be resumed
+		logger.Log.WithFields(logrus.Fields{
+			"plan": entPlan.ID,
+		}).Warnf("BUILDER | build cancelled. EXITING")
+		resetCancelledPlan(client, logger, entPlan)
+		return
+	}
+
 	if planErr != nil {
-		entStatus.Update().SetState(status.StateFAILED).SetFailed(true).Save(ctx)
-		rdb.Publish(ctx, "updatedStatus", entStatus.ID.String())
+		entStatus.Update().SetState(status.StateFAILED).SetFailed(true).SetEndedAt(time.Now()).Save(ctx)
+		publishStatus(ctx, entStatus.ID)
 		logger.Log.WithFields(logrus.Fields{
 			"type":    entPlan.Type,
 			"builder": (*builder).ID(),
 		}).Errorf("error while executing plan: %v", planErr)
 	} else {
-		entStatus.Update().SetState(status.StateCOMPLETE).SetCompleted(true).Save(ctx)
-		rdb.Publish(ctx, "updatedStatus", entStatus.ID.String())
+		entStatus.Update().SetState(status.StateCOMPLETE).SetCompleted(true).SetEndedAt(time.Now()).Save(ctx)
+		publishStatus(ctx, entStatus.ID)
 	}
+	// The build's progress changed
+	publishProgress(entPlan.BuildID)
 
 	logger.Log.WithFields(logrus.Fields{
 		"plan": entPlan.ID,
@@ -481,7 +533,7 @@ func buildHost(client *ent.Client, logger *logging.Logger, builder *builder.Buil
 		logger.Log.Errorf("Error while setting Provisioned Host status to INPROGRESS: %v", saveErr)
 		return saveErr
 	}
-	rdb.Publish(ctx, "updatedStatus", hostStatus.ID.String())
+	publishStatus(ctx, hostStatus.ID)
 	err = (*builder).DeployHost(ctx, entProHost)
 	if err != nil {
 		logger.Log.Errorf("Error while deploying host: %v", err)
@@ -490,7 +542,7 @@ func buildHost(client *ent.Client, logger *logging.Logger, builder *builder.Buil
 			logger.Log.Errorf("Error while setting Provisioned Host status to FAILED: %v", saveErr)
 			return saveErr
 		}
-		rdb.Publish(ctx, "updatedStatus", hostStatus.ID.String())
+		publishStatus(ctx, hostStatus.ID)
 		return err
 	}
 	logger.Log.Infof("deployed %s successfully", entProHost.SubnetIP)
@@ -499,7 +551,7 @@ func buildHost(client *ent.Client, logger *logging.Logger, builder *builder.Buil
 		logger.Log.Errorf("Error while setting Provisioned Host status to COMPLETE: %v", saveErr)
 		return saveErr
 	}
-	rdb.Publish(ctx, "updatedStatus", hostStatus.ID.String())
+	publishStatus(ctx, hostStatus.ID)
 	return nil
 }
 
@@ -515,7 +567,7 @@ func buildNetwork(client *ent.Client, logger *logging.Logger, builder *builder.B
 		logger.Log.Errorf("Error while setting Provisioned Network status to INPROGRESS: %v", saveErr)
 		return saveErr
 	}
-	rdb.Publish(ctx, "updatedStatus", networkStatus.ID.String())
+	publishStatus(ctx, networkStatus.ID)
 	err = (*builder).DeployNetwork(ctx, entProNetwork)
 	if err != nil {
 		logger.Log.Errorf("Error while deploying network: %v", err)
@@ -524,22 +576,29 @@ func buildNetwork(client *ent.Client, logger *logging.Logger, builder *builder.B
 			logger.Log.Errorf("Error while setting Provisioned Network status to FAILED: %v", saveErr)
 			return saveErr
 		}
-		rdb.Publish(ctx, "updatedStatus", networkStatus.ID.String())
+		publishStatus(ctx, networkStatus.ID)
 		return err
 	}
 	logger.Log.Infof("deployed %s successfully", entProNetwork.Name)
 	// Allow networks to set up
-	time.Sleep(1 * time.Minute)
+	select {
+	case <-time.After(1 * time.Minute):
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 
 	_, saveErr = networkStatus.Update().SetCompleted(true).SetState(status.StateCOMPLETE).Save(ctx)
 	if saveErr != nil {
 		logger.Log.Errorf("Error while setting Provisioned Network status to COMPLETE: %v", saveErr)
 		return saveErr
 	}
-	rdb.Publish(ctx, "updatedStatus", networkStatus.ID.String())
+	publishStatus(ctx, networkStatus.ID)
 	return nil
 }
 
+// agentTimeoutGrace is how long past a step's timeout the planner waits for the agent before failing the step itself
+const agentTimeoutGrace = 5 * time.Minute
+
 func execStep(client *ent.Client, logger *logging.Logger, ctx context.Context, entStep *ent.ProvisioningStep) error {
 	stepStatus, err := entStep.QueryProvisioningStepToStatus().Only(ctx)
 	if err != nil {
@@ -551,237 +610,264 @@ func execStep(client *ent.Client, logger *logging.Logger, ctx context.Context, e
 		logger.Log.Errorf("error while trying to set ent.ProvisioningStep.Status.State to status.StateCOMPLETED: %v", err)
 		return err
 	}
-	rdb.Publish(ctx, "updatedStatus", stepStatus.ID.String())
-	downloadURL, ok := os.LookupEnv("API_DOWNLOAD_URL")
-
-	if !ok {
-		downloadURL = "http://localhost:8080/api/download/"
-	}
+	publishStatus(ctx, stepStatus.ID)
 
-	entProvisionedHost, err := entStep.QueryProvisioningStepToProvisionedHost().Only(ctx)
+	options, err := queryStepOptions(logger, ctx, entStep)
 	if err != nil {
-		logger.Log.Errorf("failed querying Provisioned Host for Provioning Step: %v", err)
 		return err
 	}
-
-	taskCount, err := entProvisionedHost.QueryProvisionedHostToAgentTask().Count(ctx)
+	entStepType, err := lookupStepType(entStep.Type)
 	if err != nil {
-		logger.Log.Errorf("failed querying Number of Tasks: %v", err)
+		logger.Log.Errorf("failed looking up Step Type for Provisioning Step: %v", err)
 		return err
 	}
+	serverStep, runsOnServer := entStepType.(serverStepType)
 
-	switch entStep.Type {
-	case provisioningstep.TypeScript:
-		entScript, err := entStep.QueryProvisioningStepToScript().Only(ctx)
-		if err != nil {
-			logger.Log.Errorf("failed querying Script for Provioning Step: %v", err)
+	// A step that is already in progress was interrupted by a server restart (see ResumeBuilds), its
+	// latest attempt is picked back up instead of queueing its agent tasks again
+	firstAttempt, resumed := 1, false
+	if stepStatus.State == status.StateINPROGRESS {
+		latestAgentTask, err := entStep.QueryProvisioningStepToAgentTask().Order(ent.Desc(agenttask.FieldAttempt)).First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			logger.Log.Errorf("Failed to Query Agent Tasks. Err: %v", err)
 			return err
 		}
-		if _, ok := entScript.Vars["build_render"]; ok {
-			_, err := renderScript(ctx, client, logger, entStep)
+		if err == nil {
+			firstAttempt, resumed = latestAgentTask.Attempt, true
+			logger.Log.Infof("resuming attempt %d of provisioning step %s", firstAttempt, entStep.ID)
+		}
+	}
+
+	var taskErrors []string
+	for attempt := firstAttempt; ; attempt++ {
+		if !resumed || attempt != firstAttempt {
+			err = queueAgentTasks(client, logger, ctx, entStep, attempt)
 			if err != nil {
-				logger.Log.Errorf("failed rerendering Script: %v", err)
 				return err
 			}
-			logger.Log.Debug("sucessful rerendering for Script: %v", err)
-		}
-		entGinMiddleware, err := entStep.QueryProvisioningStepToGinFileMiddleware().Only(ctx)
-		if err != nil {
-			logger.Log.Errorf("failed querying Gin File Middleware for Script: %v", err)
-			return err
-		}
-		_, err = client.AgentTask.Create().
-			SetCommand(agenttask.CommandDOWNLOAD).
-			SetArgs(entScript.Source + "💔" + downloadURL + entGinMiddleware.URLID).
-			SetNumber(taskCount).
-			SetState(agenttask.StateAWAITING).
-			SetAgentTaskToProvisionedHost(entProvisionedHost).
-			SetAgentTaskToProvisioningStep(entStep).
-			Save(ctx)
-		if err != nil {
-			logger.Log.Errorf("failed Creating Agent Task for Script Download: %v", err)
-			return err
 		}
-		// TODO: Add the Ability to change permissions of a file into the agent
-		_, err = client.AgentTask.Create().
-			SetCommand(agenttask.CommandEXECUTE).
-			SetArgs(entScript.Source + "💔" + strings.Join(entScript.Args, " ")).
-			SetNumber(taskCount + 1).
-			SetState(agenttask.StateAWAITING).
-			SetAgentTaskToProvisionedHost(entProvisionedHost).
-			SetAgentTaskToProvisioningStep(entStep).
-			Save(ctx)
+		// Only this attempt's tasks are waited on, the ones of the previous attempts are kept as its history
+		agentTaskIDs, err := entStep.QueryProvisioningStepToAgentTask().Where(
+			agenttask.AttemptEQ(attempt),
+			agenttask.StateNEQ(agenttask.StateCANCELLED),
+		).IDs(ctx)
 		if err != nil {
-			logger.Log.Errorf("failed Creating Agent Task for Script Execute: %v", err)
+			logger.Log.Errorf("Failed to Query Agent Tasks. Err: %v", err)
 			return err
 		}
-		_, err = client.AgentTask.Create().
-			SetCommand(agenttask.CommandDELETE).
-			SetArgs(entScript.Source).
-			SetNumber(taskCount + 2).
-			SetState(agenttask.StateAWAITING).
-			SetAgentTaskToProvisionedHost(entProvisionedHost).
-			SetAgentTaskToProvisioningStep(entStep).
-			Save(ctx)
-		if err != nil {
-			logger.Log.Errorf("failed Creating Agent Task for Script Delete: %v", err)
+		taskErrors, err = waitForAgentTasks(ctx, client, agentTaskIDs, options)
+		if err != nil && ctx.Err() != nil {
+			// The build was cancelled, the build's context can't be used to cancel the tasks anymore
+			cancelErr := cancelAgentTasks(context.Background(), client, agentTaskIDs)
+			if cancelErr != nil {
+				logger.Log.Errorf("Failed to Cancel Agent Tasks. Err: %v", cancelErr)
+			}
 			return err
 		}
-	case provisioningstep.TypeCommand:
-		entCommand, err := entStep.QueryProvisioningStepToCommand().Only(ctx)
 		if err != nil {
-			logger.Log.Errorf("failed querying Command for Provioning Step: %v", err)
+			logger.Log.Errorf("Failed to Query Agent Task State. Err: %v", err)
 			return err
 		}
-		// Check if reboot command
-		if entCommand.Program == "REBOOT" {
-			_, err = client.AgentTask.Create().
-				SetCommand(agenttask.CommandREBOOT).
-				SetArgs("").
-				SetNumber(taskCount).
-				SetState(agenttask.StateAWAITING).
-				SetAgentTaskToProvisionedHost(entProvisionedHost).
-				SetAgentTaskToProvisioningStep(entStep).
-				Save(ctx)
-			if err != nil {
-				logger.Log.Errorf("failed Creating Agent Task for Reboot Command: %v", err)
-				return err
+		if len(taskErrors) == 0 && runsOnServer {
+			err = serverStep.Run(ctx, client, logger, entStep)
+			if err != nil && ctx.Err() != nil {
+				return ctx.Err()
 			}
-		} else {
-			_, err = client.AgentTask.Create().
-				SetCommand(agenttask.CommandEXECUTE).
-				SetArgs(entCommand.Program + "💔" + strings.Join(entCommand.Args, " ")).
-				SetNumber(taskCount).
-				SetState(agenttask.StateAWAITING).
-				SetAgentTaskToProvisionedHost(entProvisionedHost).
-				SetAgentTaskToProvisioningStep(entStep).
-				Save(ctx)
 			if err != nil {
-				logger.Log.Errorf("failed Creating Agent Task for Command: %v", err)
-				return err
+				taskErrors = append(taskErrors, err.Error())
 			}
 		}
-	case provisioningstep.TypeFileDelete:
-		entFileDelete, err := entStep.QueryProvisioningStepToFileDelete().Only(ctx)
-		if err != nil {
-			logger.Log.Errorf("failed querying File Delete for Provioning Step: %v", err)
-			return err
-		}
-		_, err = client.AgentTask.Create().
-			SetCommand(agenttask.CommandDELETE).
-			SetArgs(entFileDelete.Path).
-			SetNumber(taskCount).
-			SetState(agenttask.StateAWAITING).
-			SetAgentTaskToProvisionedHost(entProvisionedHost).
-			SetAgentTaskToProvisioningStep(entStep).
-			Save(ctx)
-		if err != nil {
-			logger.Log.Errorf("failed Creating Agent Task for File Delete: %v", err)
-			return err
-		}
-	case provisioningstep.TypeFileDownload:
-		entFileDownload, err := entStep.QueryProvisioningStepToFileDownload().Only(ctx)
-		if err != nil {
-			logger.Log.Errorf("failed querying File Download for Provioning Step: %v", err)
-			return err
+		if len(taskErrors) == 0 || options.ignoreErrors || attempt >= options.retry.MaxAttempts() || !options.retry.Retryable(taskErrors) {
+			break
 		}
-		entGinMiddleware, err := entStep.QueryProvisioningStepToGinFileMiddleware().Only(ctx)
+
+		delay := options.retry.Delay(attempt + 1)
+		logger.Log.Warnf("attempt %d of %d of provisioning step %s failed, retrying in %v: %s", attempt, options.retry.MaxAttempts(), entStep.ID, delay, strings.Join(taskErrors, "; "))
+		_, err = stepStatus.Update().SetError(fmt.Sprintf("attempt %d of %d failed: %s", attempt, options.retry.MaxAttempts(), strings.Join(taskErrors, "; "))).Save(ctx)
 		if err != nil {
-			logger.Log.Errorf("failed querying Gin File Middleware for File Download: %v", err)
+			logger.Log.Errorf("error while trying to set ent.ProvisioningStep.Status.Error: %v", err)
 			return err
 		}
-		if entFileDownload.SourceType == "remote" {
-			_, err = client.AgentTask.Create().
-				SetCommand(agenttask.CommandDOWNLOAD).
-				SetArgs(entFileDownload.Destination + "💔" + entFileDownload.Source).
-				SetNumber(taskCount).
-				SetState(agenttask.StateAWAITING).
-				SetAgentTaskToProvisionedHost(entProvisionedHost).
-				SetAgentTaskToProvisioningStep(entStep).
-				Save(ctx)
-		} else {
-			_, err = client.AgentTask.Create().
-				SetCommand(agenttask.CommandDOWNLOAD).
-				SetArgs(entFileDownload.Destination + "💔" + downloadURL + entGinMiddleware.URLID).
-				SetNumber(taskCount).
-				SetState(agenttask.StateAWAITING).
-				SetAgentTaskToProvisionedHost(entProvisionedHost).
-				SetAgentTaskToProvisioningStep(entStep).
-				Save(ctx)
+		publishStatus(ctx, stepStatus.ID)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return ctx.Err()
 		}
+	}
+
+	if len(taskErrors) > 0 && !options.ignoreErrors {
+		_, err = stepStatus.Update().SetFailed(true).SetState(status.StateFAILED).SetError(strings.Join(taskErrors, "; ")).Save(ctx)
 		if err != nil {
-			logger.Log.Errorf("failed Creating Agent Task for File Download: %v", err)
+			logger.Log.Errorf("error while trying to set ent.ProvisioningStep.Status.State to status.StateFAILED: %v", err)
 			return err
 		}
-	case provisioningstep.TypeFileExtract:
-		entFileExtract, err := entStep.QueryProvisioningStepToFileExtract().Only(ctx)
-		if err != nil {
-			logger.Log.Errorf("failed querying File Extract for Provioning Step: %v", err)
-			return err
+		publishStatus(ctx, stepStatus.ID)
+		return fmt.Errorf("one or more agent tasks failed: %s", strings.Join(taskErrors, "; "))
+	}
+
+	if options.cooldown > 0 {
+		// Hold off the steps that depend on this one
+		logger.Log.Debugf("cooling down for %ds after provisioning step %s", options.cooldown, entStep.ID)
+		select {
+		case <-time.After(time.Duration(options.cooldown) * time.Second):
+		case <-ctx.Done():
+			return ctx.Err()
 		}
+	}
+
+	stepUpdate := stepStatus.Update().SetCompleted(true).SetState(status.StateCOMPLETE)
+	if len(taskErrors) > 0 {
+		// Complete with warnings: the failures are kept on the status but don't fail the dependent plans
+		logger.Log.Warnf("ignoring errors of provisioning step %s: %s", entStep.ID, strings.Join(taskErrors, "; "))
+		stepUpdate = stepUpdate.SetError(strings.Join(taskErrors, "; "))
+	} else {
+		// Drop the failures of the earlier attempts, their agent tasks are still there
+		stepUpdate = stepUpdate.ClearError()
+	}
+	_, err = stepUpdate.Save(ctx)
+	if err != nil {
+		logger.Log.Errorf("error while trying to set ent.ProvisioningStep.Status.State to status.StateCOMPLETED: %v", err)
+		return err
+	}
+	publishStatus(ctx, stepStatus.ID)
+
+	return nil
+}
+
+// stepOptions are the execution settings of the script, command or file a provisioning step runs
+type stepOptions struct {
+	// timeout (seconds) applies to the script/command execution, cooldown (seconds) delays the next step
+	timeout      int
+	cooldown     int
+	ignoreErrors bool
+	retry        *retry.Policy
+}
+
+// queryStepOptions looks up the execution settings of the script, command or file of a provisioning step
+func queryStepOptions(logger *logging.Logger, ctx context.Context, entStep *ent.ProvisioningStep) (*stepOptions, error) {
+	entStepType, err := lookupStepType(entStep.Type)
+	if err != nil {
+		logger.Log.Errorf("failed looking up Step Type for Provisioning Step: %v", err)
+		return nil, err
+	}
+	options, err := entStepType.Options(ctx, entStep)
+	if err != nil {
+		logger.Log.Errorf("failed querying Options for Provisioning Step: %v", err)
+		return nil, err
+	}
+	return options, nil
+}
+
+// queueAgentTasks creates the agent tasks of one attempt of a provisioning step
+func queueAgentTasks(client *ent.Client, logger *logging.Logger, ctx context.Context, entStep *ent.ProvisioningStep, attempt int) error {
+	downloadURL, ok := os.LookupEnv("API_DOWNLOAD_URL")
+
+	if !ok {
+		downloadURL = "http://localhost:8080/api/download/"
+	}
+
+	entProvisionedHost, err := entStep.QueryProvisioningStepToProvisionedHost().Only(ctx)
+	if err != nil {
+		logger.Log.Errorf("failed querying Provisioned Host for Provioning Step: %v", err)
+		return err
+	}
+
+	taskCount, err := entProvisionedHost.QueryProvisionedHostToAgentTask().Count(ctx)
+	if err != nil {
+		logger.Log.Errorf("failed querying Number of Tasks: %v", err)
+		return err
+	}
+
+	entStepType, err := lookupStepType(entStep.Type)
+	if err != nil {
+		logger.Log.Errorf("failed looking up Step Type for Provisioning Step: %v", err)
+		return err
+	}
+	tasks, err := entStepType.Tasks(ctx, client, logger, entStep, downloadURL)
+	if err != nil {
+		logger.Log.Errorf("failed generating Agent Tasks for %s Provisioning Step: %v", entStep.Type, err)
+		return err
+	}
+	for i, task := range tasks {
 		_, err = client.AgentTask.Create().
-			SetCommand(agenttask.CommandEXTRACT).
-			SetArgs(entFileExtract.Source + "💔" + entFileExtract.Destination).
-			SetNumber(taskCount).
+			SetCommand(task.command).
+			SetArgs(task.args).
+			SetNumber(taskCount + i).
+			SetAttempt(attempt).
+			SetTimeout(task.timeout).
 			SetState(agenttask.StateAWAITING).
 			SetAgentTaskToProvisionedHost(entProvisionedHost).
 			SetAgentTaskToProvisioningStep(entStep).
 			Save(ctx)
 		if err != nil {
-			logger.Log.Errorf("failed Creating Agent Task for File Extract: %v", err)
+			logger.Log.Errorf("failed Creating Agent Task %d (%s) for %s Provisioning Step: %v", taskCount+i, task.command, entStep.Type, err)
 			return err
 		}
-	case provisioningstep.TypeDNSRecord:
-		break
-	default:
-		break
 	}
 
-	for {
-		taskFailed, err := entStep.QueryProvisioningStepToAgentTask().Where(
-			agenttask.StateEQ(
-				agenttask.StateFAILED,
-			),
-		).Exist(ctx)
+	return nil
+}
 
+// waitForAgentTasks waits for the agent tasks to finish and returns the errors of the failed ones. It
+// stops at the first failure unless the step ignores errors.
+func waitForAgentTasks(ctx context.Context, client *ent.Client, agentTaskIDs []uuid.UUID, options *stepOptions) ([]string, error) {
+	waitCtx := ctx
+	if options.timeout > 0 {
+		// The agent kills the process at the timeout, the grace covers the time it takes the agent to get to
+		// the tasks and report back (or a host that never does)
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(ctx, time.Duration(options.timeout)*time.Second+agentTimeoutGrace)
+		defer cancel()
+	}
+	taskErrors := []string{}
+	err := waitForUpdates(waitCtx, agentTaskIDs, func() (bool, error) {
+		entAgentTasks, err := client.AgentTask.Query().Where(agenttask.IDIn(agentTaskIDs...)).All(ctx)
 		if err != nil {
-			logger.Log.Errorf("Failed to Query Agent Task State. Err: %v", err)
-			return err
-		}
-
-		if taskFailed {
-			_, err = stepStatus.Update().SetFailed(true).SetState(status.StateFAILED).Save(ctx)
-			if err != nil {
-				logger.Log.Errorf("error while trying to set ent.ProvisioningStep.Status.State to status.StateFAILED: %v", err)
-				return err
+			return false, err
+		}
+		taskErrors = taskErrors[:0]
+		allFinished := true
+		for _, entAgentTask := range entAgentTasks {
+			switch entAgentTask.State {
+			case agenttask.StateFAILED:
+				taskErrors = append(taskErrors, fmt.Sprintf("agent task %d (%s) failed: %s", entAgentTask.Number, entAgentTask.Command, entAgentTask.ErrorMessage))
+			case agenttask.StateCOMPLETE:
+			default:
+				allFinished = false
 			}
-			rdb.Publish(ctx, "updatedStatus", stepStatus.ID.String())
-			return fmt.Errorf("one or more agent tasks failed")
 		}
-
-		taskRunning, err := entStep.QueryProvisioningStepToAgentTask().Where(
-			agenttask.StateNEQ(
-				agenttask.StateCOMPLETE,
-			),
-		).Exist(ctx)
-
-		if err != nil {
-			logger.Log.Errorf("Failed to Query Agent Task State. Err: %v", err)
-			return err
-		}
-
-		if !taskRunning {
-			break
+		// Steps that ignore errors let the rest of their tasks run (ex. the script delete after a failed execute)
+		if len(taskErrors) > 0 && !options.ignoreErrors {
+			return true, nil
 		}
+		return allFinished, nil
+	})
+	if err == context.DeadlineExceeded && ctx.Err() == nil {
+		err = timeoutAgentTasks(ctx, client, agentTaskIDs, options.timeout)
+		taskErrors = append(taskErrors, fmt.Sprintf("timed out after %ds", options.timeout))
+	}
+	return taskErrors, err
+}
 
-		time.Sleep(time.Second)
+// timeoutAgentTasks fails the agent tasks that haven't finished so the agent skips them if it ever comes back
+func timeoutAgentTasks(ctx context.Context, client *ent.Client, agentTaskIDs []uuid.UUID, timeout int) error {
+	unfinishedIDs, err := client.AgentTask.Query().Where(
+		agenttask.IDIn(agentTaskIDs...),
+		agenttask.StateIn(agenttask.StateAWAITING, agenttask.StateINPROGRESS),
+	).IDs(ctx)
+	if err != nil {
+		return err
 	}
-	_, err = stepStatus.Update().SetCompleted(true).SetState(status.StateCOMPLETE).Save(ctx)
+	err = client.AgentTask.Update().Where(agenttask.IDIn(unfinishedIDs...)).
+		SetState(agenttask.StateFAILED).
+		SetErrorMessage(fmt.Sprintf("timed out after %ds", timeout)).
+		Exec(ctx)
 	if err != nil {
-		logger.Log.Errorf("error while trying to set ent.ProvisioningStep.Status.State to status.StateCOMPLETED: %v", err)
 		return err
 	}
-	rdb.Publish(ctx, "updatedStatus", stepStatus.ID.String())
-
+	for _, id := range unfinishedIDs {
+		rdb.Publish(ctx, "updatedAgentTask", id.String())
+	}
 	return nil
 }
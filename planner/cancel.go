@@ -0,0 +1,174 @@
+package planner
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/gen0cide/laforge/ent"
+	"github.com/gen0cide/laforge/ent/agenttask"
+	"github.com/gen0cide/laforge/ent/buildcommit"
+	"github.com/gen0cide/laforge/ent/plan"
+	"github.com/gen0cide/laforge/ent/provisionedhost"
+	"github.com/gen0cide/laforge/ent/provisionednetwork"
+	"github.com/gen0cide/laforge/ent/provisioningstep"
+	"github.com/gen0cide/laforge/ent/status"
+	"github.com/gen0cide/laforge/logging"
+	"github.com/gen0cide/laforge/server/utils"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	runningCommits   = map[uuid.UUID]context.CancelFunc{}
+	runningCommitsMu sync.Mutex
+)
+
+// commitContext returns the context a build commit is applied with, it is cancelled by CancelBuildCommit.
+// The returned func has to be called once the commit is done.
+func commitContext(entCommit *ent.BuildCommit) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	runningCommitsMu.Lock()
+	runningCommits[entCommit.ID] = cancel
+	runningCommitsMu.Unlock()
+	return ctx, func() {
+		runningCommitsMu.Lock()
+		delete(runningCommits, entCommit.ID)
+		runningCommitsMu.Unlock()
+		cancel()
+	}
+}
+
+// CancelBuildCommit stops the build (or rebuild) applying the commit. runningCommits only knows about the commits
+// this server started, a commit nothing is applying anymore (e.g. it was left INPROGRESS by a restart and couldn't
+// be resumed) is cancelled through its state in the database instead.
+func CancelBuildCommit(client *ent.Client, entCommit *ent.BuildCommit) error {
+	runningCommitsMu.Lock()
+	cancel, running := runningCommits[entCommit.ID]
+	if running {
+		cancel()
+	}
+	runningCommitsMu.Unlock()
+	if running {
+		return nil
+	}
+	return cancelStaleCommit(client, entCommit)
+}
+
+// cancelStaleCommit does what a cancelled build routine would have: the plans left in progress go back to AWAITING,
+// their unfinished agent tasks are cancelled, the commit is marked CANCELLED and its server task is failed
+func cancelStaleCommit(client *ent.Client, entCommit *ent.BuildCommit) error {
+	ctx := context.Background()
+	logger := &logging.Logger{Log: logrus.StandardLogger()}
+
+	entBuild, err := entCommit.QueryBuildCommitToBuild().Only(ctx)
+	if err != nil {
+		return fmt.Errorf("error querying build of commit: %v", err)
+	}
+	interruptedPlans, err := entBuild.QueryBuildToPlan().Where(
+		plan.HasPlanToStatusWith(status.StateIn(status.StatePARENTAWAITING, status.StateINPROGRESS)),
+	).All(ctx)
+	if err != nil {
+		return fmt.Errorf("error querying interrupted plans of build: %v", err)
+	}
+	interruptedPlanIDs := make([]uuid.UUID, 0, len(interruptedPlans))
+	for _, entPlan := range interruptedPlans {
+		interruptedPlanIDs = append(interruptedPlanIDs, entPlan.ID)
+	}
+	agentTaskIDs, err := client.AgentTask.Query().Where(
+		agenttask.HasAgentTaskToProvisioningStepWith(provisioningstep.HasProvisioningStepToPlanWith(plan.IDIn(interruptedPlanIDs...))),
+	).IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("error querying agent tasks of interrupted plans: %v", err)
+	}
+	err = cancelAgentTasks(ctx, client, agentTaskIDs)
+	if err != nil {
+		return fmt.Errorf("error cancelling agent tasks of interrupted plans: %v", err)
+	}
+	for _, entPlan := range interruptedPlans {
+		resetCancelledPlan(client, logger, entPlan)
+	}
+
+	serverTask, taskStatus, err := interruptedServerTask(ctx, client, entBuild)
+	if err != nil {
+		return fmt.Errorf("error querying server task of build: %v", err)
+	}
+	if serverTask != nil {
+		logger, err = logging.CreateLoggerForServerTask(serverTask)
+		if err != nil {
+			return fmt.Errorf("error creating logger for server task %s: %v", serverTask.ID, err)
+		}
+	}
+	// cancelledCommit always returns the cancellation itself as an error, it logs what went wrong
+	cancelledCommit(client, logger, serverTask, taskStatus, entCommit)
+	return nil
+}
+
+// cancelAgentTasks marks the agent tasks the agents haven't finished as CANCELLED so they don't pick them (back) up
+func cancelAgentTasks(ctx context.Context, client *ent.Client, agentTaskIDs []uuid.UUID) error {
+	cancelledIDs, err := client.AgentTask.Query().Where(
+		agenttask.IDIn(agentTaskIDs...),
+		agenttask.StateIn(agenttask.StateAWAITING, agenttask.StateINPROGRESS),
+	).IDs(ctx)
+	if err != nil {
+		return err
+	}
+	err = client.AgentTask.Update().Where(agenttask.IDIn(cancelledIDs...)).
+		SetState(agenttask.StateCANCELLED).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	for _, id := range cancelledIDs {
+		rdb.Publish(ctx, "updatedAgentTask", id.String())
+	}
+	return nil
+}
+
+// resetCancelledPlan puts a plan (and what it provisions) interrupted by a cancellation back to AWAITING so
+// the build can be resumed later on
+func resetCancelledPlan(client *ent.Client, logger *logging.Logger, entPlan *ent.Plan) {
+	// The build's context is cancelled by now
+	ctx := context.Background()
+	entStatuses, err := client.Status.Query().Where(
+		status.Or(
+			status.HasStatusToPlanWith(plan.IDEQ(entPlan.ID)),
+			status.HasStatusToProvisionedHostWith(provisionedhost.HasProvisionedHostToPlanWith(plan.IDEQ(entPlan.ID))),
+			status.HasStatusToProvisionedNetworkWith(provisionednetwork.HasProvisionedNetworkToPlanWith(plan.IDEQ(entPlan.ID))),
+			status.HasStatusToProvisioningStepWith(provisioningstep.HasProvisioningStepToPlanWith(plan.IDEQ(entPlan.ID))),
+		),
+		status.StateIn(status.StatePARENTAWAITING, status.StateINPROGRESS, status.StateFAILED),
+	).All(ctx)
+	if err != nil {
+		logger.Log.Errorf("error querying statuses of cancelled plan %s: %v", entPlan.ID, err)
+		return
+	}
+	for _, entStatus := range entStatuses {
+		err = entStatus.Update().SetState(status.StateAWAITING).SetFailed(false).ClearError().Exec(ctx)
+		if err != nil {
+			logger.Log.Errorf("error resetting status of cancelled plan %s: %v", entPlan.ID, err)
+			continue
+		}
+		publishStatus(ctx, entStatus.ID)
+	}
+}
+
+// cancelledCommit records that the build commit was cancelled and fails its server task
+func cancelledCommit(client *ent.Client, logger *logging.Logger, serverTask *ent.ServerTask, taskStatus *ent.Status, entCommit *ent.BuildCommit) error {
+	ctx := context.Background()
+	logger.Log.Warnf("build commit %s was cancelled", entCommit.ID)
+	err := entCommit.Update().SetState(buildcommit.StateCANCELLED).Exec(ctx)
+	if err != nil {
+		logger.Log.Errorf("error while cancelling build commit: %v", err)
+		return err
+	}
+	rdb.Publish(ctx, "updatedBuildCommit", entCommit.ID.String())
+	if serverTask != nil {
+		_, _, err = utils.FailServerTask(ctx, client, rdb, taskStatus, serverTask, fmt.Errorf("build commit was cancelled"))
+		if err != nil {
+			logger.Log.Errorf("error failing server task: %v", err)
+			return err
+		}
+	}
+	return fmt.Errorf("build commit %s was cancelled", entCommit.ID)
+}
@@ -28,7 +28,7 @@ func DeleteBuild(client *ent.Client, rdb *redis.Client, logger *logging.Logger,
 	deleteContext := context.Background()
 	defer deleteContext.Done()
 
-	entDeleteCommit, err := generateDeleteBuildCommit(deleteContext, client, entBuild)
+	entDeleteCommit, err := generateDeleteBuildCommit(deleteContext, client, currentUser, entBuild)
 	if err != nil {
 		spawnedDelete <- false
 		return false, err
@@ -45,7 +45,7 @@ func DeleteBuild(client *ent.Client, rdb *redis.Client, logger *logging.Logger,
 	spawnedDelete <- true
 
 	logger.Log.Debug("-----\nWAITING FOR COMMIT REVIEW\n-----")
-	isApproved, err := utils.WaitForCommitReview(client, entDeleteCommit, 20*time.Minute)
+	isApproved, err := utils.WaitForCommitReview(client, rdb, entDeleteCommit)
 	if err != nil {
 		logger.Log.Errorf("error while waiting for delete commit to be reviewed: %v", err)
 		entDeleteCommit.Update().SetState(buildcommit.StateCANCELLED).Exec(deleteContext)
@@ -56,14 +56,14 @@ func DeleteBuild(client *ent.Client, rdb *redis.Client, logger *logging.Logger,
 	// Cancelled or timeout reached
 	if !isApproved {
 		logger.Log.Debug("-----\nCOMMIT CANCELLED/TIMED OUT\n-----")
-		logger.Log.Errorf("delete commit has been cancelled or 20 minute timeout has been reached")
+		logger.Log.Errorf("delete commit has been cancelled or the review timeout has been reached")
 		err = entDeleteCommit.Update().SetState(buildcommit.StateCANCELLED).Exec(deleteContext)
 		if err != nil {
 			logger.Log.Errorf("error while cancelling delete commit: %v", err)
 			return false, err
 		}
 		rdb.Publish(deleteContext, "updatedBuildCommit", entDeleteCommit.ID.String())
-		return false, fmt.Errorf("commit has been cancelled or 20 minute timeout has been reached")
+		return false, fmt.Errorf("commit has been cancelled or the review timeout has been reached")
 	}
 	logger.Log.Debug("-----\nCOMMIT APPROVED\n-----")
 
@@ -185,7 +185,7 @@ func DeleteBuild(client *ent.Client, rdb *redis.Client, logger *logging.Logger,
 	return true, nil
 }
 
-func generateDeleteBuildCommit(ctx context.Context, client *ent.Client, entBuild *ent.Build) (*ent.BuildCommit, error) {
+func generateDeleteBuildCommit(ctx context.Context, client *ent.Client, currentUser *ent.AuthUser, entBuild *ent.Build) (*ent.BuildCommit, error) {
 	entPlans, err := entBuild.QueryBuildToPlan().All(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error querying plans from build: %v", err)
@@ -201,6 +201,7 @@ func generateDeleteBuildCommit(ctx context.Context, client *ent.Client, entBuild
 		SetState(buildcommit.StatePLANNING).
 		SetType(buildcommit.TypeDELETE).
 		SetBuildCommitToBuild(entBuild).
+		SetCreatedBy(currentUser.ID.String()).
 		Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error creating delete build commit: %v", err)
@@ -493,6 +494,25 @@ func deleteRoutine(client *ent.Client, logger *logging.Logger, builder *builder.
 		if deleteErr != nil {
 			break
 		}
+		if entStepType, err := lookupStepType(step.Type); err == nil {
+			if serverStep, ok := entStepType.(serverStepType); ok {
+				// Steps done from the server have no file to take down, only what the server set up
+				logger.Log.Debugf("teardown step | %s - %s", step.Type, entPlan.ID)
+				err = serverStep.Teardown(ctx, client, logger, step)
+				if err != nil {
+					logger.Log.Errorf("error tearing down %s provisioning step: %v", step.Type, err)
+					entStatus.Update().SetState(status.StateTAINTED).SetFailed(true).Save(ctx)
+					rdb.Publish(ctx, "updatedStatus", entStatus.ID.String())
+					return
+				}
+				deleteErr = provisionedStatus.Update().SetState(status.StateDELETED).Exec(ctx)
+				rdb.Publish(ctx, "updatedStatus", provisionedStatus.ID.String())
+				if deleteErr != nil {
+					logger.Log.Errorf("error while setting Provisioning Step status to DELETED: %v", deleteErr)
+				}
+				break
+			}
+		}
 		ginFileMiddleware, deleteErr := step.QueryProvisioningStepToGinFileMiddleware().Only(ctx)
 		if deleteErr != nil {
 			break
@@ -0,0 +1,201 @@
+package planner
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"text/template"
+
+	"github.com/gen0cide/laforge/dnsupdate"
+	"github.com/gen0cide/laforge/ent"
+	"github.com/gen0cide/laforge/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// BuiltinDNSType is the type of the DNS blocks the Laforge server answers for itself (see dnsserver),
+// the DNS records of those aren't pushed anywhere
+const BuiltinDNSType = "laforge"
+
+// errNoDNS is returned for the DNS record steps of a competition without any DNS block
+var errNoDNS = errors.New("the competition has no DNS")
+
+// RenderedDNSRecord is the DNS record of a provisioning step rendered for its host
+type RenderedDNSRecord struct {
+	Name   string
+	Zone   string
+	Values []string
+}
+
+// RenderDNSRecord renders the name, zone and values of the DNS record of a provisioning step like a
+// script, so they can differ per team and host
+func RenderDNSRecord(ctx context.Context, entStep *ent.ProvisioningStep, entDNSRecord *ent.DNSRecord) (*RenderedDNSRecord, error) {
+	templateContext, err := stepTemplateContext(ctx, entStep)
+	if err != nil {
+		return nil, err
+	}
+	render := func(field string, text string) (string, error) {
+		t, err := template.New(entDNSRecord.HclID).Funcs(TemplateFuncLib).Parse(text)
+		if err != nil {
+			return "", fmt.Errorf("error parsing %s of DNS record %s: %v", field, entDNSRecord.HclID, err)
+		}
+		var buf bytes.Buffer
+		err = t.Execute(&buf, templateContext)
+		if err != nil {
+			return "", fmt.Errorf("error rendering %s of DNS record %s: %v", field, entDNSRecord.HclID, err)
+		}
+		return buf.String(), nil
+	}
+	rendered := &RenderedDNSRecord{Values: make([]string, 0, len(entDNSRecord.Values))}
+	if rendered.Name, err = render("name", entDNSRecord.Name); err != nil {
+		return nil, err
+	}
+	if rendered.Zone, err = render("zone", entDNSRecord.Zone); err != nil {
+		return nil, err
+	}
+	for _, value := range entDNSRecord.Values {
+		renderedValue, err := render("values", value)
+		if err != nil {
+			return nil, err
+		}
+		rendered.Values = append(rendered.Values, renderedValue)
+	}
+	return rendered, nil
+}
+
+// dnsRecordUpdate is a DNS record step as pushed to the servers of one of the competition's DNS blocks
+type dnsRecordUpdate struct {
+	servers []dnsupdate.Server
+	zone    string
+	record  dnsupdate.Record
+}
+
+// dnsRecordUpdates renders the DNS record of a provisioning step and pairs it with every DNS block of
+// the competition it is pushed to (the ones that aren't disabled or answered for by Laforge). The
+// config of a DNS block sets the TSIG key updates are signed with (tsig_key_name, tsig_secret and
+// tsig_algorithm) and the TTL of the records (ttl), a record's vars can override the TTL.
+func dnsRecordUpdates(ctx context.Context, entStep *ent.ProvisioningStep) (*ent.DNSRecord, []dnsRecordUpdate, error) {
+	entDNSRecord, err := entStep.QueryProvisioningStepToDNSRecord().Only(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed querying DNS Record for Provisioning Step: %v", err)
+	}
+	entDNSs, err := entStep.QueryProvisioningStepToProvisionedHost().QueryProvisionedHostToProvisionedNetwork().QueryProvisionedNetworkToBuild().QueryBuildToCompetition().QueryCompetitionToDNS().All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed querying DNS for Provisioning Step: %v", err)
+	}
+	if len(entDNSs) == 0 {
+		return entDNSRecord, nil, fmt.Errorf("%w to push DNS record %s to", errNoDNS, entDNSRecord.HclID)
+	}
+	rendered, err := RenderDNSRecord(ctx, entStep, entDNSRecord)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updates := make([]dnsRecordUpdate, 0, len(entDNSs))
+	for _, entDNS := range entDNSs {
+		if entDNS.Type == "disabled" || entDNS.Type == BuiltinDNSType {
+			continue
+		}
+		update := dnsRecordUpdate{
+			zone:   rendered.Zone,
+			record: dnsupdate.Record{Name: rendered.Name, Type: entDNSRecord.Type, Values: rendered.Values},
+		}
+		if update.zone == "" {
+			update.zone = entDNS.RootDomain
+		}
+		ttl, err := DNSRecordTTL(entDNS, entDNSRecord)
+		if err != nil {
+			return nil, nil, err
+		}
+		update.record.TTL = ttl
+		for _, address := range entDNS.DNSServers {
+			update.servers = append(update.servers, dnsupdate.Server{
+				Address:       address,
+				TSIGKeyName:   entDNS.Config["tsig_key_name"],
+				TSIGSecret:    entDNS.Config["tsig_secret"],
+				TSIGAlgorithm: entDNS.Config["tsig_algorithm"],
+			})
+		}
+		updates = append(updates, update)
+	}
+	return entDNSRecord, updates, nil
+}
+
+// DNSRecordTTL is the TTL of a DNS record as served by a DNS block: the record's ttl var, the block's
+// ttl config or 0 (the default TTL) when neither is set. The record is nil for the records that don't
+// come from a dns_record (ex. the host records of dnsserver).
+func DNSRecordTTL(entDNS *ent.DNS, entDNSRecord *ent.DNSRecord) (uint32, error) {
+	ttl, source := entDNS.Config["ttl"], "DNS "+entDNS.HclID
+	if entDNSRecord != nil {
+		if recordTTL, exists := entDNSRecord.Vars["ttl"]; exists {
+			ttl, source = recordTTL, "DNS record "+entDNSRecord.HclID
+		}
+	}
+	if ttl == "" {
+		return 0, nil
+	}
+	parsedTTL, err := strconv.ParseUint(ttl, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid ttl \"%s\" for %s: %v", ttl, source, err)
+	}
+	return uint32(parsedTTL), nil
+}
+
+// pushDNSRecord adds (or replaces) the DNS record of a provisioning step on every DNS server of the competition
+func pushDNSRecord(ctx context.Context, logger *logging.Logger, entStep *ent.ProvisioningStep) error {
+	entDNSRecord, updates, err := dnsRecordUpdates(ctx, entStep)
+	if err != nil {
+		return err
+	}
+	if entDNSRecord.Disabled {
+		logger.Log.Debugf("skipping disabled DNS record %s", entDNSRecord.HclID)
+		return nil
+	}
+	for _, update := range updates {
+		for _, server := range update.servers {
+			logger.Log.WithFields(logrus.Fields{
+				"server": server.Address,
+				"zone":   update.zone,
+				"name":   update.record.Name,
+				"type":   update.record.Type,
+			}).Debug("pushing DNS record")
+			err = dnsupdate.Upsert(ctx, server, update.zone, update.record)
+			if err != nil {
+				return fmt.Errorf("error pushing DNS record %s: %v", entDNSRecord.HclID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// removeDNSRecord deletes the DNS record of a provisioning step from every DNS server of the competition.
+// A competition without DNS has nothing to remove.
+func removeDNSRecord(ctx context.Context, logger *logging.Logger, entStep *ent.ProvisioningStep) error {
+	entDNSRecord, updates, err := dnsRecordUpdates(ctx, entStep)
+	if errors.Is(err, errNoDNS) {
+		logger.Log.Debugf("no DNS to remove DNS record %s from", entDNSRecord.HclID)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if entDNSRecord.Disabled {
+		return nil
+	}
+	for _, update := range updates {
+		for _, server := range update.servers {
+			logger.Log.WithFields(logrus.Fields{
+				"server": server.Address,
+				"zone":   update.zone,
+				"name":   update.record.Name,
+				"type":   update.record.Type,
+			}).Debug("removing DNS record")
+			err = dnsupdate.Remove(ctx, server, update.zone, update.record)
+			if err != nil {
+				return fmt.Errorf("error removing DNS record %s: %v", entDNSRecord.HclID, err)
+			}
+		}
+	}
+	return nil
+}
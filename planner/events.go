@@ -0,0 +1,112 @@
+package planner
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// eventFallbackInterval is how often a waiting plan node/step re-checks the database on its own,
+// in case an update was made somewhere that doesn't notify (or redis dropped the message)
+const eventFallbackInterval = 30 * time.Second
+
+// eventBus wakes up the build routines waiting on a status or agent task when it is updated
+type eventBus struct {
+	mu      sync.Mutex
+	waiters map[string]map[chan struct{}]bool
+}
+
+var (
+	events         = &eventBus{waiters: map[string]map[chan struct{}]bool{}}
+	redisEventOnce sync.Once
+)
+
+// subscribe returns a channel that receives whenever one of the ids is updated. Wake ups are
+// coalesced, the caller has to re-check the state of every id after receiving.
+func (bus *eventBus) subscribe(ids ...uuid.UUID) (<-chan struct{}, func()) {
+	wake := make(chan struct{}, 1)
+	bus.mu.Lock()
+	for _, id := range ids {
+		if bus.waiters[id.String()] == nil {
+			bus.waiters[id.String()] = map[chan struct{}]bool{}
+		}
+		bus.waiters[id.String()][wake] = true
+	}
+	bus.mu.Unlock()
+	unsubscribe := func() {
+		bus.mu.Lock()
+		defer bus.mu.Unlock()
+		for _, id := range ids {
+			delete(bus.waiters[id.String()], wake)
+			if len(bus.waiters[id.String()]) == 0 {
+				delete(bus.waiters, id.String())
+			}
+		}
+	}
+	return wake, unsubscribe
+}
+
+func (bus *eventBus) notify(id string) {
+	bus.mu.Lock()
+	defer bus.mu.Unlock()
+	for wake := range bus.waiters[id] {
+		select {
+		case wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Notify wakes up the build routines waiting on the status or agent task with the given ID.
+// Updates made in another process reach them through the redis "updatedStatus" and
+// "updatedAgentTask" channels instead.
+func Notify(id uuid.UUID) {
+	events.notify(id.String())
+}
+
+// publishStatus tells the GraphQL subscriptions and the waiting build routines that a status was updated
+func publishStatus(ctx context.Context, statusID uuid.UUID) {
+	rdb.Publish(ctx, "updatedStatus", statusID.String())
+	events.notify(statusID.String())
+}
+
+// listenForRedisEvents forwards the status and agent task updates published to redis to the event bus
+func listenForRedisEvents() {
+	ctx := context.Background()
+	sub := rdb.Subscribe(ctx, "updatedStatus", "updatedAgentTask")
+	_, err := sub.Receive(ctx)
+	if err != nil {
+		// The subscription reconnects on its own, until then waiting routines fall back to polling
+		logrus.Warnf("error subscribing to redis status updates: %v", err)
+	}
+	go func() {
+		for message := range sub.Channel() {
+			events.notify(message.Payload)
+		}
+	}()
+}
+
+// waitForUpdates calls check every time one of ids is updated until it returns done (or an error)
+func waitForUpdates(ctx context.Context, ids []uuid.UUID, check func() (done bool, err error)) error {
+	redisEventOnce.Do(listenForRedisEvents)
+	// Subscribe before the first check so an update made in between isn't missed
+	wake, unsubscribe := events.subscribe(ids...)
+	defer unsubscribe()
+	fallback := time.NewTicker(eventFallbackInterval)
+	defer fallback.Stop()
+	for {
+		done, err := check()
+		if err != nil || done {
+			return err
+		}
+		select {
+		case <-wake:
+		case <-fallback.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
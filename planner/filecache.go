@@ -0,0 +1,149 @@
+package planner
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gen0cide/laforge/logging"
+	"github.com/google/uuid"
+)
+
+// remoteFileCacheDir holds the remote file_download sources, each one named by the SHA-256 of its content
+var remoteFileCacheDir = path.Join("builds", "cache")
+
+var remoteFileClient = &http.Client{Timeout: 30 * time.Minute}
+
+// remoteFileKey is a remote source as used by a build, every build fetches its sources again (unless pinned)
+type remoteFileKey struct {
+	buildID uuid.UUID
+	source  string
+}
+
+// remoteFetch is a fetch of a remote source shared by all the hosts of a build that download it
+type remoteFetch struct {
+	done chan struct{}
+	path string
+	err  error
+}
+
+var (
+	remoteFetchesMu sync.Mutex
+	remoteFetches   = map[remoteFileKey]*remoteFetch{}
+)
+
+// cacheRemoteFile fetches a remote file_download source into the content-addressed cache once per build
+// and returns the path of the cached copy. When a SHA-256 is pinned the cached copy is used as is if there
+// is one, and a fetched source with another hash is rejected.
+func cacheRemoteFile(ctx context.Context, logger *logging.Logger, buildID uuid.UUID, source string, pinnedSha256 string) (string, error) {
+	pinnedSha256 = strings.ToLower(pinnedSha256)
+	if pinnedSha256 != "" {
+		cachedPath, err := filepath.Abs(path.Join(remoteFileCacheDir, pinnedSha256))
+		if err != nil {
+			return "", err
+		}
+		if _, err := os.Stat(cachedPath); err == nil {
+			return cachedPath, nil
+		}
+	}
+
+	key := remoteFileKey{buildID: buildID, source: source}
+	remoteFetchesMu.Lock()
+	fetch, started := remoteFetches[key]
+	if !started {
+		fetch = &remoteFetch{done: make(chan struct{})}
+		remoteFetches[key] = fetch
+	}
+	remoteFetchesMu.Unlock()
+
+	if started {
+		select {
+		case <-fetch.done:
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	} else {
+		fetch.path, fetch.err = fetchRemoteFile(ctx, logger, source)
+		if fetch.err != nil {
+			// Let the next host of the build try again
+			remoteFetchesMu.Lock()
+			delete(remoteFetches, key)
+			remoteFetchesMu.Unlock()
+		}
+		close(fetch.done)
+	}
+	if fetch.err != nil {
+		return "", fetch.err
+	}
+	if pinnedSha256 != "" && filepath.Base(fetch.path) != pinnedSha256 {
+		return "", fmt.Errorf("%s has SHA-256 %s, expected %s", source, filepath.Base(fetch.path), pinnedSha256)
+	}
+	return fetch.path, nil
+}
+
+// forgetRemoteFiles drops the fetches of a build once it is done rendering its files, the cached copies stay on disk
+func forgetRemoteFiles(buildID uuid.UUID) {
+	remoteFetchesMu.Lock()
+	defer remoteFetchesMu.Unlock()
+	for key := range remoteFetches {
+		if key.buildID == buildID {
+			delete(remoteFetches, key)
+		}
+	}
+}
+
+// fetchRemoteFile downloads a remote source into the cache, named by the SHA-256 of its content
+func fetchRemoteFile(ctx context.Context, logger *logging.Logger, source string) (string, error) {
+	logger.Log.Debugf("fetching remote file %s", source)
+	err := os.MkdirAll(remoteFileCacheDir, 0755)
+	if err != nil {
+		return "", fmt.Errorf("error creating remote file cache: %v", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+	if err != nil {
+		return "", fmt.Errorf("error fetching %s: %v", source, err)
+	}
+	resp, err := remoteFileClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error fetching %s: %v", source, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("error fetching %s: %s", source, resp.Status)
+	}
+
+	tmpFile, err := ioutil.TempFile(remoteFileCacheDir, "fetch-")
+	if err != nil {
+		return "", fmt.Errorf("error creating remote file cache entry: %v", err)
+	}
+	defer os.Remove(tmpFile.Name())
+	hash := sha256.New()
+	_, err = io.Copy(io.MultiWriter(tmpFile, hash), resp.Body)
+	closeErr := tmpFile.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", fmt.Errorf("error fetching %s: %v", source, err)
+	}
+
+	cachedPath, err := filepath.Abs(path.Join(remoteFileCacheDir, fmt.Sprintf("%x", hash.Sum(nil))))
+	if err != nil {
+		return "", err
+	}
+	err = os.Rename(tmpFile.Name(), cachedPath)
+	if err != nil {
+		return "", fmt.Errorf("error caching %s: %v", source, err)
+	}
+	logger.Log.Debugf("cached remote file %s as %s", source, cachedPath)
+	return cachedPath, nil
+}
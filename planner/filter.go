@@ -0,0 +1,229 @@
+package planner
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gen0cide/laforge/ent"
+	"github.com/gen0cide/laforge/ent/plan"
+	"github.com/gen0cide/laforge/ent/predicate"
+	"github.com/gen0cide/laforge/ent/provisionedhost"
+	"github.com/gen0cide/laforge/ent/provisionednetwork"
+	"github.com/gen0cide/laforge/ent/provisioningstep"
+	"github.com/gen0cide/laforge/ent/team"
+	"github.com/google/uuid"
+)
+
+// BuildFilter restricts a build to some of its teams and hosts. A host is selected when its HCL ID, the HCL ID
+// of its network or one of its tags ("key" or "key=value") is listed. The hosts a selected host depends on
+// are always pulled in. Empty lists don't restrict anything, a nil filter selects the whole environment.
+type BuildFilter struct {
+	Teams    []int    `json:"teams,omitempty"`
+	Networks []string `json:"networks,omitempty"`
+	Hosts    []string `json:"hosts,omitempty"`
+	HostTags []string `json:"hostTags,omitempty"`
+}
+
+// selectedHosts are network HCL ID -> host HCL ID, nil when every host is selected
+type selectedHosts map[string]map[string]bool
+
+func (s selectedHosts) includes(networkHclID string, hostHclID string) bool {
+	return s == nil || s[networkHclID][hostHclID]
+}
+
+func (s selectedHosts) includesNetwork(networkHclID string) bool {
+	return s == nil || len(s[networkHclID]) > 0
+}
+
+func (f *BuildFilter) filtersTeams() bool {
+	return f != nil && len(f.Teams) > 0
+}
+
+func (f *BuildFilter) filtersHosts() bool {
+	return f != nil && (len(f.Networks) > 0 || len(f.Hosts) > 0 || len(f.HostTags) > 0)
+}
+
+// teamNumbers returns the team numbers of the environment the filter selects
+func (f *BuildFilter) teamNumbers(entEnvironment *ent.Environment) []int {
+	if !f.filtersTeams() {
+		teamNumbers := make([]int, 0, entEnvironment.TeamCount)
+		for teamNumber := 0; teamNumber < entEnvironment.TeamCount; teamNumber++ {
+			teamNumbers = append(teamNumbers, teamNumber)
+		}
+		return teamNumbers
+	}
+	return f.Teams
+}
+
+func (f *BuildFilter) matchesHost(entNetwork *ent.Network, entHost *ent.Host) bool {
+	for _, networkHclID := range f.Networks {
+		if networkHclID == entNetwork.HclID {
+			return true
+		}
+	}
+	for _, hostHclID := range f.Hosts {
+		if hostHclID == entHost.HclID {
+			return true
+		}
+	}
+	for _, tag := range f.HostTags {
+		key, value, hasValue := tag, "", false
+		if i := strings.Index(tag, "="); i >= 0 {
+			key, value, hasValue = tag[:i], tag[i+1:], true
+		}
+		hostValue, exists := entHost.Tags[key]
+		if exists && (!hasValue || hostValue == value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks that the filter's teams are in the environment and that it selects at least one host
+func (f *BuildFilter) Validate(ctx context.Context, entEnvironment *ent.Environment) error {
+	if f == nil {
+		return nil
+	}
+	for _, teamNumber := range f.Teams {
+		if teamNumber < 0 || teamNumber >= entEnvironment.TeamCount {
+			return fmt.Errorf("team %d is not in environment %s (team_count %d)", teamNumber, entEnvironment.HclID, entEnvironment.TeamCount)
+		}
+	}
+	selected, err := f.selectHosts(ctx, entEnvironment)
+	if err != nil {
+		return err
+	}
+	if selected != nil && len(selected) == 0 {
+		return fmt.Errorf("build filter doesn't select any host of environment %s", entEnvironment.HclID)
+	}
+	return nil
+}
+
+// selectHosts returns the hosts of the environment the filter selects along with the hosts they depend on
+func (f *BuildFilter) selectHosts(ctx context.Context, entEnvironment *ent.Environment) (selectedHosts, error) {
+	if !f.filtersHosts() {
+		return nil, nil
+	}
+	selected := selectedHosts{}
+	var addHost func(networkHclID string, entHost *ent.Host) error
+	addHost = func(networkHclID string, entHost *ent.Host) error {
+		if selected[networkHclID][entHost.HclID] {
+			return nil
+		}
+		if selected[networkHclID] == nil {
+			selected[networkHclID] = map[string]bool{}
+		}
+		selected[networkHclID][entHost.HclID] = true
+		entHostDependencies, err := entHost.QueryDependByHostToHostDependency().
+			WithHostDependencyToDependOnHost().
+			WithHostDependencyToNetwork().
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("error querying dependencies of host %s: %v", entHost.HclID, err)
+		}
+		for _, entHostDependency := range entHostDependencies {
+			err = addHost(entHostDependency.Edges.HostDependencyToNetwork.HclID, entHostDependency.Edges.HostDependencyToDependOnHost)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	entNetworks, err := entEnvironment.QueryEnvironmentToNetwork().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error querying networks of environment %s: %v", entEnvironment.HclID, err)
+	}
+	for _, entNetwork := range entNetworks {
+		entHosts, err := entNetwork.QueryNetworkToIncludedNetwork().QueryIncludedNetworkToHost().All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error querying hosts of network %s: %v", entNetwork.HclID, err)
+		}
+		for _, entHost := range entHosts {
+			if !f.matchesHost(entNetwork, entHost) {
+				continue
+			}
+			err = addHost(entNetwork.HclID, entHost)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return selected, nil
+}
+
+// filterPlans returns the IDs of the plans of a build the filter selects: the plans of the selected hosts and
+// their steps, and every plan these wait on (their networks, teams, the hosts they depend on and the build)
+func (f *BuildFilter) filterPlans(ctx context.Context, entBuild *ent.Build) (map[uuid.UUID]bool, error) {
+	entEnvironment, err := entBuild.QueryBuildToEnvironment().Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error querying environment of build: %v", err)
+	}
+	selected, err := f.selectHosts(ctx, entEnvironment)
+	if err != nil {
+		return nil, err
+	}
+	// Builds can have more teams than the environment once they are scaled
+	teamNumbers := f.teamNumbers(entEnvironment)
+	if !f.filtersTeams() {
+		teamNumbers, err = entBuild.QueryBuildToTeam().Select(team.FieldTeamNumber).Ints(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error querying teams of build: %v", err)
+		}
+	}
+	teamPredicate := team.TeamNumberIn(teamNumbers...)
+
+	entProvisionedHosts, err := entBuild.QueryBuildToProvisionedNetwork().
+		Where(provisionednetwork.HasProvisionedNetworkToTeamWith(teamPredicate)).
+		QueryProvisionedNetworkToProvisionedHost().
+		WithProvisionedHostToHost().
+		WithProvisionedHostToProvisionedNetwork(func(q *ent.ProvisionedNetworkQuery) {
+			q.WithProvisionedNetworkToNetwork()
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error querying provisioned hosts of build: %v", err)
+	}
+	provisionedHostIDs := []uuid.UUID{}
+	for _, entProvisionedHost := range entProvisionedHosts {
+		networkHclID := entProvisionedHost.Edges.ProvisionedHostToProvisionedNetwork.Edges.ProvisionedNetworkToNetwork.HclID
+		if selected.includes(networkHclID, entProvisionedHost.Edges.ProvisionedHostToHost.HclID) {
+			provisionedHostIDs = append(provisionedHostIDs, entProvisionedHost.ID)
+		}
+	}
+
+	planPredicates := []predicate.Plan{
+		plan.HasPlanToProvisionedHostWith(provisionedhost.IDIn(provisionedHostIDs...)),
+		plan.HasPlanToProvisioningStepWith(
+			provisioningstep.HasProvisioningStepToProvisionedHostWith(provisionedhost.IDIn(provisionedHostIDs...)),
+		),
+	}
+	if !f.filtersHosts() {
+		// Teams (and networks) without hosts still get built
+		planPredicates = append(planPredicates,
+			plan.HasPlanToTeamWith(teamPredicate),
+			plan.HasPlanToProvisionedNetworkWith(provisionednetwork.HasProvisionedNetworkToTeamWith(teamPredicate)),
+		)
+	}
+	entPlans, err := entBuild.QueryBuildToPlan().Where(plan.Or(planPredicates...)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error querying plans of build: %v", err)
+	}
+
+	planIDs := map[uuid.UUID]bool{}
+	for len(entPlans) > 0 {
+		entPlan := entPlans[0]
+		entPlans = entPlans[1:]
+		if planIDs[entPlan.ID] {
+			continue
+		}
+		planIDs[entPlan.ID] = true
+		prevPlans, err := entPlan.QueryPrevPlan().All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error querying previous plans of plan %s: %v", entPlan.ID, err)
+		}
+		entPlans = append(entPlans, prevPlans...)
+	}
+	return planIDs, nil
+}
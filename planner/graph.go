@@ -0,0 +1,312 @@
+package planner
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gen0cide/laforge/ent"
+	"github.com/gen0cide/laforge/ent/status"
+	"github.com/google/uuid"
+)
+
+// Plan graph export formats
+const (
+	PlanGraphDOT     = "dot"
+	PlanGraphMermaid = "mermaid"
+	PlanGraphJSON    = "json"
+)
+
+// PlanGraph is the plan DAG of a build, edges go from a plan to the plans waiting on it
+type PlanGraph struct {
+	BuildID uuid.UUID       `json:"build_id"`
+	Nodes   []PlanGraphNode `json:"nodes"`
+	Edges   []PlanGraphEdge `json:"edges"`
+}
+
+// PlanGraphNode is a single plan of the graph. Team is nil for the build's own plan, ProvisionedNetwork and
+// ProvisionedHost are set for the plans of (and under) a network or host.
+type PlanGraphNode struct {
+	ID                 uuid.UUID  `json:"id"`
+	Type               string     `json:"type"`
+	StepNumber         int        `json:"step_number"`
+	State              string     `json:"state"`
+	Team               *int       `json:"team,omitempty"`
+	ProvisionedNetwork *uuid.UUID `json:"provisioned_network,omitempty"`
+	ProvisionedHost    *uuid.UUID `json:"provisioned_host,omitempty"`
+	Label              string     `json:"label"`
+
+	// startedAt and endedAt are the times of the plan's status, durationKey groups the plans that take
+	// about as long as each other across builds (see GetBuildProgress)
+	startedAt   time.Time
+	endedAt     time.Time
+	durationKey string
+}
+
+// PlanGraphEdge is a PrevPlan -> NextPlan link
+type PlanGraphEdge struct {
+	From uuid.UUID `json:"from"`
+	To   uuid.UUID `json:"to"`
+}
+
+// stateColors are the fill colors of the plan states in the DOT and Mermaid exports
+var stateColors = map[string]string{
+	status.StatePLANNING.String():         "#e0e0e0",
+	status.StateAWAITING.String():         "#fff3b0",
+	status.StatePARENTAWAITING.String():   "#fff3b0",
+	status.StateINPROGRESS.String():       "#9ecbff",
+	status.StateFAILED.String():           "#ff9e9e",
+	status.StateCOMPLETE.String():         "#a8e6a1",
+	status.StateTAINTED.String():          "#ffc58a",
+	status.StateTODELETE.String():         "#d7b8f3",
+	status.StateDELETEINPROGRESS.String(): "#d7b8f3",
+	status.StateDELETED.String():          "#bdbdbd",
+	status.StateTOREBUILD.String():        "#d7b8f3",
+}
+
+// ExportPlanGraph renders the plan DAG of a build in the given format (dot, mermaid or json).
+// A filter restricts the graph to the plans of its teams and hosts and the plans they wait on.
+func ExportPlanGraph(ctx context.Context, entBuild *ent.Build, filter *BuildFilter, format string) (string, error) {
+	graph, err := BuildPlanGraph(ctx, entBuild, filter)
+	if err != nil {
+		return "", err
+	}
+	switch strings.ToLower(format) {
+	case PlanGraphDOT:
+		return graph.DOT(), nil
+	case PlanGraphMermaid:
+		return graph.Mermaid(), nil
+	case PlanGraphJSON:
+		graphJSON, err := json.MarshalIndent(graph, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("error marshalling plan graph: %v", err)
+		}
+		return string(graphJSON), nil
+	default:
+		return "", fmt.Errorf("unknown plan graph format \"%s\"", format)
+	}
+}
+
+// BuildPlanGraph loads the plan DAG of a build, the nodes are ordered by step number
+func BuildPlanGraph(ctx context.Context, entBuild *ent.Build, filter *BuildFilter) (*PlanGraph, error) {
+	var planIDs map[uuid.UUID]bool
+	if filter != nil {
+		var err error
+		planIDs, err = filter.filterPlans(ctx, entBuild)
+		if err != nil {
+			return nil, err
+		}
+	}
+	withProvisionedNetwork := func(q *ent.ProvisionedNetworkQuery) {
+		q.WithProvisionedNetworkToTeam().WithProvisionedNetworkToNetwork()
+	}
+	withProvisionedHost := func(q *ent.ProvisionedHostQuery) {
+		q.WithProvisionedHostToHost().WithProvisionedHostToProvisionedNetwork(withProvisionedNetwork)
+	}
+	entPlans, err := entBuild.QueryBuildToPlan().
+		WithPlanToStatus().
+		WithNextPlan().
+		WithPlanToTeam().
+		WithPlanToProvisionedNetwork(withProvisionedNetwork).
+		WithPlanToProvisionedHost(withProvisionedHost).
+		WithPlanToProvisioningStep(func(q *ent.ProvisioningStepQuery) {
+			q.WithProvisioningStepToProvisionedHost(withProvisionedHost).
+				WithProvisioningStepToScript().
+				WithProvisioningStepToCommand()
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error querying plans of build: %v", err)
+	}
+
+	graph := &PlanGraph{
+		BuildID: entBuild.ID,
+		Nodes:   []PlanGraphNode{},
+		Edges:   []PlanGraphEdge{},
+	}
+	for _, entPlan := range entPlans {
+		if planIDs != nil && !planIDs[entPlan.ID] {
+			continue
+		}
+		node := PlanGraphNode{
+			ID:         entPlan.ID,
+			Type:       entPlan.Type.String(),
+			StepNumber: entPlan.StepNumber,
+		}
+		if entPlan.Edges.PlanToStatus != nil {
+			node.State = entPlan.Edges.PlanToStatus.State.String()
+			node.startedAt = entPlan.Edges.PlanToStatus.StartedAt
+			node.endedAt = entPlan.Edges.PlanToStatus.EndedAt
+		}
+		node.Label, node.Team = planGraphLabel(entPlan)
+		node.ProvisionedNetwork, node.ProvisionedHost, node.durationKey = planGraphScope(entPlan)
+		graph.Nodes = append(graph.Nodes, node)
+		for _, nextPlan := range entPlan.Edges.NextPlan {
+			if planIDs != nil && !planIDs[nextPlan.ID] {
+				continue
+			}
+			graph.Edges = append(graph.Edges, PlanGraphEdge{From: entPlan.ID, To: nextPlan.ID})
+		}
+	}
+	sort.Slice(graph.Nodes, func(i, j int) bool {
+		a, b := graph.Nodes[i], graph.Nodes[j]
+		if a.StepNumber != b.StepNumber {
+			return a.StepNumber < b.StepNumber
+		}
+		if a.Label != b.Label {
+			return a.Label < b.Label
+		}
+		return a.ID.String() < b.ID.String()
+	})
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		a, b := graph.Edges[i], graph.Edges[j]
+		if a.From != b.From {
+			return a.From.String() < b.From.String()
+		}
+		return a.To.String() < b.To.String()
+	})
+	return graph, nil
+}
+
+// planGraphLabel describes what the plan provisions along with its team number
+func planGraphLabel(entPlan *ent.Plan) (string, *int) {
+	teamOf := func(entProvisionedNetwork *ent.ProvisionedNetwork) *int {
+		if entProvisionedNetwork == nil || entProvisionedNetwork.Edges.ProvisionedNetworkToTeam == nil {
+			return nil
+		}
+		return &entProvisionedNetwork.Edges.ProvisionedNetworkToTeam.TeamNumber
+	}
+	hostLabel := func(entProvisionedHost *ent.ProvisionedHost) (string, *int) {
+		if entProvisionedHost == nil || entProvisionedHost.Edges.ProvisionedHostToHost == nil {
+			return "host", nil
+		}
+		teamNumber := teamOf(entProvisionedHost.Edges.ProvisionedHostToProvisionedNetwork)
+		label := entProvisionedHost.Edges.ProvisionedHostToHost.Hostname
+		if teamNumber != nil {
+			label = fmt.Sprintf("team %d %s", *teamNumber, label)
+		}
+		return label, teamNumber
+	}
+
+	switch {
+	case entPlan.Edges.PlanToTeam != nil:
+		return fmt.Sprintf("team %d", entPlan.Edges.PlanToTeam.TeamNumber), &entPlan.Edges.PlanToTeam.TeamNumber
+	case entPlan.Edges.PlanToProvisionedNetwork != nil:
+		entProvisionedNetwork := entPlan.Edges.PlanToProvisionedNetwork
+		teamNumber := teamOf(entProvisionedNetwork)
+		if teamNumber == nil {
+			return entProvisionedNetwork.Name, nil
+		}
+		return fmt.Sprintf("team %d %s", *teamNumber, entProvisionedNetwork.Name), teamNumber
+	case entPlan.Edges.PlanToProvisionedHost != nil:
+		return hostLabel(entPlan.Edges.PlanToProvisionedHost)
+	case entPlan.Edges.PlanToProvisioningStep != nil:
+		entProvisioningStep := entPlan.Edges.PlanToProvisioningStep
+		label, teamNumber := hostLabel(entProvisioningStep.Edges.ProvisioningStepToProvisionedHost)
+		return fmt.Sprintf("%s step %d (%s)", label, entProvisioningStep.StepNumber, entProvisioningStep.Type), teamNumber
+	default:
+		return "build", nil
+	}
+}
+
+// planGraphScope returns the provisioned network and host the plan belongs to, along with its duration key:
+// the HCL ID of the network, host, script or command it provisions
+func planGraphScope(entPlan *ent.Plan) (*uuid.UUID, *uuid.UUID, string) {
+	hostScope := func(entProvisionedHost *ent.ProvisionedHost) (*uuid.UUID, *uuid.UUID) {
+		if entProvisionedHost == nil {
+			return nil, nil
+		}
+		var networkID *uuid.UUID
+		if entProvisionedHost.Edges.ProvisionedHostToProvisionedNetwork != nil {
+			networkID = &entProvisionedHost.Edges.ProvisionedHostToProvisionedNetwork.ID
+		}
+		return networkID, &entProvisionedHost.ID
+	}
+
+	switch {
+	case entPlan.Edges.PlanToProvisionedNetwork != nil:
+		entProvisionedNetwork := entPlan.Edges.PlanToProvisionedNetwork
+		durationKey := ""
+		if entProvisionedNetwork.Edges.ProvisionedNetworkToNetwork != nil {
+			durationKey = "network:" + entProvisionedNetwork.Edges.ProvisionedNetworkToNetwork.HclID
+		}
+		return &entProvisionedNetwork.ID, nil, durationKey
+	case entPlan.Edges.PlanToProvisionedHost != nil:
+		entProvisionedHost := entPlan.Edges.PlanToProvisionedHost
+		networkID, hostID := hostScope(entProvisionedHost)
+		durationKey := ""
+		if entProvisionedHost.Edges.ProvisionedHostToHost != nil {
+			durationKey = "host:" + entProvisionedHost.Edges.ProvisionedHostToHost.HclID
+		}
+		return networkID, hostID, durationKey
+	case entPlan.Edges.PlanToProvisioningStep != nil:
+		entProvisioningStep := entPlan.Edges.PlanToProvisioningStep
+		networkID, hostID := hostScope(entProvisioningStep.Edges.ProvisioningStepToProvisionedHost)
+		durationKey := ""
+		if entProvisioningStep.Edges.ProvisioningStepToScript != nil {
+			durationKey = "script:" + entProvisioningStep.Edges.ProvisioningStepToScript.HclID
+		} else if entProvisioningStep.Edges.ProvisioningStepToCommand != nil {
+			durationKey = "command:" + entProvisioningStep.Edges.ProvisioningStepToCommand.HclID
+		}
+		return networkID, hostID, durationKey
+	default:
+		return nil, nil, ""
+	}
+}
+
+// DOT renders the graph for Graphviz, nodes are filled by state
+func (g *PlanGraph) DOT() string {
+	escape := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "digraph \"build %s\" {\n", g.BuildID)
+	sb.WriteString("  rankdir=LR;\n")
+	sb.WriteString("  node [shape=box, style=\"rounded,filled\", fontname=\"Helvetica\"];\n")
+	for _, node := range g.Nodes {
+		fmt.Fprintf(&sb, "  \"%s\" [label=\"%s\\n%s | %s\", fillcolor=\"%s\"];\n",
+			node.ID, escape.Replace(node.Label), node.Type, node.State, planGraphColor(node.State))
+	}
+	for _, edge := range g.Edges {
+		fmt.Fprintf(&sb, "  \"%s\" -> \"%s\";\n", edge.From, edge.To)
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// Mermaid renders the graph as a Mermaid flowchart, nodes are styled by state
+func (g *PlanGraph) Mermaid() string {
+	escape := strings.NewReplacer(`"`, "#quot;")
+	// Mermaid IDs can't be UUIDs, number the nodes in order instead
+	nodeIDs := map[uuid.UUID]string{}
+	var sb strings.Builder
+	sb.WriteString("flowchart LR\n")
+	for i, node := range g.Nodes {
+		nodeIDs[node.ID] = fmt.Sprintf("p%d", i)
+		fmt.Fprintf(&sb, "  %s[\"%s<br/>%s | %s\"]", nodeIDs[node.ID], escape.Replace(node.Label), node.Type, node.State)
+		if _, ok := stateColors[node.State]; ok {
+			fmt.Fprintf(&sb, ":::%s", strings.ToLower(node.State))
+		}
+		sb.WriteString("\n")
+	}
+	for _, edge := range g.Edges {
+		fmt.Fprintf(&sb, "  %s --> %s\n", nodeIDs[edge.From], nodeIDs[edge.To])
+	}
+	states := make([]string, 0, len(stateColors))
+	for state := range stateColors {
+		states = append(states, state)
+	}
+	sort.Strings(states)
+	for _, state := range states {
+		fmt.Fprintf(&sb, "  classDef %s fill:%s\n", strings.ToLower(state), stateColors[state])
+	}
+	return sb.String()
+}
+
+func planGraphColor(state string) string {
+	if color, ok := stateColors[state]; ok {
+		return color
+	}
+	return "#ffffff"
+}
@@ -12,18 +12,12 @@ import (
 	"strings"
 	"sync"
 	"text/template"
-	"time"
 
 	"github.com/gen0cide/laforge/ent"
 	"github.com/gen0cide/laforge/ent/build"
 	"github.com/gen0cide/laforge/ent/buildcommit"
-	"github.com/gen0cide/laforge/ent/command"
 	"github.com/gen0cide/laforge/ent/competition"
-	"github.com/gen0cide/laforge/ent/dnsrecord"
 	"github.com/gen0cide/laforge/ent/environment"
-	"github.com/gen0cide/laforge/ent/filedelete"
-	"github.com/gen0cide/laforge/ent/filedownload"
-	"github.com/gen0cide/laforge/ent/fileextract"
 	"github.com/gen0cide/laforge/ent/host"
 	"github.com/gen0cide/laforge/ent/network"
 	"github.com/gen0cide/laforge/ent/plan"
@@ -35,6 +29,7 @@ import (
 	"github.com/gen0cide/laforge/ent/status"
 	"github.com/gen0cide/laforge/ent/team"
 	"github.com/gen0cide/laforge/grpc"
+	"github.com/gen0cide/laforge/ipam"
 	"github.com/gen0cide/laforge/logging"
 	"github.com/gen0cide/laforge/server/utils"
 	"github.com/go-redis/redis/v8"
@@ -86,7 +81,7 @@ func createPlanningStatus(ctx context.Context, client *ent.Client, logger *loggi
 	return entStatus, nil
 }
 
-func CreateBuild(ctx context.Context, client *ent.Client, rdb *redis.Client, currentUser *ent.AuthUser, entEnvironment *ent.Environment) (*ent.Build, error) {
+func CreateBuild(ctx context.Context, client *ent.Client, rdb *redis.Client, currentUser *ent.AuthUser, entEnvironment *ent.Environment, filter *BuildFilter) (*ent.Build, error) {
 	taskStatus, serverTask, err := utils.CreateServerTask(ctx, client, rdb, currentUser, servertask.TypeCREATEBUILD)
 	if err != nil {
 		return nil, fmt.Errorf("error creating server task: %v", err)
@@ -180,10 +175,19 @@ func CreateBuild(ctx context.Context, client *ent.Client, rdb *redis.Client, cur
 		}
 		return nil, err
 	}
-	for teamNumber := 0; teamNumber < entEnvironment.TeamCount; teamNumber++ {
+	selected, err := filter.selectHosts(ctx, entEnvironment)
+	if err != nil {
+		logger.Log.Errorf("Failed to apply build filter. Err: %v", err)
+		_, _, err = utils.FailServerTask(ctx, client, rdb, taskStatus, serverTask, err)
+		if err != nil {
+			return nil, fmt.Errorf("error failing server task: %v", err)
+		}
+		return nil, err
+	}
+	for _, teamNumber := range filter.teamNumbers(entEnvironment) {
 		wg.Add(1)
 		go func(wg *sync.WaitGroup, teamNumber int, logger *logging.Logger, entBuild *ent.Build, client *ent.Client) {
-			_, err := createTeam(client, logger, entBuild, teamNumber, wg)
+			_, err := createTeam(client, logger, entBuild, teamNumber, selected, wg)
 			if err != nil {
 				logrus.Errorf("error creating team: %v", err)
 				logger.Log.Errorf("error creating team: %v", err)
@@ -192,6 +196,7 @@ func CreateBuild(ctx context.Context, client *ent.Client, rdb *redis.Client, cur
 	}
 
 	wg.Wait()
+	forgetRemoteFiles(entBuild.ID)
 
 	go func(wg *sync.WaitGroup, entBuild *ent.Build) {
 		wg.Wait()
@@ -199,7 +204,7 @@ func CreateBuild(ctx context.Context, client *ent.Client, rdb *redis.Client, cur
 		ctx := context.Background()
 		defer ctx.Done()
 
-		entCommit, err := utils.CreateRootCommit(client, rdb, entBuild)
+		entCommit, err := utils.CreateRootCommit(client, rdb, currentUser, entBuild)
 		if err != nil {
 			_, _, err = utils.FailServerTask(ctx, client, rdb, taskStatus, serverTask, err)
 			return
@@ -233,7 +238,7 @@ func CreateBuild(ctx context.Context, client *ent.Client, rdb *redis.Client, cur
 		// entBuild.Update().SetCompletedPlan(true).SaveX(ctx)
 
 		logger.Log.Debug("-----\nWAITING FOR COMMIT REVIEW\n-----")
-		isApproved, err := utils.WaitForCommitReview(client, entCommit, 20*time.Minute)
+		isApproved, err := utils.WaitForCommitReview(client, rdb, entCommit)
 		if err != nil {
 			logger.Log.Errorf("error while waiting for root commit to be approved: %v", err)
 			entCommit.Update().SetState(buildcommit.StateCANCELLED).Exec(ctx)
@@ -270,10 +275,10 @@ func CreateBuild(ctx context.Context, client *ent.Client, rdb *redis.Client, cur
 				logger.Log.Errorf("error creating logger for execute build: %v", err)
 				return
 			}
-			go StartBuild(client, executeLogger, currentUser, serverTask, taskStatus, entBuild)
+			go StartBuild(client, executeLogger, currentUser, serverTask, taskStatus, entBuild, nil)
 		} else {
 			logger.Log.Debug("-----\nCOMMIT CANCELLED/TIMED OUT\n-----")
-			logger.Log.Errorf("root commit has been cancelled or 20 minute timeout has been reached")
+			logger.Log.Errorf("root commit has been cancelled or the review timeout has been reached")
 			entCommit.Update().SetState(buildcommit.StateCANCELLED).Exec(ctx)
 			rdb.Publish(ctx, "updatedBuildCommit", entCommit.ID.String())
 		}
@@ -282,7 +287,7 @@ func CreateBuild(ctx context.Context, client *ent.Client, rdb *redis.Client, cur
 	return entBuild, nil
 }
 
-func createTeam(client *ent.Client, logger *logging.Logger, entBuild *ent.Build, teamNumber int, wg *sync.WaitGroup) (*ent.Team, error) {
+func createTeam(client *ent.Client, logger *logging.Logger, entBuild *ent.Build, teamNumber int, selected selectedHosts, wg *sync.WaitGroup) (*ent.Team, error) {
 	logger.Log.WithFields(logrus.Fields{
 		"teamNumber": teamNumber,
 	}).Debug("creating team")
@@ -334,11 +339,20 @@ func createTeam(client *ent.Client, logger *logging.Logger, entBuild *ent.Build,
 		return nil, err
 	}
 	createProvisonedNetworks := []*ent.ProvisionedNetwork{}
+	networkHclIDs := []string{}
 	for _, buildNetwork := range buildNetworks {
-		pNetwork, _ := createProvisionedNetworks(ctx, client, logger, entBuild, entTeam, buildNetwork)
+		// Networks without a selected host (or one they depend on) are left out of filtered builds
+		if !selected.includesNetwork(buildNetwork.HclID) {
+			continue
+		}
+		pNetwork, err := createProvisionedNetworks(ctx, client, logger, entBuild, entTeam, buildNetwork)
+		if err != nil {
+			return nil, err
+		}
 		createProvisonedNetworks = append(createProvisonedNetworks, pNetwork)
+		networkHclIDs = append(networkHclIDs, buildNetwork.HclID)
 	}
-	for _, pNetwork := range createProvisonedNetworks {
+	for i, pNetwork := range createProvisonedNetworks {
 		entHosts, err := pNetwork.
 			QueryProvisionedNetworkToNetwork().
 			QueryNetworkToIncludedNetwork().
@@ -354,6 +368,9 @@ func createTeam(client *ent.Client, logger *logging.Logger, entBuild *ent.Build,
 			return nil, err
 		}
 		for _, entHost := range entHosts {
+			if !selected.includes(networkHclIDs[i], entHost.HclID) {
+				continue
+			}
 			_, err = createProvisionedHosts(ctx, client, logger, pNetwork, entHost, networkPlan)
 			if err != nil {
 				logrus.Errorf("Failed to create provisioned hosts")
@@ -379,7 +396,8 @@ func createProvisionedNetworks(ctx context.Context, client *ent.Client, logger *
 
 	entProvisionedNetwork, err := client.ProvisionedNetwork.Create().
 		SetName(entNetwork.Name).
-		SetCidr(entNetwork.Cidr).
+		SetCidr(ipam.ForTeam(entNetwork.Cidr, entTeam.TeamNumber)).
+		SetIpv6Cidr(ipam.ForTeam(entNetwork.Ipv6Cidr, entTeam.TeamNumber)).
 		SetProvisionedNetworkToStatus(entStatus).
 		SetProvisionedNetworkToNetwork(entNetwork).
 		SetProvisionedNetworkToTeam(entTeam).
@@ -395,6 +413,11 @@ func createProvisionedNetworks(ctx context.Context, client *ent.Client, logger *
 		return nil, err
 	}
 
+	networkSum, err := networkChecksum(entNetwork)
+	if err != nil {
+		logger.Log.Errorf("Failed to hash Network %v. Err: %v", entNetwork.HclID, err)
+		return nil, err
+	}
 	entPlanStatus, err := createPlanningStatus(ctx, client, logger, status.StatusForPlan)
 	if err != nil {
 		return nil, err
@@ -403,6 +426,7 @@ func createProvisionedNetworks(ctx context.Context, client *ent.Client, logger *
 		AddPrevPlan(teamPlanNode).
 		SetType(plan.TypeProvisionNetwork).
 		SetBuildID(entBuild.ID.String()).
+		SetChecksum(networkSum).
 		SetPlanToProvisionedNetwork(entProvisionedNetwork).
 		SetPlanToBuild(entBuild).
 		SetStepNumber(teamPlanNode.StepNumber + 1).
@@ -523,6 +547,13 @@ func createProvisionedHosts(ctx context.Context, client *ent.Client, logger *log
 	if err != nil {
 		return nil, err
 	}
+	subnetIpv6 := ""
+	if pNetwork.Ipv6Cidr != "" {
+		subnetIpv6, err = CalcIP(pNetwork.Ipv6Cidr, entHost.LastOctet)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	entStatus, err := createPlanningStatus(ctx, client, logger, status.StatusForProvisionedHost)
 	if err != nil {
@@ -531,6 +562,7 @@ func createProvisionedHosts(ctx context.Context, client *ent.Client, logger *log
 
 	entProvisionedHost, err = client.ProvisionedHost.Create().
 		SetSubnetIP(subnetIP).
+		SetSubnetIpv6(subnetIpv6).
 		SetProvisionedHostToStatus(entStatus).
 		SetProvisionedHostToProvisionedNetwork(pNetwork).
 		SetProvisionedHostToHost(entHost).
@@ -549,6 +581,11 @@ func createProvisionedHosts(ctx context.Context, client *ent.Client, logger *log
 		}
 	}
 
+	hostSum, err := hostChecksum(ctx, entHost)
+	if err != nil {
+		logger.Log.Errorf("Failed to hash Host %v. Err: %v", entHost.HclID, err)
+		return nil, err
+	}
 	entPlanStatus, err := createPlanningStatus(ctx, client, logger, status.StatusForPlan)
 	if err != nil {
 		return nil, err
@@ -559,6 +596,7 @@ func createProvisionedHosts(ctx context.Context, client *ent.Client, logger *log
 		AddPrevPlan(prevPlans...).
 		SetType(plan.TypeProvisionHost).
 		SetBuildID(prevPlan.BuildID).
+		SetChecksum(hostSum).
 		SetPlanToProvisionedHost(entProvisionedHost).
 		SetStepNumber(planStepNumber).
 		SetPlanToBuild(currentBuild).
@@ -642,24 +680,10 @@ func createProvisionedHosts(ctx context.Context, client *ent.Client, logger *log
 			return nil, err
 		}
 		if RenderFiles {
-			filePath, err := renderScript(ctx, client, logger, entUserDataProvisioningStep)
-			if err != nil {
-				return nil, err
-			}
-			entTmpUrl, err := utils.CreateTempURL(ctx, client, filePath)
-			if err != nil {
-				return nil, err
-			}
-			_, err = entTmpUrl.Update().SetGinFileMiddlewareToProvisioningStep(entUserDataProvisioningStep).Save(ctx)
+			err = serveStepFile(ctx, client, logger, scriptStep{}, entUserDataProvisioningStep)
 			if err != nil {
 				return nil, err
 			}
-			if RenderFilesTask != nil {
-				RenderFilesTask, err = RenderFilesTask.Update().AddServerTaskToGinFileMiddleware(entTmpUrl).Save(ctx)
-				if err != nil {
-					return nil, err
-				}
-			}
 		}
 
 	}
@@ -690,7 +714,7 @@ func createProvisioningStep(ctx context.Context, client *ent.Client, logger *log
 		logger.Log.Errorf("Failed to Query Host for Provisoned Host %v. Err: %v", pHost.ID, err)
 		return nil, err
 	}
-	logger.Log.WithFields(logrus.Fields{
+	stepFields := logrus.Fields{
 		"pHost":               pHost.ID,
 		"pHost.HCLID":         entHost.HclID,
 		"pHost.SubnetIP":      pHost.SubnetIP,
@@ -698,8 +722,8 @@ func createProvisioningStep(ctx context.Context, client *ent.Client, logger *log
 		"prevPlan":            prevPlan.ID,
 		"prevPlan.Type":       prevPlan.Type,
 		"prevPlan.StepNumber": prevPlan.StepNumber,
-	}).Debug("creating provisioned step")
-	var entProvisioningStep *ent.ProvisioningStep
+	}
+	logger.Log.WithFields(stepFields).Debug("creating provisioned step")
 	currentEnvironment, err := pHost.QueryProvisionedHostToHost().QueryHostToEnvironment().Only(ctx)
 	currentBuild := pHost.QueryProvisionedHostToProvisionedNetwork().QueryProvisionedNetworkToBuild().WithBuildToEnvironment().OnlyX(ctx)
 	if err != nil {
@@ -710,308 +734,33 @@ func createProvisioningStep(ctx context.Context, client *ent.Client, logger *log
 	if err != nil {
 		return nil, err
 	}
-	entScript, err := client.Script.Query().Where(
-		script.And(
-			script.HasScriptToEnvironmentWith(
-				environment.IDEQ(currentEnvironment.ID),
-			),
-			script.HclIDEQ(hclID),
-		),
-	).Only(ctx)
+	entStepType, linkStep, err := resolveStep(ctx, client, currentEnvironment.ID, hclID)
 	if err != nil {
-		if err != err.(*ent.NotFoundError) {
-			logger.Log.WithFields(logrus.Fields{
-				"pHost":               pHost.ID,
-				"pHost.HCLID":         entHost.HclID,
-				"pHost.SubnetIP":      pHost.SubnetIP,
-				"stepNumber":          stepNumber,
-				"prevPlan":            prevPlan.ID,
-				"prevPlan.Type":       prevPlan.Type,
-				"prevPlan.StepNumber": prevPlan.StepNumber,
-			}).Errorf("Failed to Query Script %v. Err: %v", hclID, err)
-			return nil, err
-		} else {
-			entCommand, err := client.Command.Query().Where(
-				command.And(
-					command.HasCommandToEnvironmentWith(
-						environment.IDEQ(currentEnvironment.ID),
-					),
-					command.HclIDEQ(hclID),
-				)).Only(ctx)
-			if err != nil {
-				if err != err.(*ent.NotFoundError) {
-					logger.Log.WithFields(logrus.Fields{
-						"pHost":               pHost.ID,
-						"pHost.HCLID":         entHost.HclID,
-						"pHost.SubnetIP":      pHost.SubnetIP,
-						"stepNumber":          stepNumber,
-						"prevPlan":            prevPlan.ID,
-						"prevPlan.Type":       prevPlan.Type,
-						"prevPlan.StepNumber": prevPlan.StepNumber,
-					}).Errorf("Failed to Query Command %v. Err: %v", hclID, err)
-					return nil, err
-				} else {
-					entFileDownload, err := client.FileDownload.Query().Where(
-						filedownload.And(
-							filedownload.HasFileDownloadToEnvironmentWith(
-								environment.IDEQ(currentEnvironment.ID),
-							),
-							filedownload.HclIDEQ(hclID),
-						)).Only(ctx)
-					if err != nil {
-						if err != err.(*ent.NotFoundError) {
-							logger.Log.WithFields(logrus.Fields{
-								"pHost":               pHost.ID,
-								"pHost.HCLID":         entHost.HclID,
-								"pHost.SubnetIP":      pHost.SubnetIP,
-								"stepNumber":          stepNumber,
-								"prevPlan":            prevPlan.ID,
-								"prevPlan.Type":       prevPlan.Type,
-								"prevPlan.StepNumber": prevPlan.StepNumber,
-							}).Errorf("Failed to Query FileDownload %v. Err: %v", hclID, err)
-							return nil, err
-						} else {
-							entFileExtract, err := client.FileExtract.Query().Where(
-								fileextract.And(
-									fileextract.HasFileExtractToEnvironmentWith(
-										environment.IDEQ(currentEnvironment.ID),
-									),
-									fileextract.HclIDEQ(hclID),
-								)).Only(ctx)
-							if err != nil {
-								if err != err.(*ent.NotFoundError) {
-									logger.Log.WithFields(logrus.Fields{
-										"pHost":               pHost.ID,
-										"pHost.HCLID":         entHost.HclID,
-										"pHost.SubnetIP":      pHost.SubnetIP,
-										"stepNumber":          stepNumber,
-										"prevPlan":            prevPlan.ID,
-										"prevPlan.Type":       prevPlan.Type,
-										"prevPlan.StepNumber": prevPlan.StepNumber,
-									}).Errorf("Failed to Query FileExtract %v. Err: %v", hclID, err)
-									return nil, err
-								} else {
-									entFileDelete, err := client.FileDelete.Query().Where(
-										filedelete.And(
-											filedelete.HasFileDeleteToEnvironmentWith(
-												environment.IDEQ(currentEnvironment.ID),
-											),
-											filedelete.HclIDEQ(hclID),
-										)).Only(ctx)
-									if err != nil {
-										if err != err.(*ent.NotFoundError) {
-											logger.Log.WithFields(logrus.Fields{
-												"pHost":               pHost.ID,
-												"pHost.HCLID":         entHost.HclID,
-												"pHost.SubnetIP":      pHost.SubnetIP,
-												"stepNumber":          stepNumber,
-												"prevPlan":            prevPlan.ID,
-												"prevPlan.Type":       prevPlan.Type,
-												"prevPlan.StepNumber": prevPlan.StepNumber,
-											}).Errorf("Failed to Query FileDelete %v. Err: %v", hclID, err)
-											return nil, err
-										} else {
-											entDNSRecord, err := client.DNSRecord.Query().Where(
-												dnsrecord.And(
-													dnsrecord.HasDNSRecordToEnvironmentWith(
-														environment.IDEQ(currentEnvironment.ID),
-													),
-													dnsrecord.HclIDEQ(hclID),
-												)).Only(ctx)
-											if err != nil {
-												if err != err.(*ent.NotFoundError) {
-													logger.Log.WithFields(logrus.Fields{
-														"pHost":               pHost.ID,
-														"pHost.HCLID":         entHost.HclID,
-														"pHost.SubnetIP":      pHost.SubnetIP,
-														"stepNumber":          stepNumber,
-														"prevPlan":            prevPlan.ID,
-														"prevPlan.Type":       prevPlan.Type,
-														"prevPlan.StepNumber": prevPlan.StepNumber,
-													}).Errorf("Failed to Query FileDelete %v. Err: %v", hclID, err)
-													return nil, err
-												} else {
-													logger.Log.WithFields(logrus.Fields{
-														"pHost":               pHost.ID,
-														"pHost.HCLID":         entHost.HclID,
-														"pHost.SubnetIP":      pHost.SubnetIP,
-														"stepNumber":          stepNumber,
-														"prevPlan":            prevPlan.ID,
-														"prevPlan.Type":       prevPlan.Type,
-														"prevPlan.StepNumber": prevPlan.StepNumber,
-													}).Errorf("No Provisioning Steps found for %v. Err: %v", hclID, err)
-													return nil, err
-												}
-											} else {
-												entProvisioningStep, err = client.ProvisioningStep.Create().
-													SetStepNumber(stepNumber).
-													SetType(provisioningstep.TypeDNSRecord).SetProvisioningStepToDNSRecord(entDNSRecord).
-													SetProvisioningStepToStatus(entStatus).
-													SetProvisioningStepToProvisionedHost(pHost).
-													Save(ctx)
-												if err != nil {
-													logger.Log.WithFields(logrus.Fields{
-														"pHost":               pHost.ID,
-														"pHost.HCLID":         entHost.HclID,
-														"pHost.SubnetIP":      pHost.SubnetIP,
-														"stepNumber":          stepNumber,
-														"prevPlan":            prevPlan.ID,
-														"prevPlan.Type":       prevPlan.Type,
-														"prevPlan.StepNumber": prevPlan.StepNumber,
-													}).Errorf("Failed to Create Provisioning Step for FileDelete %v. Err: %v", hclID, err)
-													return nil, err
-												}
-											}
-										}
-									} else {
-										entProvisioningStep, err = client.ProvisioningStep.Create().
-											SetStepNumber(stepNumber).
-											SetType(provisioningstep.TypeFileDelete).SetProvisioningStepToFileDelete(entFileDelete).
-											SetProvisioningStepToStatus(entStatus).
-											SetProvisioningStepToProvisionedHost(pHost).
-											Save(ctx)
-										if err != nil {
-											logger.Log.WithFields(logrus.Fields{
-												"pHost":               pHost.ID,
-												"pHost.HCLID":         entHost.HclID,
-												"pHost.SubnetIP":      pHost.SubnetIP,
-												"stepNumber":          stepNumber,
-												"prevPlan":            prevPlan.ID,
-												"prevPlan.Type":       prevPlan.Type,
-												"prevPlan.StepNumber": prevPlan.StepNumber,
-											}).Errorf("Failed to Create Provisioning Step for FileDelete %v. Err: %v", hclID, err)
-											return nil, err
-										}
-									}
-								}
-							} else {
-								entProvisioningStep, err = client.ProvisioningStep.Create().
-									SetStepNumber(stepNumber).
-									SetType(provisioningstep.TypeFileExtract).
-									SetProvisioningStepToFileExtract(entFileExtract).
-									SetProvisioningStepToStatus(entStatus).
-									SetProvisioningStepToProvisionedHost(pHost).
-									Save(ctx)
-								if err != nil {
-									logger.Log.WithFields(logrus.Fields{
-										"pHost":               pHost.ID,
-										"pHost.HCLID":         entHost.HclID,
-										"pHost.SubnetIP":      pHost.SubnetIP,
-										"stepNumber":          stepNumber,
-										"prevPlan":            prevPlan.ID,
-										"prevPlan.Type":       prevPlan.Type,
-										"prevPlan.StepNumber": prevPlan.StepNumber,
-									}).Errorf("Failed to Create Provisioning Step for FileExtract %v. Err: %v", hclID, err)
-									return nil, err
-								}
-							}
-						}
-					} else {
-						entProvisioningStep, err = client.ProvisioningStep.Create().
-							SetStepNumber(stepNumber).
-							SetType(provisioningstep.TypeFileDownload).
-							SetProvisioningStepToFileDownload(entFileDownload).
-							SetProvisioningStepToStatus(entStatus).
-							SetProvisioningStepToProvisionedHost(pHost).
-							Save(ctx)
-						if err != nil {
-							logger.Log.WithFields(logrus.Fields{
-								"pHost":               pHost.ID,
-								"pHost.HCLID":         entHost.HclID,
-								"pHost.SubnetIP":      pHost.SubnetIP,
-								"stepNumber":          stepNumber,
-								"prevPlan":            prevPlan.ID,
-								"prevPlan.Type":       prevPlan.Type,
-								"prevPlan.StepNumber": prevPlan.StepNumber,
-							}).Errorf("Failed to Create Provisioning Step for FileDownload %v. Err: %v", hclID, err)
-							return nil, err
-						}
-						if RenderFiles {
-							filePath, err := renderFileDownload(ctx, logger, entProvisioningStep)
-							if err != nil {
-								return nil, err
-							}
-							entTmpUrl, err := utils.CreateTempURL(ctx, client, filePath)
-							if err != nil {
-								return nil, err
-							}
-							_, err = entTmpUrl.Update().SetGinFileMiddlewareToProvisioningStep(entProvisioningStep).Save(ctx)
-							if err != nil {
-								return nil, err
-							}
-							if RenderFilesTask != nil {
-								RenderFilesTask, err = RenderFilesTask.Update().AddServerTaskToGinFileMiddleware(entTmpUrl).Save(ctx)
-								if err != nil {
-									return nil, err
-								}
-							}
-						}
-					}
-				}
-			} else {
-				entProvisioningStep, err = client.ProvisioningStep.Create().
-					SetStepNumber(stepNumber).
-					SetType(provisioningstep.TypeCommand).
-					SetProvisioningStepToCommand(entCommand).
-					SetProvisioningStepToStatus(entStatus).
-					SetProvisioningStepToProvisionedHost(pHost).
-					Save(ctx)
-				if err != nil {
-					logger.Log.WithFields(logrus.Fields{
-						"pHost":               pHost.ID,
-						"pHost.HCLID":         entHost.HclID,
-						"pHost.SubnetIP":      pHost.SubnetIP,
-						"stepNumber":          stepNumber,
-						"prevPlan":            prevPlan.ID,
-						"prevPlan.Type":       prevPlan.Type,
-						"prevPlan.StepNumber": prevPlan.StepNumber,
-					}).Errorf("Failed to Create Provisioning Step for Command %v. Err: %v", hclID, err)
-					return nil, err
-				}
-			}
-		}
-	} else {
-		entProvisioningStep, err = client.ProvisioningStep.Create().
-			SetStepNumber(stepNumber).
-			SetType(provisioningstep.TypeScript).
-			SetProvisioningStepToScript(entScript).
-			SetProvisioningStepToStatus(entStatus).
-			SetProvisioningStepToProvisionedHost(pHost).
-			Save(ctx)
+		logger.Log.WithFields(stepFields).Errorf("Failed to Resolve Provisioning Step %v. Err: %v", hclID, err)
+		return nil, err
+	}
+	entProvisioningStep, err := linkStep(client.ProvisioningStep.Create().
+		SetStepNumber(stepNumber).
+		SetType(entStepType.Type()).
+		SetProvisioningStepToStatus(entStatus).
+		SetProvisioningStepToProvisionedHost(pHost)).
+		Save(ctx)
+	if err != nil {
+		logger.Log.WithFields(stepFields).Errorf("Failed to Create Provisioning Step for %v %v. Err: %v", entStepType.Type(), hclID, err)
+		return nil, err
+	}
+	if RenderFiles {
+		err = serveStepFile(ctx, client, logger, entStepType, entProvisioningStep)
 		if err != nil {
-			logger.Log.WithFields(logrus.Fields{
-				"pHost":               pHost.ID,
-				"pHost.HCLID":         entHost.HclID,
-				"pHost.SubnetIP":      pHost.SubnetIP,
-				"stepNumber":          stepNumber,
-				"prevPlan":            prevPlan.ID,
-				"prevPlan.Type":       prevPlan.Type,
-				"prevPlan.StepNumber": prevPlan.StepNumber,
-			}).Errorf("Failed to Create Provisioning Step for Script %v. Err: %v", hclID, err)
 			return nil, err
 		}
-		if RenderFiles {
-			filePath, err := renderScript(ctx, client, logger, entProvisioningStep)
-			if err != nil {
-				return nil, err
-			}
-			entTmpUrl, err := utils.CreateTempURL(ctx, client, filePath)
-			if err != nil {
-				return nil, err
-			}
-			_, err = entTmpUrl.Update().SetGinFileMiddlewareToProvisioningStep(entProvisioningStep).Save(ctx)
-			if err != nil {
-				return nil, err
-			}
-			if RenderFilesTask != nil {
-				RenderFilesTask, err = RenderFilesTask.Update().AddServerTaskToGinFileMiddleware(entTmpUrl).Save(ctx)
-				if err != nil {
-					return nil, err
-				}
-			}
-		}
 	}
 
+	stepSum, err := entStepType.Checksum(ctx, entProvisioningStep)
+	if err != nil {
+		logger.Log.WithFields(stepFields).Errorf("Failed to hash Provisioning Step %v. Err: %v", hclID, err)
+		return nil, err
+	}
 	entPlanStatus, err := createPlanningStatus(ctx, client, logger, status.StatusForPlan)
 	if err != nil {
 		return nil, err
@@ -1021,6 +770,7 @@ func createProvisioningStep(ctx context.Context, client *ent.Client, logger *log
 		AddPrevPlan(prevPlan).
 		SetType(plan.TypeExecuteStep).
 		SetBuildID(prevPlan.BuildID).
+		SetChecksum(stepSum).
 		SetPlanToProvisioningStep(entProvisioningStep).
 		SetStepNumber(prevPlan.StepNumber + 1).
 		SetPlanToBuild(currentBuild).
@@ -1028,15 +778,7 @@ func createProvisioningStep(ctx context.Context, client *ent.Client, logger *log
 		Save(ctx)
 
 	if err != nil {
-		logger.Log.WithFields(logrus.Fields{
-			"pHost":               pHost.ID,
-			"pHost.HCLID":         entHost.HclID,
-			"pHost.SubnetIP":      pHost.SubnetIP,
-			"stepNumber":          stepNumber,
-			"prevPlan":            prevPlan.ID,
-			"prevPlan.Type":       prevPlan.Type,
-			"prevPlan.StepNumber": prevPlan.StepNumber,
-		}).Errorf("Failed to Create Plan Node for Provisioning Step %v. Err: %v", entProvisioningStep.ID, err)
+		logger.Log.WithFields(stepFields).Errorf("Failed to Create Plan Node for Provisioning Step %v. Err: %v", entProvisioningStep.ID, err)
 		return nil, err
 	}
 
@@ -1050,46 +792,19 @@ func renderScript(ctx context.Context, client *ent.Client, logger *logging.Logge
 		"pStep.StepNumber": pStep.StepNumber,
 		"pStep.Type":       pStep.Type,
 	}).Debug("render script")
-	currentProvisionedHost := pStep.QueryProvisioningStepToProvisionedHost().OnlyX(ctx)
 	currentScript := pStep.QueryProvisioningStepToScript().OnlyX(ctx)
-	currentProvisionedNetwork := currentProvisionedHost.QueryProvisionedHostToProvisionedNetwork().OnlyX(ctx)
-	currentTeam := currentProvisionedNetwork.QueryProvisionedNetworkToTeam().OnlyX(ctx)
-	currentBuild := currentTeam.QueryTeamToBuild().OnlyX(ctx)
-	currentEnvironment := currentBuild.QueryBuildToEnvironment().OnlyX(ctx)
-	currentIncludedNetwork := currentEnvironment.QueryEnvironmentToIncludedNetwork().WithIncludedNetworkToHost().WithIncludedNetworkToNetwork().AllX(ctx)
-	currentCompetition := currentBuild.QueryBuildToCompetition().OnlyX(ctx)
-	currentNetwork := currentProvisionedNetwork.QueryProvisionedNetworkToNetwork().OnlyX(ctx)
-	currentHost := currentProvisionedHost.QueryProvisionedHostToHost().OnlyX(ctx)
-	currentIdentities := currentEnvironment.QueryEnvironmentToIdentity().AllX(ctx)
-	agentScriptFile := currentProvisionedHost.QueryProvisionedHostToGinFileMiddleware().OnlyX(ctx)
-	// Need to Make Unique and change how it's loaded in
-	currentDNS := currentCompetition.QueryCompetitionToDNS().FirstX(ctx)
-	templateData := TempleteContext{
-		Build:              currentBuild,
-		Competition:        currentCompetition,
-		Environment:        currentEnvironment,
-		Host:               currentHost,
-		DNS:                currentDNS,
-		IncludedNetworks:   currentIncludedNetwork,
-		Network:            currentNetwork,
-		Script:             currentScript,
-		Team:               currentTeam,
-		Identities:         currentIdentities,
-		ProvisionedNetwork: currentProvisionedNetwork,
-		ProvisionedHost:    currentProvisionedHost,
-		ProvisioningStep:   pStep,
-		AgentSlug:          agentScriptFile.URLID,
+	templateData, err := stepTemplateContext(ctx, pStep)
+	if err != nil {
+		logger.Log.Errorf("Failed to gather template context for script %v. Err: %v", currentScript.Name, err)
+		return "", err
 	}
+	templateData.Script = currentScript
 	t, err := template.New(strings.Replace(currentScript.Source, "./", "", -1)).Funcs(TemplateFuncLib).ParseFiles(currentScript.AbsPath)
 	if err != nil {
 		logger.Log.Errorf("Failed to Parse template for script %v. Err: %v", currentScript.Name, err)
 		return "", err
 	}
-	fileRelativePath := path.Join("builds", currentEnvironment.Name, fmt.Sprint(currentBuild.Revision), fmt.Sprint(currentTeam.TeamNumber), currentProvisionedNetwork.Name, currentHost.Hostname)
-	os.MkdirAll(fileRelativePath, 0755)
-	fileName := filepath.Base(currentScript.Source)
-	fileName = path.Join(fileRelativePath, fileName)
-	fileName, err = filepath.Abs(fileName)
+	fileName, err := stepFilePath(ctx, pStep, currentScript.Source)
 	if err != nil {
 		return "", err
 	}
@@ -1109,25 +824,92 @@ func renderScript(ctx context.Context, client *ent.Client, logger *logging.Logge
 	return fileName, nil
 }
 
-func renderFileDownload(ctx context.Context, logger *logging.Logger, pStep *ent.ProvisioningStep) (string, error) {
-	logger.Log.WithFields(logrus.Fields{
-		"pStep":            pStep.ID,
-		"pStep.StepNumber": pStep.StepNumber,
-		"pStep.Type":       pStep.Type,
-	}).Debug("render file download")
-	currentFileDownload := pStep.QueryProvisioningStepToFileDownload().OnlyX(ctx)
+// stepTemplateContext gathers what the templates of a provisioning step (scripts, templated file
+// downloads and DNS records) are rendered with
+func stepTemplateContext(ctx context.Context, pStep *ent.ProvisioningStep) (TempleteContext, error) {
+	templateData := TempleteContext{ProvisioningStep: pStep}
+	var err error
+	if templateData.ProvisionedHost, err = pStep.QueryProvisioningStepToProvisionedHost().Only(ctx); err != nil {
+		return templateData, fmt.Errorf("error querying provisioned host of provisioning step: %v", err)
+	}
+	if templateData.ProvisionedNetwork, err = templateData.ProvisionedHost.QueryProvisionedHostToProvisionedNetwork().Only(ctx); err != nil {
+		return templateData, fmt.Errorf("error querying provisioned network of provisioning step: %v", err)
+	}
+	if templateData.Team, err = templateData.ProvisionedNetwork.QueryProvisionedNetworkToTeam().Only(ctx); err != nil {
+		return templateData, fmt.Errorf("error querying team of provisioning step: %v", err)
+	}
+	if templateData.Build, err = templateData.Team.QueryTeamToBuild().Only(ctx); err != nil {
+		return templateData, fmt.Errorf("error querying build of provisioning step: %v", err)
+	}
+	if templateData.Environment, err = templateData.Build.QueryBuildToEnvironment().Only(ctx); err != nil {
+		return templateData, fmt.Errorf("error querying environment of provisioning step: %v", err)
+	}
+	if templateData.IncludedNetworks, err = templateData.Environment.QueryEnvironmentToIncludedNetwork().WithIncludedNetworkToHost().WithIncludedNetworkToNetwork().All(ctx); err != nil {
+		return templateData, fmt.Errorf("error querying included networks of provisioning step: %v", err)
+	}
+	if templateData.Competition, err = templateData.Build.QueryBuildToCompetition().Only(ctx); err != nil {
+		return templateData, fmt.Errorf("error querying competition of provisioning step: %v", err)
+	}
+	if templateData.Network, err = templateData.ProvisionedNetwork.QueryProvisionedNetworkToNetwork().Only(ctx); err != nil {
+		return templateData, fmt.Errorf("error querying network of provisioning step: %v", err)
+	}
+	if templateData.Host, err = templateData.ProvisionedHost.QueryProvisionedHostToHost().Only(ctx); err != nil {
+		return templateData, fmt.Errorf("error querying host of provisioning step: %v", err)
+	}
+	if templateData.Identities, err = templateData.Environment.QueryEnvironmentToIdentity().All(ctx); err != nil {
+		return templateData, fmt.Errorf("error querying identities of provisioning step: %v", err)
+	}
+	agentScriptFile, err := templateData.ProvisionedHost.QueryProvisionedHostToGinFileMiddleware().Only(ctx)
+	if err != nil {
+		return templateData, fmt.Errorf("error querying agent script of provisioning step: %v", err)
+	}
+	templateData.AgentSlug = agentScriptFile.URLID
+	// Need to Make Unique and change how it's loaded in
+	if templateData.DNS, err = templateData.Competition.QueryCompetitionToDNS().First(ctx); err != nil {
+		return templateData, fmt.Errorf("error querying DNS of provisioning step: %v", err)
+	}
+	// Templates see the team's addresses instead of the ${team} cidr templates
+	templateData.Network = networkForTeam(templateData.Network, templateData.Team.TeamNumber)
+	for _, includedNetwork := range templateData.IncludedNetworks {
+		if includedNetwork.Edges.IncludedNetworkToNetwork != nil {
+			includedNetwork.Edges.IncludedNetworkToNetwork = networkForTeam(includedNetwork.Edges.IncludedNetworkToNetwork, templateData.Team.TeamNumber)
+		}
+	}
+	return templateData, nil
+}
+
+// stepFilePath is where the rendered file of a provisioning step is written to, a directory per build,
+// team, network and host
+func stepFilePath(ctx context.Context, pStep *ent.ProvisioningStep, source string) (string, error) {
 	currentProvisionedHost := pStep.QueryProvisioningStepToProvisionedHost().OnlyX(ctx)
 	currentProvisionedNetwork := currentProvisionedHost.QueryProvisionedHostToProvisionedNetwork().OnlyX(ctx)
 	currentHost := currentProvisionedHost.QueryProvisionedHostToHost().OnlyX(ctx)
 	currentTeam := currentProvisionedNetwork.QueryProvisionedNetworkToTeam().OnlyX(ctx)
 	currentBuild := currentTeam.QueryTeamToBuild().OnlyX(ctx)
 	currentEnvironment := currentBuild.QueryBuildToEnvironment().OnlyX(ctx)
-
 	fileRelativePath := path.Join("builds", currentEnvironment.Name, fmt.Sprint(currentBuild.Revision), fmt.Sprint(currentTeam.TeamNumber), currentProvisionedNetwork.Name, currentHost.Hostname)
 	os.MkdirAll(fileRelativePath, 0755)
-	fileName := filepath.Base(currentFileDownload.Source)
-	fileName = path.Join(fileRelativePath, fileName)
-	fileName, err := filepath.Abs(fileName)
+	fileName := path.Join(fileRelativePath, filepath.Base(source))
+	return filepath.Abs(fileName)
+}
+
+func renderFileDownload(ctx context.Context, logger *logging.Logger, pStep *ent.ProvisioningStep) (string, error) {
+	logger.Log.WithFields(logrus.Fields{
+		"pStep":            pStep.ID,
+		"pStep.StepNumber": pStep.StepNumber,
+		"pStep.Type":       pStep.Type,
+	}).Debug("render file download")
+	currentFileDownload := pStep.QueryProvisioningStepToFileDownload().OnlyX(ctx)
+	if currentFileDownload.SourceType == "remote" {
+		// Team networks can be isolated, so agents get remote sources from the server like any other file
+		buildID, err := pStep.QueryProvisioningStepToProvisionedHost().QueryProvisionedHostToProvisionedNetwork().QueryProvisionedNetworkToBuild().OnlyID(ctx)
+		if err != nil {
+			return "", fmt.Errorf("error querying build of file download %s: %v", currentFileDownload.HclID, err)
+		}
+		return cacheRemoteFile(ctx, logger, buildID, currentFileDownload.Source, currentFileDownload.Sha256)
+	}
+
+	fileName, err := stepFilePath(ctx, pStep, currentFileDownload.Source)
 	if err != nil {
 		return "", err
 	}
@@ -1138,9 +920,27 @@ func renderFileDownload(ctx context.Context, logger *logging.Logger, pStep *ent.
 	}
 	defer destFile.Close()
 
-	// TODO: SOMETHING
-	if currentFileDownload.SourceType == "remote" {
-		// http.Get(currentFileDownload.Source)
+	if currentFileDownload.Template {
+		// Config files (sshd_config, web.config, etc.) get the same context as scripts so they can differ per team and host
+		t, err := template.New(filepath.Base(currentFileDownload.AbsPath)).Funcs(TemplateFuncLib).ParseFiles(currentFileDownload.AbsPath)
+		if err != nil {
+			logger.Log.Errorf("Failed to Parse template for file download %v. Err: %v", currentFileDownload.HclID, err)
+			return "", err
+		}
+		templateData, err := stepTemplateContext(ctx, pStep)
+		if err != nil {
+			logger.Log.Errorf("Failed to gather template context for file download %v. Err: %v", currentFileDownload.HclID, err)
+			return "", err
+		}
+		templateData.FileDownload = currentFileDownload
+		err = t.Execute(destFile, templateData)
+		if err != nil {
+			logger.Log.WithFields(logrus.Fields{
+				"fileDownload": currentFileDownload.HclID,
+				"path":         currentFileDownload.AbsPath,
+			}).Errorf("error while executing template for file download: %v", err)
+			return "", err
+		}
 	} else {
 		srcFile, err := os.Open(currentFileDownload.AbsPath)
 		if err != nil {
@@ -0,0 +1,692 @@
+package planner
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gen0cide/laforge/builder"
+	"github.com/gen0cide/laforge/ent"
+	"github.com/gen0cide/laforge/ent/buildcommit"
+	"github.com/gen0cide/laforge/ent/plan"
+	"github.com/gen0cide/laforge/ent/plandiff"
+	"github.com/gen0cide/laforge/ent/provisionedhost"
+	"github.com/gen0cide/laforge/ent/provisioningstep"
+	"github.com/gen0cide/laforge/ent/status"
+	"github.com/gen0cide/laforge/ipam"
+	"github.com/gen0cide/laforge/logging"
+	"github.com/gen0cide/laforge/server/utils"
+	"github.com/go-redis/redis/v8"
+)
+
+// PlanChange is a plan of a build whose environment config changed since it was planned
+type PlanChange struct {
+	Plan *ent.Plan
+	// Description names what changed (ex. "host web01 of team 2") for the logs
+	Description string
+}
+
+// planChangeRank orders the changes of a build so the plans that tear down the most come first
+var planChangeRank = map[plan.Type]int{
+	plan.TypeProvisionNetwork: 0,
+	plan.TypeProvisionHost:    1,
+	plan.TypeExecuteStep:      2,
+}
+
+// checksum hashes the environment config a plan is planned from
+func checksum(values ...interface{}) (string, error) {
+	h := sha256.New()
+	encoder := json.NewEncoder(h)
+	for _, value := range values {
+		err := encoder.Encode(value)
+		if err != nil {
+			return "", fmt.Errorf("error hashing plan config: %v", err)
+		}
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
+// fileChecksum hashes the contents of a file an environment references, missing files hash as ""
+func fileChecksum(path string) (string, error) {
+	if path == "" {
+		return "", nil
+	}
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("error opening %s: %v", path, err)
+	}
+	defer f.Close()
+	h := sha256.New()
+	_, err = io.Copy(h, f)
+	if err != nil {
+		return "", fmt.Errorf("error hashing %s: %v", path, err)
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
+// networkChecksum hashes what a provisioned network is built from
+func networkChecksum(entNetwork *ent.Network) (string, error) {
+	return checksum(entNetwork.Name, entNetwork.Cidr, entNetwork.Ipv6Cidr, entNetwork.VdiVisible, entNetwork.Vars, entNetwork.Tags)
+}
+
+// hostChecksum hashes what a provisioned host and its provisioning steps are built from
+func hostChecksum(ctx context.Context, entHost *ent.Host) (string, error) {
+	diskSize := 0
+	entDisk, err := entHost.QueryHostToDisk().Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return "", fmt.Errorf("failed querying Disk for Host: %v", err)
+	}
+	if entDisk != nil {
+		diskSize = entDisk.Size
+	}
+	return checksum(
+		entHost.Hostname,
+		entHost.OS,
+		entHost.LastOctet,
+		entHost.Cpus,
+		entHost.MemoryMB,
+		entHost.InstanceSize,
+		entHost.AllowMACChanges,
+		entHost.ExposedTCPPorts,
+		entHost.ExposedUDPPorts,
+		entHost.OverridePassword,
+		entHost.Vars,
+		entHost.UserGroups,
+		entHost.ProvisionSteps,
+		entHost.AdditionalDisks,
+		entHost.Tags,
+		diskSize,
+	)
+}
+
+// planChecksum hashes the current environment config of a plan and describes what the plan builds
+func planChecksum(ctx context.Context, entPlan *ent.Plan) (string, string, error) {
+	switch entPlan.Type {
+	case plan.TypeProvisionNetwork:
+		entProNetwork, err := entPlan.QueryPlanToProvisionedNetwork().
+			WithProvisionedNetworkToNetwork().
+			WithProvisionedNetworkToTeam().
+			Only(ctx)
+		if err != nil {
+			return "", "", fmt.Errorf("failed querying Provisioned Network for Plan: %v", err)
+		}
+		sum, err := networkChecksum(entProNetwork.Edges.ProvisionedNetworkToNetwork)
+		description := fmt.Sprintf("network %s of team %d", entProNetwork.Edges.ProvisionedNetworkToNetwork.HclID, entProNetwork.Edges.ProvisionedNetworkToTeam.TeamNumber)
+		return sum, description, err
+	case plan.TypeProvisionHost:
+		entProHost, err := entPlan.QueryPlanToProvisionedHost().WithProvisionedHostToHost().Only(ctx)
+		if err != nil {
+			return "", "", fmt.Errorf("failed querying Provisioned Host for Plan: %v", err)
+		}
+		entTeam, err := entProHost.QueryProvisionedHostToProvisionedNetwork().QueryProvisionedNetworkToTeam().Only(ctx)
+		if err != nil {
+			return "", "", fmt.Errorf("failed querying Team for Provisioned Host: %v", err)
+		}
+		sum, err := hostChecksum(ctx, entProHost.Edges.ProvisionedHostToHost)
+		description := fmt.Sprintf("host %s of team %d", entProHost.Edges.ProvisionedHostToHost.HclID, entTeam.TeamNumber)
+		return sum, description, err
+	case plan.TypeExecuteStep:
+		entStep, err := entPlan.QueryPlanToProvisioningStep().Only(ctx)
+		if err != nil {
+			return "", "", fmt.Errorf("failed querying Provisioning Step for Plan: %v", err)
+		}
+		entHost, err := entStep.QueryProvisioningStepToProvisionedHost().QueryProvisionedHostToHost().Only(ctx)
+		if err != nil {
+			return "", "", fmt.Errorf("failed querying Host for Provisioning Step: %v", err)
+		}
+		entTeam, err := entStep.QueryProvisioningStepToProvisionedHost().QueryProvisionedHostToProvisionedNetwork().QueryProvisionedNetworkToTeam().Only(ctx)
+		if err != nil {
+			return "", "", fmt.Errorf("failed querying Team for Provisioning Step: %v", err)
+		}
+		entStepType, err := lookupStepType(entStep.Type)
+		if err != nil {
+			return "", "", err
+		}
+		sum, err := entStepType.Checksum(ctx, entStep)
+		description := fmt.Sprintf("%s step %d of host %s of team %d", entStep.Type, entStep.StepNumber, entHost.HclID, entTeam.TeamNumber)
+		return sum, description, err
+	}
+	return "", "", fmt.Errorf("plans of type %s aren't checksummed", entPlan.Type)
+}
+
+// DiffBuild compares the networks, hosts and provisioning steps of a build with the current revision of its
+// environment and returns the plans whose config changed, networks first, then hosts, then steps in the
+// order they run. Plans made before checksums were recorded and deleted plans are never reported. Networks
+// and hosts added to or removed from the environment aren't changes of the build's plans either, new
+// teams or builds pick those up.
+func DiffBuild(ctx context.Context, client *ent.Client, entBuild *ent.Build) ([]*PlanChange, error) {
+	entPlans, err := entBuild.QueryBuildToPlan().Where(
+		plan.TypeIn(plan.TypeProvisionNetwork, plan.TypeProvisionHost, plan.TypeExecuteStep),
+		plan.ChecksumNEQ(""),
+		plan.HasPlanToStatusWith(status.StateNEQ(status.StateDELETED)),
+	).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error querying plans of build: %v", err)
+	}
+	changes := []*PlanChange{}
+	for _, entPlan := range entPlans {
+		currentSum, description, err := planChecksum(ctx, entPlan)
+		if err != nil {
+			return nil, err
+		}
+		if currentSum != entPlan.Checksum {
+			changes = append(changes, &PlanChange{Plan: entPlan, Description: description})
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		iRank, jRank := planChangeRank[changes[i].Plan.Type], planChangeRank[changes[j].Plan.Type]
+		if iRank != jRank {
+			return iRank < jRank
+		}
+		return changes[i].Plan.StepNumber < changes[j].Plan.StepNumber
+	})
+	return changes, nil
+}
+
+// RebuildChanges rebuilds the plans of a build that DiffBuild found changed once its REBUILD commit is approved.
+// A changed plan is torn down along with what depends on it (the hosts of a network, the steps of a host and
+// the steps after a step) and rebuilt from the current environment, the rest of the build isn't touched.
+// Rebuilt hosts are provisioned with their current provision_steps.
+func RebuildChanges(client *ent.Client, rdb *redis.Client, logger *logging.Logger, currentUser *ent.AuthUser, serverTask *ent.ServerTask, taskStatus *ent.Status, entBuild *ent.Build, changes []*PlanChange, spawnedRebuild chan bool) (bool, error) {
+	ctx := context.Background()
+	defer ctx.Done()
+
+	if len(changes) == 0 {
+		spawnedRebuild <- false
+		logger.Log.Errorf("nothing changed in build %s", entBuild.ID)
+		return false, fmt.Errorf("nothing changed in build %s", entBuild.ID)
+	}
+
+	rebuildRevision, err := entBuild.QueryBuildToBuildCommits().Count(ctx)
+	if err != nil {
+		spawnedRebuild <- false
+		logger.Log.Errorf("error counting commits on build: %v", err)
+		return false, err
+	}
+	entRebuildCommit, err := client.BuildCommit.Create().
+		SetRevision(rebuildRevision).
+		SetType(buildcommit.TypeREBUILD).
+		SetState(buildcommit.StatePLANNING).
+		SetBuildCommitToBuild(entBuild).
+		SetCreatedBy(currentUser.ID.String()).
+		Save(ctx)
+	if err != nil {
+		spawnedRebuild <- false
+		logger.Log.Errorf("error while creating rebuild commit: %v", err)
+		return false, fmt.Errorf("error while creating rebuild commit: %v", err)
+	}
+	rdb.Publish(ctx, "updatedBuildCommit", entRebuildCommit.ID.String())
+	err = entBuild.Update().SetBuildToLatestBuildCommit(entRebuildCommit).Exec(ctx)
+	if err != nil {
+		spawnedRebuild <- false
+		logger.Log.Errorf("error while setting latest commit on build: %v", err)
+		return false, fmt.Errorf("error while setting latest commit on build: %v", err)
+	}
+	rdb.Publish(ctx, "updatedBuild", entBuild.ID.String())
+
+	// Changes inside what an earlier (bigger) change tears down are rebuilt with it
+	rootPlans := []*ent.Plan{}
+	for _, change := range changes {
+		covered, err := entRebuildCommit.QueryBuildCommitToPlanDiffs().Where(plandiff.HasPlanDiffToPlanWith(plan.IDEQ(change.Plan.ID))).Exist(ctx)
+		if err != nil {
+			spawnedRebuild <- false
+			logger.Log.Errorf("error querying plan diffs of rebuild commit: %v", err)
+			return false, err
+		}
+		if covered {
+			logger.Log.Infof("%s changed, rebuilding it along with a change before it", change.Description)
+			continue
+		}
+		logger.Log.Infof("%s changed, rebuilding it", change.Description)
+		err = generateChangeCommitPlans(client, ctx, change.Plan, entRebuildCommit)
+		if err != nil {
+			spawnedRebuild <- false
+			logger.Log.Errorf("error generating plans for rebuild commit: %v", err)
+			return false, err
+		}
+		rootPlans = append(rootPlans, change.Plan)
+	}
+	for _, rootPlan := range rootPlans {
+		err = generateRebuildCommitPreviousPlans(client, ctx, rootPlan, entRebuildCommit)
+		if err != nil {
+			spawnedRebuild <- false
+			logger.Log.Errorf("error generating previous plans for rebuild commit: %v", err)
+			return false, err
+		}
+	}
+
+	spawnedRebuild <- true
+
+	logger.Log.Debug("-----\nWAITING FOR COMMIT REVIEW\n-----")
+	isApproved, err := utils.WaitForCommitReview(client, rdb, entRebuildCommit)
+	if err != nil {
+		logger.Log.Errorf("error while waiting for rebuild commit to be reviewed: %v", err)
+		entRebuildCommit.Update().SetState(buildcommit.StateCANCELLED).Exec(ctx)
+		rdb.Publish(ctx, "updatedBuildCommit", entRebuildCommit.ID.String())
+		return false, err
+	}
+
+	// Cancelled or timeout reached
+	if !isApproved {
+		logger.Log.Debug("-----\nCOMMIT CANCELLED/TIMED OUT\n-----")
+		logger.Log.Errorf("rebuild commit has been cancelled or the review timeout has been reached")
+		err = entRebuildCommit.Update().SetState(buildcommit.StateCANCELLED).Exec(ctx)
+		if err != nil {
+			logger.Log.Errorf("error while cancelling rebuild commit: %v", err)
+			return false, err
+		}
+		rdb.Publish(ctx, "updatedBuildCommit", entRebuildCommit.ID.String())
+		taskStatus, serverTask, err = utils.FailServerTask(ctx, client, rdb, taskStatus, serverTask)
+		if err != nil {
+			return false, fmt.Errorf("error failing rebuild changes server task: %v", err)
+		}
+		return false, fmt.Errorf("commit has been cancelled or the review timeout has been reached")
+	}
+	logger.Log.Debug("-----\nCOMMIT APPROVED\n-----")
+
+	env, err := entBuild.QueryBuildToEnvironment().Only(ctx)
+	if err != nil {
+		logger.Log.Errorf("error querying environment from build: %v", err)
+		return false, err
+	}
+
+	err = entRebuildCommit.Update().SetState(buildcommit.StateINPROGRESS).Exec(ctx)
+	if err != nil {
+		taskStatus, serverTask, err = utils.FailServerTask(ctx, client, rdb, taskStatus, serverTask)
+		if err != nil {
+			return false, fmt.Errorf("error failing rebuild changes server task: %v", err)
+		}
+		logger.Log.Errorf("error while starting rebuild commit: %v", err)
+		return false, err
+	}
+	rdb.Publish(ctx, "updatedBuildCommit", entRebuildCommit.ID.String())
+
+	genericBuilder, err := builder.BuilderFromEnvironment(env, logger)
+	if err != nil {
+		taskStatus, serverTask, err = utils.FailServerTask(ctx, client, rdb, taskStatus, serverTask)
+		if err != nil {
+			return false, fmt.Errorf("error failing rebuild changes server task: %v", err)
+		}
+		logger.Log.Errorf("error generating builder: %v", err)
+		return false, err
+	}
+
+	commitCtx, rebuildDone := commitContext(entRebuildCommit)
+	defer rebuildDone()
+	err = markForRoutine(commitCtx, logger, status.StateTODELETE, entRebuildCommit)
+	if err != nil {
+		taskStatus, serverTask, err = utils.FailServerTask(ctx, client, rdb, taskStatus, serverTask)
+		if err != nil {
+			return false, fmt.Errorf("error failing rebuild changes server task: %v", err)
+		}
+		return false, err
+	}
+
+	var wg sync.WaitGroup
+	for _, rootPlan := range rootPlans {
+		wg.Add(1)
+		go deleteRoutine(client, logger, &genericBuilder, commitCtx, rootPlan, &wg)
+	}
+	wg.Wait()
+	if commitCtx.Err() != nil {
+		return false, cancelledCommit(client, logger, serverTask, taskStatus, entRebuildCommit)
+	}
+
+	logger.Log.Debug("waiting for deletion to propagate to all systems")
+	select {
+	case <-time.After(1 * time.Minute):
+	case <-commitCtx.Done():
+		return false, cancelledCommit(client, logger, serverTask, taskStatus, entRebuildCommit)
+	}
+
+	// Everything torn down is rebuilt from the current environment
+	err = readdressRebuiltPlans(commitCtx, client, logger, entRebuildCommit)
+	if err == nil {
+		err = replanRebuiltHosts(commitCtx, client, logger, entRebuildCommit)
+	}
+	if err == nil {
+		err = refreshRebuiltPlans(commitCtx, client, logger, entRebuildCommit)
+	}
+	forgetRemoteFiles(entBuild.ID)
+	if err != nil {
+		logger.Log.Errorf("error replanning rebuild commit: %v", err)
+		taskStatus, serverTask, err = utils.FailServerTask(ctx, client, rdb, taskStatus, serverTask)
+		if err != nil {
+			return false, fmt.Errorf("error failing rebuild changes server task: %v", err)
+		}
+		return false, err
+	}
+
+	err = markForRoutine(commitCtx, logger, status.StateAWAITING, entRebuildCommit)
+	if err != nil {
+		taskStatus, serverTask, err = utils.FailServerTask(ctx, client, rdb, taskStatus, serverTask)
+		if err != nil {
+			return false, fmt.Errorf("error failing rebuild changes server task: %v", err)
+		}
+		return false, err
+	}
+
+	for _, rootPlan := range rootPlans {
+		wg.Add(1)
+		go buildRoutine(client, logger, &genericBuilder, commitCtx, rootPlan, &wg)
+	}
+	wg.Wait()
+	if commitCtx.Err() != nil {
+		return false, cancelledCommit(client, logger, serverTask, taskStatus, entRebuildCommit)
+	}
+
+	err = entRebuildCommit.Update().SetState(buildcommit.StateAPPLIED).Exec(ctx)
+	if err != nil {
+		taskStatus, serverTask, err = utils.FailServerTask(ctx, client, rdb, taskStatus, serverTask)
+		if err != nil {
+			return false, fmt.Errorf("error failing rebuild changes server task: %v", err)
+		}
+		logger.Log.Errorf("error while applying rebuild commit: %v", err)
+		return false, err
+	}
+	rdb.Publish(ctx, "updatedBuildCommit", entRebuildCommit.ID.String())
+
+	err = entBuild.Update().SetEnvironmentRevision(env.Revision).Exec(ctx)
+	if err != nil {
+		logger.Log.Errorf("error updating environment revision of build: %v", err)
+	}
+	rdb.Publish(ctx, "updatedBuild", entBuild.ID.String())
+
+	taskStatus, serverTask, err = utils.CompleteServerTask(ctx, client, rdb, taskStatus, serverTask)
+	if err != nil {
+		return false, fmt.Errorf("error completing rebuild changes server task: %v", err)
+	}
+	return true, nil
+}
+
+// generateChangeCommitPlans marks a changed plan to be rebuilt along with everything deleteRoutine tears down
+// with it. Unlike generateRebuildCommitPlans, hosts that only depend on a changed host aren't rebuilt.
+func generateChangeCommitPlans(client *ent.Client, ctx context.Context, rootPlan *ent.Plan, entBuildCommit *ent.BuildCommit) error {
+	diffRevision, err := rootPlan.QueryPlanToPlanDiffs().Count(ctx)
+	if err != nil {
+		return err
+	}
+
+	planDiffExists, err := entBuildCommit.QueryBuildCommitToPlanDiffs().Where(plandiff.HasPlanDiffToPlanWith(plan.IDEQ(rootPlan.ID))).Exist(ctx)
+	if err != nil {
+		return err
+	} else if !planDiffExists {
+		_, err = client.PlanDiff.Create().
+			SetNewState(plandiff.NewStateTOREBUILD).
+			SetPlanDiffToBuildCommit(entBuildCommit).
+			SetPlanDiffToPlan(rootPlan).
+			SetRevision(diffRevision).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	var nextType plan.Type
+	switch rootPlan.Type {
+	case plan.TypeProvisionNetwork:
+		nextType = plan.TypeProvisionHost
+	case plan.TypeProvisionHost, plan.TypeExecuteStep:
+		nextType = plan.TypeExecuteStep
+	default:
+		return nil
+	}
+	nextPlans, err := rootPlan.QueryNextPlan().Where(plan.TypeEQ(nextType)).All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, nextPlan := range nextPlans {
+		err := generateChangeCommitPlans(client, ctx, nextPlan, entBuildCommit)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readdressRebuiltPlans sets the name and cidrs of the provisioned networks a commit rebuilds and the addresses
+// of its provisioned hosts from the current environment, the builders deploy from those. Networks go first, the
+// hosts are addressed in their (new) cidrs.
+func readdressRebuiltPlans(ctx context.Context, client *ent.Client, logger *logging.Logger, entCommit *ent.BuildCommit) error {
+	rebuiltPlans := entCommit.QueryBuildCommitToPlanDiffs().
+		Where(plandiff.NewStateEQ(plandiff.NewStateTOREBUILD)).
+		QueryPlanDiffToPlan()
+	entProNetworks, err := rebuiltPlans.Clone().
+		Where(plan.TypeEQ(plan.TypeProvisionNetwork)).
+		QueryPlanToProvisionedNetwork().
+		WithProvisionedNetworkToNetwork().
+		WithProvisionedNetworkToTeam().
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("error querying provisioned networks of commit: %v", err)
+	}
+	for _, entProNetwork := range entProNetworks {
+		entNetwork, entTeam := entProNetwork.Edges.ProvisionedNetworkToNetwork, entProNetwork.Edges.ProvisionedNetworkToTeam
+		if entNetwork == nil || entTeam == nil {
+			return fmt.Errorf("provisioned network %s has no network or team", entProNetwork.ID)
+		}
+		err = entProNetwork.Update().
+			SetName(entNetwork.Name).
+			SetCidr(ipam.ForTeam(entNetwork.Cidr, entTeam.TeamNumber)).
+			SetIpv6Cidr(ipam.ForTeam(entNetwork.Ipv6Cidr, entTeam.TeamNumber)).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("error updating provisioned network %s: %v", entNetwork.HclID, err)
+		}
+	}
+
+	entProHosts, err := rebuiltPlans.Clone().
+		Where(plan.TypeEQ(plan.TypeProvisionHost)).
+		QueryPlanToProvisionedHost().
+		WithProvisionedHostToHost().
+		WithProvisionedHostToProvisionedNetwork().
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("error querying provisioned hosts of commit: %v", err)
+	}
+	for _, entProHost := range entProHosts {
+		entHost, entProNetwork := entProHost.Edges.ProvisionedHostToHost, entProHost.Edges.ProvisionedHostToProvisionedNetwork
+		if entHost == nil || entProNetwork == nil {
+			return fmt.Errorf("provisioned host %s has no host or provisioned network", entProHost.ID)
+		}
+		subnetIP, err := CalcIP(entProNetwork.Cidr, entHost.LastOctet)
+		if err != nil {
+			return fmt.Errorf("error addressing host %s: %v", entHost.HclID, err)
+		}
+		subnetIpv6 := ""
+		if entProNetwork.Ipv6Cidr != "" {
+			subnetIpv6, err = CalcIP(entProNetwork.Ipv6Cidr, entHost.LastOctet)
+			if err != nil {
+				return fmt.Errorf("error addressing host %s: %v", entHost.HclID, err)
+			}
+		}
+		if subnetIP == entProHost.SubnetIP && subnetIpv6 == entProHost.SubnetIpv6 {
+			continue
+		}
+		err = entProHost.Update().SetSubnetIP(subnetIP).SetSubnetIpv6(subnetIpv6).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("error updating address of host %s: %v", entHost.HclID, err)
+		}
+		logger.Log.Infof("host %s moved from %s to %s", entHost.HclID, entProHost.SubnetIP, subnetIP)
+	}
+	return nil
+}
+
+// replanRebuiltHosts replaces the provisioning steps of the hosts a commit rebuilds with their current
+// provision_steps. The old steps are taken out of the build (their plan diffs are kept as DELETED) and the
+// hosts that depend on a rebuilt host wait on its new last step.
+func replanRebuiltHosts(ctx context.Context, client *ent.Client, logger *logging.Logger, entCommit *ent.BuildCommit) error {
+	hostPlans, err := entCommit.QueryBuildCommitToPlanDiffs().
+		Where(plandiff.NewStateEQ(plandiff.NewStateTOREBUILD)).
+		QueryPlanDiffToPlan().
+		Where(plan.TypeEQ(plan.TypeProvisionHost)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("error querying host plans of commit: %v", err)
+	}
+	for _, hostPlan := range hostPlans {
+		err = replanHostSteps(ctx, client, logger, entCommit, hostPlan)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func replanHostSteps(ctx context.Context, client *ent.Client, logger *logging.Logger, entCommit *ent.BuildCommit, hostPlan *ent.Plan) error {
+	entProHost, err := hostPlan.QueryPlanToProvisionedHost().WithProvisionedHostToHost().Only(ctx)
+	if err != nil {
+		return fmt.Errorf("failed querying Provisioned Host for Plan: %v", err)
+	}
+	entHost := entProHost.Edges.ProvisionedHostToHost
+	oldStepPlanIDs, err := client.Plan.Query().Where(
+		plan.HasPlanToProvisioningStepWith(
+			provisioningstep.HasProvisioningStepToProvisionedHostWith(provisionedhost.IDEQ(entProHost.ID)),
+		),
+	).IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed querying step plans of Provisioned Host: %v", err)
+	}
+	oldEndPlan, err := entProHost.QueryProvisionedHostToEndStepPlan().Only(ctx)
+	if err != nil {
+		return fmt.Errorf("failed querying end step plan of Provisioned Host: %v", err)
+	}
+	dependentPlans, err := oldEndPlan.QueryNextPlan().Where(plan.TypeEQ(plan.TypeProvisionHost)).All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed querying dependent host plans of Provisioned Host: %v", err)
+	}
+
+	if len(oldStepPlanIDs) > 0 {
+		err = client.PlanDiff.Update().Where(
+			plandiff.HasPlanDiffToBuildCommitWith(buildcommit.IDEQ(entCommit.ID)),
+			plandiff.HasPlanDiffToPlanWith(plan.IDIn(oldStepPlanIDs...)),
+		).SetNewState(plandiff.NewStateDELETED).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("error updating plan diffs of old steps: %v", err)
+		}
+		err = client.ProvisioningStep.Update().
+			Where(provisioningstep.HasProvisioningStepToPlanWith(plan.IDIn(oldStepPlanIDs...))).
+			ClearProvisioningStepToProvisionedHost().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("error unlinking old steps from Provisioned Host: %v", err)
+		}
+		err = client.Plan.Update().
+			Where(plan.IDIn(oldStepPlanIDs...)).
+			ClearPrevPlan().
+			ClearNextPlan().
+			ClearPlanToBuild().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("error unlinking old step plans from build: %v", err)
+		}
+	}
+
+	endPlan := hostPlan
+	for i, hclID := range entHost.ProvisionSteps {
+		entStep, err := createProvisioningStep(ctx, client, logger, hclID, i+1, entProHost, endPlan)
+		if err != nil {
+			return err
+		}
+		endPlan, err = entStep.QueryProvisioningStepToPlan().Only(ctx)
+		if err != nil {
+			return fmt.Errorf("failed querying Plan for Provisioning Step: %v", err)
+		}
+		_, err = client.PlanDiff.Create().
+			SetNewState(plandiff.NewStateTOREBUILD).
+			SetPlanDiffToBuildCommit(entCommit).
+			SetPlanDiffToPlan(endPlan).
+			SetRevision(0).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("error creating plan diff for new step: %v", err)
+		}
+	}
+	err = entProHost.Update().SetProvisionedHostToEndStepPlan(endPlan).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("error updating end step plan of Provisioned Host: %v", err)
+	}
+
+	if oldEndPlan.ID == endPlan.ID {
+		return nil
+	}
+	for _, dependentPlan := range dependentPlans {
+		update := dependentPlan.Update().AddPrevPlan(endPlan)
+		// The edges of old step plans are already cleared
+		if oldEndPlan.ID == hostPlan.ID {
+			update = update.RemovePrevPlan(hostPlan)
+		}
+		err = update.Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("error moving dependent host plan to new end step plan: %v", err)
+		}
+	}
+	logger.Log.Infof("replanned %d provisioning steps of host %s", len(entHost.ProvisionSteps), entHost.HclID)
+	return nil
+}
+
+// refreshRebuiltPlans records the current checksums of the changed plans a commit rebuilds and renders the
+// files of their provisioning steps again
+func refreshRebuiltPlans(ctx context.Context, client *ent.Client, logger *logging.Logger, entCommit *ent.BuildCommit) error {
+	entPlans, err := entCommit.QueryBuildCommitToPlanDiffs().
+		Where(plandiff.NewStateEQ(plandiff.NewStateTOREBUILD)).
+		QueryPlanDiffToPlan().
+		Where(plan.TypeIn(plan.TypeProvisionNetwork, plan.TypeProvisionHost, plan.TypeExecuteStep)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("error querying plans of commit: %v", err)
+	}
+	for _, entPlan := range entPlans {
+		currentSum, _, err := planChecksum(ctx, entPlan)
+		if err != nil {
+			return err
+		}
+		if currentSum == entPlan.Checksum {
+			continue
+		}
+		if entPlan.Type == plan.TypeExecuteStep {
+			entStep, err := entPlan.QueryPlanToProvisioningStep().Only(ctx)
+			if err != nil {
+				return fmt.Errorf("failed querying Provisioning Step for Plan: %v", err)
+			}
+			err = rerenderStepFile(ctx, client, logger, entStep)
+			if err != nil {
+				return fmt.Errorf("error rendering file of Provisioning Step %s: %v", entStep.ID, err)
+			}
+		}
+		err = entPlan.Update().SetChecksum(currentSum).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("error updating checksum of Plan: %v", err)
+		}
+	}
+	return nil
+}
+
+// rerenderStepFile renders the file of a changed provisioning step again and serves it from the step's URL
+func rerenderStepFile(ctx context.Context, client *ent.Client, logger *logging.Logger, entStep *ent.ProvisioningStep) error {
+	entStepType, err := lookupStepType(entStep.Type)
+	if err != nil {
+		return err
+	}
+	entGinMiddleware, err := entStep.QueryProvisioningStepToGinFileMiddleware().Only(ctx)
+	if ent.IsNotFound(err) {
+		return serveStepFile(ctx, client, logger, entStepType, entStep)
+	}
+	if err != nil {
+		return fmt.Errorf("failed querying Gin File Middleware for Provisioning Step: %v", err)
+	}
+	filePath, err := entStepType.Render(ctx, client, logger, entStep)
+	if err != nil || filePath == "" || filePath == entGinMiddleware.FilePath {
+		return err
+	}
+	return entGinMiddleware.Update().SetFilePath(filePath).Exec(ctx)
+}
@@ -0,0 +1,148 @@
+package planner
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/gen0cide/laforge/ent"
+	"github.com/gen0cide/laforge/ent/buildcommit"
+	"github.com/gen0cide/laforge/ent/enttest"
+	"github.com/gen0cide/laforge/ent/plan"
+	"github.com/gen0cide/laforge/ent/plandiff"
+	"github.com/gen0cide/laforge/ent/status"
+	"github.com/gen0cide/laforge/logging"
+	"github.com/sirupsen/logrus"
+)
+
+func TestReaddressRebuiltPlans(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	defer client.Close()
+	log := logrus.New()
+	log.Out = ioutil.Discard
+	logger := &logging.Logger{Log: log}
+
+	newStatus := func(statusFor status.StatusFor) *ent.Status {
+		return client.Status.Create().SetState(status.StateCOMPLETE).SetStatusFor(statusFor).SaveX(ctx)
+	}
+	entCompetition := client.Competition.Create().
+		SetHclID("comp").
+		SetRootPassword("").
+		SetConfig(map[string]string{}).
+		SetTags(map[string]string{}).
+		SaveX(ctx)
+	entEnvironment := client.Environment.Create().
+		SetHclID("env").
+		SetCompetitionID("comp").
+		SetName("env").
+		SetDescription("").
+		SetBuilder("vsphere-nsxt").
+		SetTeamCount(4).
+		SetRevision(2).
+		SetAdminCidrs([]string{}).
+		SetExposedVdiPorts([]string{}).
+		SetConfig(map[string]string{}).
+		SetTags(map[string]string{}).
+		SaveX(ctx)
+	entBuild := client.Build.Create().
+		SetRevision(1).
+		SetEnvironmentRevision(1).
+		SetBuildToEnvironment(entEnvironment).
+		SetBuildToCompetition(entCompetition).
+		SetBuildToStatus(newStatus(status.StatusForBuild)).
+		SaveX(ctx)
+	entTeam := client.Team.Create().
+		SetTeamNumber(3).
+		SetVars(map[string]string{}).
+		SetTeamToBuild(entBuild).
+		SetTeamToStatus(newStatus(status.StatusForTeam)).
+		SaveX(ctx)
+
+	// The environment moved the network from 10.${team}.10.0/24 and the host from .5 since the build was planned
+	entNetwork := client.Network.Create().
+		SetHclID("corp").
+		SetName("corp-new").
+		SetCidr("10.${team}.20.0/24").
+		SetIpv6Cidr("fd00:${team}:20::/64").
+		SetVdiVisible(false).
+		SetVars(map[string]string{}).
+		SetTags(map[string]string{}).
+		SaveX(ctx)
+	entHost := client.Host.Create().
+		SetHclID("web").
+		SetHostname("web").
+		SetDescription("").
+		SetOS("ubuntu").
+		SetLastOctet(7).
+		SetInstanceSize("").
+		SetAllowMACChanges(false).
+		SetExposedTCPPorts([]string{}).
+		SetExposedUDPPorts([]string{}).
+		SetOverridePassword("").
+		SetVars(map[string]string{}).
+		SetUserGroups([]string{}).
+		SetTags(map[string]string{}).
+		SaveX(ctx)
+	entProNetwork := client.ProvisionedNetwork.Create().
+		SetName("corp").
+		SetCidr("10.3.10.0/24").
+		SetProvisionedNetworkToNetwork(entNetwork).
+		SetProvisionedNetworkToTeam(entTeam).
+		SetProvisionedNetworkToBuild(entBuild).
+		SetProvisionedNetworkToStatus(newStatus(status.StatusForProvisionedNetwork)).
+		SaveX(ctx)
+	entProHost := client.ProvisionedHost.Create().
+		SetSubnetIP("10.3.10.5").
+		SetProvisionedHostToProvisionedNetwork(entProNetwork).
+		SetProvisionedHostToHost(entHost).
+		SetProvisionedHostToBuild(entBuild).
+		SetProvisionedHostToStatus(newStatus(status.StatusForProvisionedHost)).
+		SaveX(ctx)
+	networkPlan := client.Plan.Create().
+		SetType(plan.TypeProvisionNetwork).
+		SetStepNumber(2).
+		SetBuildID(entBuild.ID.String()).
+		SetPlanToBuild(entBuild).
+		SetPlanToProvisionedNetwork(entProNetwork).
+		SetPlanToStatus(newStatus(status.StatusForPlan)).
+		SaveX(ctx)
+	hostPlan := client.Plan.Create().
+		SetType(plan.TypeProvisionHost).
+		SetStepNumber(3).
+		SetBuildID(entBuild.ID.String()).
+		SetPlanToBuild(entBuild).
+		SetPlanToProvisionedHost(entProHost).
+		SetPlanToStatus(newStatus(status.StatusForPlan)).
+		AddPrevPlan(networkPlan).
+		SaveX(ctx)
+
+	entCommit := client.BuildCommit.Create().
+		SetType(buildcommit.TypeREBUILD).
+		SetRevision(1).
+		SetState(buildcommit.StateINPROGRESS).
+		SetBuildCommitToBuild(entBuild).
+		SaveX(ctx)
+	for _, entPlan := range []*ent.Plan{networkPlan, hostPlan} {
+		client.PlanDiff.Create().
+			SetRevision(0).
+			SetNewState(plandiff.NewStateTOREBUILD).
+			SetPlanDiffToBuildCommit(entCommit).
+			SetPlanDiffToPlan(entPlan).
+			SaveX(ctx)
+	}
+
+	err := readdressRebuiltPlans(ctx, client, logger, entCommit)
+	if err != nil {
+		t.Fatalf("readdressRebuiltPlans() error = %v", err)
+	}
+	entProNetwork = client.ProvisionedNetwork.GetX(ctx, entProNetwork.ID)
+	if entProNetwork.Name != "corp-new" || entProNetwork.Cidr != "10.3.20.0/24" || entProNetwork.Ipv6Cidr != "fd00:3:20::/64" {
+		t.Errorf("provisioned network = %s %s %s, want corp-new 10.3.20.0/24 fd00:3:20::/64", entProNetwork.Name, entProNetwork.Cidr, entProNetwork.Ipv6Cidr)
+	}
+	entProHost = client.ProvisionedHost.GetX(ctx, entProHost.ID)
+	if entProHost.SubnetIP != "10.3.20.7" || entProHost.SubnetIpv6 != "fd00:3:20::7" {
+		t.Errorf("provisioned host = %s %s, want 10.3.20.7 fd00:3:20::7", entProHost.SubnetIP, entProHost.SubnetIpv6)
+	}
+}
@@ -0,0 +1,369 @@
+package planner
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gen0cide/laforge/ent"
+	"github.com/gen0cide/laforge/ent/build"
+	"github.com/gen0cide/laforge/ent/command"
+	"github.com/gen0cide/laforge/ent/host"
+	"github.com/gen0cide/laforge/ent/network"
+	"github.com/gen0cide/laforge/ent/plan"
+	"github.com/gen0cide/laforge/ent/predicate"
+	"github.com/gen0cide/laforge/ent/provisionedhost"
+	"github.com/gen0cide/laforge/ent/provisionednetwork"
+	"github.com/gen0cide/laforge/ent/provisioningstep"
+	"github.com/gen0cide/laforge/ent/script"
+	"github.com/gen0cide/laforge/ent/status"
+	"github.com/google/uuid"
+)
+
+// durationSamples is how many of the latest runs of a network, host, script or command the ETA is averaged over
+const durationSamples = 20
+
+// progressDelay groups the plans of a build finishing together into a single update of its progress
+const progressDelay = 2 * time.Second
+
+// durationsTTL is how long the historical durations of a build are reused, they come from the other builds
+// and barely move while this one runs
+const durationsTTL = 10 * time.Minute
+
+var (
+	progressUpdatesMu sync.Mutex
+	progressUpdates   = map[string]bool{}
+)
+
+// cachedDurations are the historical durations looked up for a build, queried holds the keys without any run too
+type cachedDurations struct {
+	loadedAt  time.Time
+	durations map[string]time.Duration
+	queried   map[string]bool
+}
+
+var (
+	durationsCacheMu sync.Mutex
+	durationsCache   = map[uuid.UUID]*cachedDurations{}
+)
+
+// fallbackDurations are used for the plans that were never run in a previous build
+var fallbackDurations = map[plan.Type]time.Duration{
+	plan.TypeProvisionNetwork: 1 * time.Minute,
+	plan.TypeProvisionHost:    5 * time.Minute,
+	plan.TypeExecuteStep:      1 * time.Minute,
+}
+
+// ProgressRollup counts the plans of a build (or of one of its teams, networks or hosts) by state
+type ProgressRollup struct {
+	Total           int     `json:"total"`
+	Completed       int     `json:"completed"`
+	Failed          int     `json:"failed"`
+	InProgress      int     `json:"in_progress"`
+	PercentComplete float64 `json:"percent_complete"`
+}
+
+func (r *ProgressRollup) add(state string) {
+	r.Total++
+	switch state {
+	case status.StateCOMPLETE.String():
+		r.Completed++
+	case status.StateFAILED.String():
+		r.Failed++
+	case status.StateINPROGRESS.String():
+		r.InProgress++
+	}
+	r.PercentComplete = float64(r.Completed) * 100 / float64(r.Total)
+}
+
+// BuildProgress is how far along a build is. EstimatedRemaining is the length of the critical path, the
+// longest chain of plans left to run, using the durations of the same networks, hosts, scripts and commands
+// in the previous builds. ETA is zero once nothing is left to run.
+type BuildProgress struct {
+	BuildID            uuid.UUID                     `json:"build_id"`
+	Build              ProgressRollup                `json:"build"`
+	Teams              map[int]*ProgressRollup       `json:"teams"`
+	Networks           map[uuid.UUID]*ProgressRollup `json:"networks"`
+	Hosts              map[uuid.UUID]*ProgressRollup `json:"hosts"`
+	EstimatedRemaining time.Duration                 `json:"estimated_remaining"`
+	ETA                time.Time                     `json:"eta"`
+	CriticalPath       []uuid.UUID                   `json:"critical_path"`
+}
+
+// GetBuildProgress rolls up the plan statuses of a build. When only part of the build was executed (see
+// BuildFilter) the plans left PLANNING aren't counted.
+func GetBuildProgress(ctx context.Context, client *ent.Client, entBuild *ent.Build) (*BuildProgress, error) {
+	graph, err := BuildPlanGraph(ctx, entBuild, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	executed := false
+	for _, node := range graph.Nodes {
+		if node.State != status.StatePLANNING.String() {
+			executed = true
+			break
+		}
+	}
+	nodes := map[uuid.UUID]*PlanGraphNode{}
+	for i, node := range graph.Nodes {
+		if executed && node.State == status.StatePLANNING.String() {
+			continue
+		}
+		nodes[node.ID] = &graph.Nodes[i]
+	}
+
+	progress := &BuildProgress{
+		BuildID:      entBuild.ID,
+		Teams:        map[int]*ProgressRollup{},
+		Networks:     map[uuid.UUID]*ProgressRollup{},
+		Hosts:        map[uuid.UUID]*ProgressRollup{},
+		CriticalPath: []uuid.UUID{},
+	}
+	durationKeys := map[string]bool{}
+	for _, node := range nodes {
+		progress.Build.add(node.State)
+		if node.Team != nil {
+			if progress.Teams[*node.Team] == nil {
+				progress.Teams[*node.Team] = &ProgressRollup{}
+			}
+			progress.Teams[*node.Team].add(node.State)
+		}
+		if node.ProvisionedNetwork != nil {
+			if progress.Networks[*node.ProvisionedNetwork] == nil {
+				progress.Networks[*node.ProvisionedNetwork] = &ProgressRollup{}
+			}
+			progress.Networks[*node.ProvisionedNetwork].add(node.State)
+		}
+		if node.ProvisionedHost != nil {
+			if progress.Hosts[*node.ProvisionedHost] == nil {
+				progress.Hosts[*node.ProvisionedHost] = &ProgressRollup{}
+			}
+			progress.Hosts[*node.ProvisionedHost].add(node.State)
+		}
+		if node.durationKey != "" {
+			durationKeys[node.durationKey] = true
+		}
+	}
+
+	durations, err := buildDurations(ctx, client, entBuild, durationKeys)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	remaining := map[uuid.UUID]time.Duration{}
+	for id, node := range nodes {
+		remaining[id] = remainingDuration(node, durations, now)
+	}
+	progress.EstimatedRemaining, progress.CriticalPath = criticalPath(nodes, graph.Edges, remaining)
+	if progress.EstimatedRemaining > 0 {
+		progress.ETA = now.Add(progress.EstimatedRemaining)
+	}
+	return progress, nil
+}
+
+// planFinished is true for the plan states the build walk doesn't do anything more with
+func planFinished(state string) bool {
+	switch state {
+	case status.StateCOMPLETE.String(), status.StateFAILED.String(), status.StateTAINTED.String(), status.StateDELETED.String():
+		return true
+	}
+	return false
+}
+
+// remainingDuration estimates how much longer a plan will take, finished plans take no time
+func remainingDuration(node *PlanGraphNode, durations map[string]time.Duration, now time.Time) time.Duration {
+	if planFinished(node.State) {
+		return 0
+	}
+	estimate, known := durations[node.durationKey]
+	if !known {
+		estimate = fallbackDurations[plan.Type(node.Type)]
+	}
+	if node.State == status.StateINPROGRESS.String() && !node.startedAt.IsZero() {
+		estimate -= now.Sub(node.startedAt)
+		if estimate < 0 {
+			// Running late, it is expected to finish any time now
+			estimate = 0
+		}
+	}
+	return estimate
+}
+
+// criticalPath returns the longest chain of plans by remaining duration and its length. Plans that are
+// already done are left out of the returned path.
+func criticalPath(nodes map[uuid.UUID]*PlanGraphNode, edges []PlanGraphEdge, remaining map[uuid.UUID]time.Duration) (time.Duration, []uuid.UUID) {
+	next := map[uuid.UUID][]uuid.UUID{}
+	parents := map[uuid.UUID]int{}
+	for _, edge := range edges {
+		if nodes[edge.From] == nil || nodes[edge.To] == nil {
+			continue
+		}
+		next[edge.From] = append(next[edge.From], edge.To)
+		parents[edge.To]++
+	}
+
+	// Walk the DAG in topological order, keeping the longest chain leading to every plan
+	queue := []uuid.UUID{}
+	for id := range nodes {
+		if parents[id] == 0 {
+			queue = append(queue, id)
+		}
+	}
+	longest := map[uuid.UUID]time.Duration{}
+	prev := map[uuid.UUID]uuid.UUID{}
+	var end uuid.UUID
+	var endLength time.Duration = -1
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		longest[id] += remaining[id]
+		if longest[id] > endLength {
+			end, endLength = id, longest[id]
+		}
+		for _, nextID := range next[id] {
+			if _, seen := prev[nextID]; !seen || longest[id] > longest[nextID] {
+				prev[nextID] = id
+				longest[nextID] = longest[id]
+			}
+			parents[nextID]--
+			if parents[nextID] == 0 {
+				queue = append(queue, nextID)
+			}
+		}
+	}
+	if endLength <= 0 {
+		return 0, []uuid.UUID{}
+	}
+
+	path := []uuid.UUID{}
+	for id, ok := end, true; ok; id, ok = prev[id] {
+		if !planFinished(nodes[id].State) {
+			path = append([]uuid.UUID{id}, path...)
+		}
+	}
+	return endLength, path
+}
+
+// publishProgress lets the subscribers of a build know its progress changed. Updates are sent progressDelay
+// after the first change, so every plan finishing doesn't reload the progress of the whole build.
+func publishProgress(buildID string) {
+	progressUpdatesMu.Lock()
+	defer progressUpdatesMu.Unlock()
+	if progressUpdates[buildID] {
+		return
+	}
+	progressUpdates[buildID] = true
+	time.AfterFunc(progressDelay, func() {
+		progressUpdatesMu.Lock()
+		delete(progressUpdates, buildID)
+		progressUpdatesMu.Unlock()
+		rdb.Publish(context.Background(), "updatedBuild", buildID)
+	})
+}
+
+// buildDurations returns the historical durations of a build, only the keys it didn't look up yet (or
+// durationsTTL ago) are queried
+func buildDurations(ctx context.Context, client *ent.Client, entBuild *ent.Build, durationKeys map[string]bool) (map[string]time.Duration, error) {
+	now := time.Now()
+	durationsCacheMu.Lock()
+	for buildID, cached := range durationsCache {
+		if now.Sub(cached.loadedAt) > durationsTTL {
+			delete(durationsCache, buildID)
+		}
+	}
+	cached, exists := durationsCache[entBuild.ID]
+	if !exists {
+		cached = &cachedDurations{loadedAt: now, durations: map[string]time.Duration{}, queried: map[string]bool{}}
+		durationsCache[entBuild.ID] = cached
+	}
+	missingKeys := map[string]bool{}
+	for durationKey := range durationKeys {
+		if !cached.queried[durationKey] {
+			missingKeys[durationKey] = true
+		}
+	}
+	durationsCacheMu.Unlock()
+
+	var missing map[string]time.Duration
+	if len(missingKeys) > 0 {
+		var err error
+		missing, err = historicalDurations(ctx, client, entBuild, missingKeys)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	durationsCacheMu.Lock()
+	defer durationsCacheMu.Unlock()
+	for durationKey := range missingKeys {
+		cached.queried[durationKey] = true
+		if duration, exists := missing[durationKey]; exists {
+			cached.durations[durationKey] = duration
+		}
+	}
+	durations := make(map[string]time.Duration, len(durationKeys))
+	for durationKey := range durationKeys {
+		if duration, exists := cached.durations[durationKey]; exists {
+			durations[durationKey] = duration
+		}
+	}
+	return durations, nil
+}
+
+// historicalDurations averages how long the latest runs of the networks, hosts, scripts and commands took in
+// the other builds. The keys without any run are left out.
+func historicalDurations(ctx context.Context, client *ent.Client, entBuild *ent.Build, durationKeys map[string]bool) (map[string]time.Duration, error) {
+	durations := map[string]time.Duration{}
+	for durationKey := range durationKeys {
+		i := strings.Index(durationKey, ":")
+		if i < 0 {
+			continue
+		}
+		kind, hclID := durationKey[:i], durationKey[i+1:]
+		var planPredicate predicate.Plan
+		switch kind {
+		case "network":
+			planPredicate = plan.HasPlanToProvisionedNetworkWith(
+				provisionednetwork.HasProvisionedNetworkToNetworkWith(network.HclIDEQ(hclID)),
+			)
+		case "host":
+			planPredicate = plan.HasPlanToProvisionedHostWith(
+				provisionedhost.HasProvisionedHostToHostWith(host.HclIDEQ(hclID)),
+			)
+		case "script":
+			planPredicate = plan.HasPlanToProvisioningStepWith(
+				provisioningstep.HasProvisioningStepToScriptWith(script.HclIDEQ(hclID)),
+			)
+		case "command":
+			planPredicate = plan.HasPlanToProvisioningStepWith(
+				provisioningstep.HasProvisioningStepToCommandWith(command.HclIDEQ(hclID)),
+			)
+		default:
+			continue
+		}
+		entStatuses, err := client.Status.Query().Where(
+			status.StateEQ(status.StateCOMPLETE),
+			status.StartedAtNotNil(),
+			status.EndedAtNotNil(),
+			status.HasStatusToPlanWith(
+				planPredicate,
+				plan.Not(plan.HasPlanToBuildWith(build.IDEQ(entBuild.ID))),
+			),
+		).Order(ent.Desc(status.FieldEndedAt)).Limit(durationSamples).All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error querying previous runs of %s: %v", durationKey, err)
+		}
+		if len(entStatuses) == 0 {
+			continue
+		}
+		var total time.Duration
+		for _, entStatus := range entStatuses {
+			total += entStatus.EndedAt.Sub(entStatus.StartedAt)
+		}
+		durations[durationKey] = total / time.Duration(len(entStatuses))
+	}
+	return durations, nil
+}
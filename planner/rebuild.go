@@ -41,6 +41,7 @@ func Rebuild(client *ent.Client, rdb *redis.Client, logger *logging.Logger, curr
 		SetType(buildcommit.TypeREBUILD).
 		SetState(buildcommit.StatePLANNING).
 		SetBuildCommitToBuild(entBuild).
+		SetCreatedBy(currentUser.ID.String()).
 		Save(ctx)
 	if err != nil {
 		spawnedRebuildSuccessfully <- false
@@ -74,7 +75,7 @@ func Rebuild(client *ent.Client, rdb *redis.Client, logger *logging.Logger, curr
 	spawnedRebuildSuccessfully <- true
 
 	logger.Log.Debug("-----\nWAITING FOR COMMIT REVIEW\n-----")
-	isApproved, err := utils.WaitForCommitReview(client, entRebuildCommit, 20*time.Minute)
+	isApproved, err := utils.WaitForCommitReview(client, rdb, entRebuildCommit)
 	if err != nil {
 		logger.Log.Errorf("error while waiting for rebuild commit to be reviewed: %v", err)
 		entRebuildCommit.Update().SetState(buildcommit.StateCANCELLED).Exec(ctx)
@@ -85,7 +86,7 @@ func Rebuild(client *ent.Client, rdb *redis.Client, logger *logging.Logger, curr
 	// Cancelled or timeout reached
 	if !isApproved {
 		logger.Log.Debug("-----\nCOMMIT CANCELLED/TIMED OUT\n-----")
-		logger.Log.Errorf("rebuild commit has been cancelled or 20 minute timeout has been reached")
+		logger.Log.Errorf("rebuild commit has been cancelled or the review timeout has been reached")
 		err = entRebuildCommit.Update().SetState(buildcommit.StateCANCELLED).Exec(ctx)
 		if err != nil {
 			logger.Log.Errorf("error while cancelling rebuild commit: %v", err)
@@ -96,7 +97,7 @@ func Rebuild(client *ent.Client, rdb *redis.Client, logger *logging.Logger, curr
 		if err != nil {
 			return false, fmt.Errorf("error failing execute build server task: %v", err)
 		}
-		return false, fmt.Errorf("commit has been cancelled or 20 minute timeout has been reached")
+		return false, fmt.Errorf("commit has been cancelled or the review timeout has been reached")
 	}
 	logger.Log.Debug("-----\nCOMMIT APPROVED\n-----")
 
@@ -127,7 +128,9 @@ func Rebuild(client *ent.Client, rdb *redis.Client, logger *logging.Logger, curr
 		return false, err
 	}
 
-	deleteContext := context.Background()
+	// Both the teardown and the build of the rebuild stop when the commit is cancelled
+	deleteContext, rebuildDone := commitContext(entRebuildCommit)
+	defer rebuildDone()
 	// Mark all plans involved for rebuild
 	// for _, entPlan := range entPlans {
 	err = markForRoutine(deleteContext, logger, status.StateTODELETE, entRebuildCommit)
@@ -149,12 +152,18 @@ func Rebuild(client *ent.Client, rdb *redis.Client, logger *logging.Logger, curr
 		go deleteRoutine(client, logger, &genericBuilder, deleteContext, entPlan, &wg)
 	}
 	wg.Wait()
+	if deleteContext.Err() != nil {
+		return false, cancelledCommit(client, logger, serverTask, taskStatus, entRebuildCommit)
+	}
 
 	logger.Log.Debug("waiting for deletion to propagate to all systems")
-	time.Sleep(1 * time.Minute)
+	select {
+	case <-time.After(1 * time.Minute):
+	case <-deleteContext.Done():
+		return false, cancelledCommit(client, logger, serverTask, taskStatus, entRebuildCommit)
+	}
 
-	buildContext := context.Background()
-	defer buildContext.Done()
+	buildContext := deleteContext
 	// for _, entPlan := range entPlans {
 	err = markForRoutine(buildContext, logger, status.StateAWAITING, entRebuildCommit)
 	if err != nil {
@@ -175,6 +184,9 @@ func Rebuild(client *ent.Client, rdb *redis.Client, logger *logging.Logger, curr
 		go buildRoutine(client, logger, &genericBuilder, buildContext, entPlan, &wg2)
 	}
 	wg2.Wait()
+	if buildContext.Err() != nil {
+		return false, cancelledCommit(client, logger, serverTask, taskStatus, entRebuildCommit)
+	}
 
 	err = entRebuildCommit.Update().SetState(buildcommit.StateAPPLIED).Exec(ctx)
 	if err != nil {
@@ -0,0 +1,221 @@
+package planner
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gen0cide/laforge/builder"
+	"github.com/gen0cide/laforge/ent"
+	"github.com/gen0cide/laforge/ent/status"
+	"github.com/gen0cide/laforge/logging"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/semaphore"
+)
+
+// DriftKind describes how a provisioned object differs from the real infrastructure
+type DriftKind string
+
+const (
+	// DriftMissing means the VM/segment no longer exists
+	DriftMissing DriftKind = "MISSING"
+	// DriftPoweredOff means the VM exists but isn't running
+	DriftPoweredOff DriftKind = "POWERED_OFF"
+)
+
+// maxRefreshWorkers is how many hosts/networks are checked against the builder at once
+const maxRefreshWorkers = 8
+
+// Drift is a provisioned host or network that doesn't match the real infrastructure
+type Drift struct {
+	ProvisionedHost    *ent.ProvisionedHost
+	ProvisionedNetwork *ent.ProvisionedNetwork
+	TeamNumber         int
+	Kind               DriftKind
+	Detail             string
+}
+
+// DriftReport is the result of refreshing a build against its builder
+type DriftReport struct {
+	BuildID         uuid.UUID
+	CheckedAt       time.Time
+	CheckedHosts    int
+	CheckedNetworks int
+	// Errors are checks that couldn't be completed, the objects they are about are left untouched
+	Errors []string
+	Drift  []Drift
+}
+
+// driftReports only lives in memory on purpose: a report is a snapshot that goes stale as soon as the
+// infrastructure changes, so it isn't persisted. A server restart drops every report and getDriftReport
+// returns null until the build is refreshed again.
+var (
+	driftReportsMu sync.RWMutex
+	driftReports   = map[uuid.UUID]*DriftReport{}
+)
+
+// LastDriftReport returns the report of the last refresh of a build since the server started
+func LastDriftReport(buildID uuid.UUID) (*DriftReport, bool) {
+	driftReportsMu.RLock()
+	defer driftReportsMu.RUnlock()
+	report, exists := driftReports[buildID]
+	return report, exists
+}
+
+// RefreshBuild asks the build's builder whether every deployed host and network still exists
+// (and every host is powered on). Mismatches are marked TAINTED with the drift in the status
+// error so they show up as needing a rebuild.
+func RefreshBuild(ctx context.Context, client *ent.Client, rdb *redis.Client, logger *logging.Logger, entBuild *ent.Build) (*DriftReport, error) {
+	entEnvironment, err := entBuild.QueryBuildToEnvironment().Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error querying environment from build: %v", err)
+	}
+	genericBuilder, err := builder.BuilderFromEnvironment(entEnvironment, logger)
+	if err != nil {
+		return nil, fmt.Errorf("error generating builder: %v", err)
+	}
+	refresher, ok := genericBuilder.(builder.Refresher)
+	if !ok {
+		return nil, fmt.Errorf("builder \"%s\" doesn't support refreshing", entEnvironment.Builder)
+	}
+
+	entProNetworks, err := entBuild.QueryBuildToProvisionedNetwork().
+		WithProvisionedNetworkToStatus().
+		WithProvisionedNetworkToTeam().
+		WithProvisionedNetworkToProvisionedHost(func(phq *ent.ProvisionedHostQuery) {
+			phq.WithProvisionedHostToStatus()
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error querying provisioned networks from build: %v", err)
+	}
+
+	report := &DriftReport{
+		BuildID:   entBuild.ID,
+		CheckedAt: time.Now(),
+		Errors:    []string{},
+		Drift:     []Drift{},
+	}
+	var reportMu sync.Mutex
+	addDrift := func(drift Drift, entStatus *ent.Status) {
+		logger.Log.WithFields(logrus.Fields{
+			"team":   drift.TeamNumber,
+			"kind":   drift.Kind,
+			"detail": drift.Detail,
+		}).Warn("drift detected")
+		if entStatus.State == status.StateCOMPLETE {
+			err := entStatus.Update().SetState(status.StateTAINTED).SetError(drift.Detail).Exec(ctx)
+			if err != nil {
+				logger.Log.Errorf("error marking status %s as TAINTED: %v", entStatus.ID, err)
+			} else {
+				rdb.Publish(ctx, "updatedStatus", entStatus.ID.String())
+			}
+		}
+		reportMu.Lock()
+		report.Drift = append(report.Drift, drift)
+		reportMu.Unlock()
+	}
+	addError := func(err error) {
+		logger.Log.Error(err)
+		reportMu.Lock()
+		report.Errors = append(report.Errors, err.Error())
+		reportMu.Unlock()
+	}
+
+	workers := semaphore.NewWeighted(maxRefreshWorkers)
+	var wg sync.WaitGroup
+	for _, entProNetwork := range entProNetworks {
+		teamNumber := -1
+		if entProNetwork.Edges.ProvisionedNetworkToTeam != nil {
+			teamNumber = entProNetwork.Edges.ProvisionedNetworkToTeam.TeamNumber
+		}
+		if isDeployed(entProNetwork.Edges.ProvisionedNetworkToStatus) {
+			report.CheckedNetworks++
+			wg.Add(1)
+			go func(entProNetwork *ent.ProvisionedNetwork, teamNumber int) {
+				defer wg.Done()
+				if err := workers.Acquire(ctx, 1); err != nil {
+					addError(err)
+					return
+				}
+				defer workers.Release(1)
+				exists, err := refresher.RefreshNetwork(ctx, entProNetwork)
+				if err != nil {
+					addError(fmt.Errorf("error refreshing network %s for team %d: %v", entProNetwork.Name, teamNumber, err))
+					return
+				}
+				if !exists {
+					addDrift(Drift{
+						ProvisionedNetwork: entProNetwork,
+						TeamNumber:         teamNumber,
+						Kind:               DriftMissing,
+						Detail:             fmt.Sprintf("network %s for team %d no longer exists", entProNetwork.Name, teamNumber),
+					}, entProNetwork.Edges.ProvisionedNetworkToStatus)
+				}
+			}(entProNetwork, teamNumber)
+		}
+		for _, entProHost := range entProNetwork.Edges.ProvisionedNetworkToProvisionedHost {
+			if !isDeployed(entProHost.Edges.ProvisionedHostToStatus) {
+				continue
+			}
+			report.CheckedHosts++
+			wg.Add(1)
+			go func(entProHost *ent.ProvisionedHost, teamNumber int) {
+				defer wg.Done()
+				if err := workers.Acquire(ctx, 1); err != nil {
+					addError(err)
+					return
+				}
+				defer workers.Release(1)
+				exists, poweredOn, err := refresher.RefreshHost(ctx, entProHost)
+				if err != nil {
+					addError(fmt.Errorf("error refreshing host %s for team %d: %v", entProHost.SubnetIP, teamNumber, err))
+					return
+				}
+				if !exists {
+					addDrift(Drift{
+						ProvisionedHost: entProHost,
+						TeamNumber:      teamNumber,
+						Kind:            DriftMissing,
+						Detail:          fmt.Sprintf("host %s for team %d no longer exists", entProHost.SubnetIP, teamNumber),
+					}, entProHost.Edges.ProvisionedHostToStatus)
+				} else if !poweredOn {
+					addDrift(Drift{
+						ProvisionedHost: entProHost,
+						TeamNumber:      teamNumber,
+						Kind:            DriftPoweredOff,
+						Detail:          fmt.Sprintf("host %s for team %d is powered off", entProHost.SubnetIP, teamNumber),
+					}, entProHost.Edges.ProvisionedHostToStatus)
+				}
+			}(entProHost, teamNumber)
+		}
+	}
+	wg.Wait()
+
+	sort.Slice(report.Drift, func(i, j int) bool {
+		if report.Drift[i].TeamNumber != report.Drift[j].TeamNumber {
+			return report.Drift[i].TeamNumber < report.Drift[j].TeamNumber
+		}
+		return report.Drift[i].Detail < report.Drift[j].Detail
+	})
+	sort.Strings(report.Errors)
+
+	driftReportsMu.Lock()
+	driftReports[entBuild.ID] = report
+	driftReportsMu.Unlock()
+
+	logger.Log.Infof("refreshed build %s: checked %d hosts and %d networks, found %d drifted", entBuild.ID, report.CheckedHosts, report.CheckedNetworks, len(report.Drift))
+	return report, nil
+}
+
+// isDeployed returns true if the object behind the status should exist on the infrastructure
+func isDeployed(entStatus *ent.Status) bool {
+	if entStatus == nil {
+		return false
+	}
+	return entStatus.State == status.StateCOMPLETE || entStatus.State == status.StateTAINTED
+}
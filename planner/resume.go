@@ -0,0 +1,245 @@
+package planner
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/gen0cide/laforge/builder"
+	"github.com/gen0cide/laforge/ent"
+	"github.com/gen0cide/laforge/ent/build"
+	"github.com/gen0cide/laforge/ent/buildcommit"
+	"github.com/gen0cide/laforge/ent/plan"
+	"github.com/gen0cide/laforge/ent/plandiff"
+	"github.com/gen0cide/laforge/ent/servertask"
+	"github.com/gen0cide/laforge/ent/status"
+	"github.com/gen0cide/laforge/logging"
+	"github.com/gen0cide/laforge/server/utils"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// ResumeBuilds picks back up the builds (and rebuilds) that were in progress when the server stopped. The
+// IDs of the resumed builds are returned so their interrupted server tasks aren't failed on startup.
+func ResumeBuilds(ctx context.Context, client *ent.Client) ([]uuid.UUID, error) {
+	entBuilds, err := client.Build.Query().Where(
+		build.HasBuildToLatestBuildCommitWith(
+			buildcommit.StateEQ(buildcommit.StateINPROGRESS),
+			buildcommit.TypeIn(buildcommit.TypeROOT, buildcommit.TypeREBUILD),
+		),
+	).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error querying in progress builds: %v", err)
+	}
+	resumedBuildIDs := []uuid.UUID{}
+	for _, entBuild := range entBuilds {
+		tearingDown, err := rebuildTearingDown(ctx, entBuild)
+		if err != nil {
+			logrus.Errorf("error querying plans of build %s: %v", entBuild.ID, err)
+			continue
+		}
+		if tearingDown {
+			logrus.Warnf("build %s was interrupted while deleting plans for a rebuild, it won't be resumed", entBuild.ID)
+			continue
+		}
+		serverTask, taskStatus, err := interruptedServerTask(ctx, client, entBuild)
+		if err != nil {
+			logrus.Errorf("error querying interrupted server task of build %s: %v", entBuild.ID, err)
+			continue
+		}
+		logger := &logging.Logger{Log: logrus.StandardLogger()}
+		if serverTask != nil {
+			logger, err = logging.CreateLoggerForServerTask(serverTask)
+			if err != nil {
+				logrus.Errorf("error creating logger for server task %s: %v", serverTask.ID, err)
+				continue
+			}
+		}
+		err = resetInterruptedPlans(ctx, client, entBuild)
+		if err != nil {
+			logrus.Errorf("error resetting interrupted plans of build %s: %v", entBuild.ID, err)
+			continue
+		}
+		logrus.Infof("resuming build %s", entBuild.ID)
+		resumedBuildIDs = append(resumedBuildIDs, entBuild.ID)
+		go ResumeBuild(client, logger, serverTask, taskStatus, entBuild)
+	}
+	return resumedBuildIDs, nil
+}
+
+// rebuildTearingDown returns true if the build has plans a rebuild was still tearing down. The build walk can't pick those back up.
+func rebuildTearingDown(ctx context.Context, entBuild *ent.Build) (bool, error) {
+	return entBuild.QueryBuildToPlan().Where(
+		plan.HasPlanToStatusWith(status.StateIn(status.StateTODELETE, status.StateDELETEINPROGRESS)),
+	).Exist(ctx)
+}
+
+// ResumeCancelledCommit puts a cancelled build (or rebuild) commit back in progress so ResumeBuild can walk the
+// plans its cancellation put back to AWAITING. The commit has to still be the latest one of its build.
+func ResumeCancelledCommit(ctx context.Context, client *ent.Client, entCommit *ent.BuildCommit) (*ent.Build, error) {
+	if entCommit.State != buildcommit.StateCANCELLED {
+		return nil, fmt.Errorf("build commit is %s, only cancelled commits can be resumed", entCommit.State)
+	}
+	if entCommit.Type != buildcommit.TypeROOT && entCommit.Type != buildcommit.TypeREBUILD {
+		return nil, fmt.Errorf("%s commits can't be resumed", entCommit.Type)
+	}
+	entBuild, err := entCommit.QueryBuildCommitToBuild().Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error querying build of commit: %v", err)
+	}
+	latestCommit, err := entBuild.QueryBuildToLatestBuildCommit().Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error querying latest commit of build: %v", err)
+	}
+	if latestCommit.ID != entCommit.ID {
+		return nil, fmt.Errorf("build has a newer commit (revision %d), only the latest commit can be resumed", latestCommit.Revision)
+	}
+	tearingDown, err := rebuildTearingDown(ctx, entBuild)
+	if err != nil {
+		return nil, fmt.Errorf("error querying plans of build: %v", err)
+	}
+	if tearingDown {
+		return nil, fmt.Errorf("build was cancelled while deleting plans for a rebuild, it can't be resumed")
+	}
+	err = resetInterruptedPlans(ctx, client, entBuild)
+	if err != nil {
+		return nil, fmt.Errorf("error resetting interrupted plans of build: %v", err)
+	}
+	// Only flip the commit if it is still cancelled, so two resumes can't both walk the build
+	updated, err := client.BuildCommit.Update().Where(
+		buildcommit.IDEQ(entCommit.ID),
+		buildcommit.StateEQ(buildcommit.StateCANCELLED),
+	).SetState(buildcommit.StateINPROGRESS).Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while resuming build commit: %v", err)
+	}
+	if updated == 0 {
+		return nil, fmt.Errorf("build commit is already being resumed")
+	}
+	rdb.Publish(ctx, "updatedBuildCommit", entCommit.ID.String())
+	return entBuild, nil
+}
+
+// interruptedServerTask returns the execute build (or rebuild) server task that was running the build, if there is one
+func interruptedServerTask(ctx context.Context, client *ent.Client, entBuild *ent.Build) (*ent.ServerTask, *ent.Status, error) {
+	serverTask, err := client.ServerTask.Query().Where(
+		servertask.HasServerTaskToBuildWith(build.IDEQ(entBuild.ID)),
+		servertask.TypeIn(servertask.TypeEXECUTEBUILD, servertask.TypeREBUILD),
+		servertask.HasServerTaskToStatusWith(status.StateEQ(status.StateINPROGRESS)),
+	).Order(ent.Desc(servertask.FieldStartTime)).First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	taskStatus, err := serverTask.QueryServerTaskToStatus().Only(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return serverTask, taskStatus, nil
+}
+
+// resetInterruptedPlans puts the plans whose build routine was lost back to AWAITING. Finished plans are
+// left alone so they aren't redone, the steps that were executing re-attach to their agent tasks (see execStep).
+func resetInterruptedPlans(ctx context.Context, client *ent.Client, entBuild *ent.Build) error {
+	err := client.Status.Update().Where(
+		status.HasStatusToPlanWith(plan.HasPlanToBuildWith(build.IDEQ(entBuild.ID))),
+		status.StateIn(status.StatePARENTAWAITING, status.StateINPROGRESS),
+	).SetState(status.StateAWAITING).Exec(ctx)
+	if err != nil {
+		return err
+	}
+	entCommit, err := entBuild.QueryBuildToLatestBuildCommit().Only(ctx)
+	if err != nil {
+		return err
+	}
+	if entCommit.Type != buildcommit.TypeREBUILD {
+		return nil
+	}
+	// A rebuild interrupted between deleting its plans and marking them for the build has them DELETED
+	return client.Status.Update().Where(
+		status.HasStatusToPlanWith(
+			plan.HasPlanToBuildWith(build.IDEQ(entBuild.ID)),
+			plan.HasPlanToPlanDiffsWith(
+				plandiff.NewStateEQ(plandiff.NewStateTOREBUILD),
+				plandiff.HasPlanDiffToBuildCommitWith(buildcommit.IDEQ(entCommit.ID)),
+			),
+		),
+		status.StateEQ(status.StateDELETED),
+	).SetState(status.StateAWAITING).Exec(ctx)
+}
+
+// ResumeBuild walks the plans of a build that are still AWAITING. Each of them waits on its parents
+// like in StartBuild, the parents that already finished don't hold it up.
+func ResumeBuild(client *ent.Client, logger *logging.Logger, serverTask *ent.ServerTask, taskStatus *ent.Status, entBuild *ent.Build) error {
+	ctx := context.Background()
+	defer ctx.Done()
+
+	failServerTask := func() {
+		if serverTask == nil {
+			return
+		}
+		_, _, err := utils.FailServerTask(ctx, client, rdb, taskStatus, serverTask)
+		if err != nil {
+			logger.Log.Errorf("error failing execute build server task: %v", err)
+		}
+	}
+
+	environment, err := entBuild.QueryBuildToEnvironment().Only(ctx)
+	if err != nil {
+		logger.Log.Errorf("Failed to Query Environment. Err: %v", err)
+		failServerTask()
+		return err
+	}
+	genericBuilder, err := builder.BuilderFromEnvironment(environment, logger)
+	if err != nil {
+		logger.Log.Errorf("error generating builder: %v", err)
+		failServerTask()
+		return err
+	}
+	entCommit, err := entBuild.QueryBuildToLatestBuildCommit().Only(ctx)
+	if err != nil {
+		logger.Log.Errorf("error while querying lastest commit from build: %v", err)
+		failServerTask()
+		return err
+	}
+
+	awaitingPlans, err := entBuild.QueryBuildToPlan().Where(plan.HasPlanToStatusWith(status.StateEQ(status.StateAWAITING))).All(ctx)
+	if err != nil {
+		logger.Log.Errorf("Failed to Query Plan Nodes. Err: %v", err)
+		failServerTask()
+		return err
+	}
+	logger.Log.Infof("resuming build %s with %d plans left", entBuild.ID, len(awaitingPlans))
+
+	buildCtx, buildDone := commitContext(entCommit)
+	defer buildDone()
+	var wg sync.WaitGroup
+	for _, entPlan := range awaitingPlans {
+		wg.Add(1)
+		go buildRoutine(client, logger, &genericBuilder, buildCtx, entPlan, &wg)
+	}
+	wg.Wait()
+
+	if buildCtx.Err() != nil {
+		return cancelledCommit(client, logger, serverTask, taskStatus, entCommit)
+	}
+
+	if serverTask != nil {
+		_, _, err = utils.CompleteServerTask(ctx, client, rdb, taskStatus, serverTask)
+		if err != nil {
+			logger.Log.Errorf("error completing execute build server task: %v", err)
+			return err
+		}
+	}
+
+	err = entCommit.Update().SetState(buildcommit.StateAPPLIED).Exec(ctx)
+	if err != nil {
+		logger.Log.Errorf("error while applying build commit: %v", err)
+		return err
+	}
+	rdb.Publish(ctx, "updatedBuildCommit", entCommit.ID.String())
+
+	return nil
+}
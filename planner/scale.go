@@ -0,0 +1,515 @@
+package planner
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/gen0cide/laforge/builder"
+	"github.com/gen0cide/laforge/ent"
+	"github.com/gen0cide/laforge/ent/build"
+	"github.com/gen0cide/laforge/ent/buildcommit"
+	"github.com/gen0cide/laforge/ent/plan"
+	"github.com/gen0cide/laforge/ent/plandiff"
+	"github.com/gen0cide/laforge/ent/provisionedhost"
+	"github.com/gen0cide/laforge/ent/provisionednetwork"
+	"github.com/gen0cide/laforge/ent/provisioningstep"
+	"github.com/gen0cide/laforge/ent/status"
+	"github.com/gen0cide/laforge/ent/team"
+	"github.com/gen0cide/laforge/ipam"
+	"github.com/gen0cide/laforge/logging"
+	"github.com/gen0cide/laforge/server/utils"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+)
+
+// ScaleBuild plans the teams a build is missing to reach teamCount and deploys them once the commit is approved.
+// The commit is a REBUILD of the new teams' plans, the teams that already exist aren't touched. The filter a
+// build was created with isn't kept, so new teams get every host of the environment.
+func ScaleBuild(client *ent.Client, rdb *redis.Client, logger *logging.Logger, currentUser *ent.AuthUser, serverTask *ent.ServerTask, taskStatus *ent.Status, entBuild *ent.Build, teamCount int, spawnedScale chan bool) (bool, error) {
+	ctx := context.Background()
+	defer ctx.Done()
+
+	existingTeamNumbers, err := entBuild.QueryBuildToTeam().Select(team.FieldTeamNumber).Ints(ctx)
+	if err != nil {
+		spawnedScale <- false
+		logger.Log.Errorf("error querying teams of build: %v", err)
+		return false, err
+	}
+	existing := map[int]bool{}
+	for _, teamNumber := range existingTeamNumbers {
+		existing[teamNumber] = true
+	}
+	newTeamNumbers := []int{}
+	for teamNumber := 0; teamNumber < teamCount; teamNumber++ {
+		if !existing[teamNumber] {
+			newTeamNumbers = append(newTeamNumbers, teamNumber)
+		}
+	}
+	if len(newTeamNumbers) == 0 {
+		spawnedScale <- false
+		logger.Log.Errorf("build already has %d teams", len(existingTeamNumbers))
+		return false, fmt.Errorf("build already has %d teams", len(existingTeamNumbers))
+	}
+
+	var wg sync.WaitGroup
+	createErrs := make(chan error, len(newTeamNumbers))
+	for _, teamNumber := range newTeamNumbers {
+		wg.Add(1)
+		go func(teamNumber int) {
+			_, err := createTeam(client, logger, entBuild, teamNumber, nil, &wg)
+			if err != nil {
+				createErrs <- fmt.Errorf("error creating team %d: %v", teamNumber, err)
+			}
+		}(teamNumber)
+	}
+	wg.Wait()
+	forgetRemoteFiles(entBuild.ID)
+	close(createErrs)
+	if err, failed := <-createErrs; failed {
+		spawnedScale <- false
+		logger.Log.Error(err)
+		// Don't leave the teams that were (partly) planned behind, the scale can be tried again
+		deleteErr := deleteTeams(ctx, client, entBuild, newTeamNumbers)
+		if deleteErr != nil {
+			logger.Log.Errorf("error cleaning up new teams: %v", deleteErr)
+		}
+		return false, err
+	}
+
+	teamPlans, err := entBuild.QueryBuildToPlan().Where(
+		plan.TypeEQ(plan.TypeStartTeam),
+		plan.HasPlanToTeamWith(team.TeamNumberIn(newTeamNumbers...)),
+	).All(ctx)
+	if err != nil {
+		spawnedScale <- false
+		logger.Log.Errorf("error querying plans of new teams: %v", err)
+		return false, err
+	}
+
+	scaleRevision, err := entBuild.QueryBuildToBuildCommits().Count(ctx)
+	if err != nil {
+		spawnedScale <- false
+		logger.Log.Errorf("error counting commits on build: %v", err)
+		return false, err
+	}
+	entScaleCommit, err := client.BuildCommit.Create().
+		SetRevision(scaleRevision).
+		SetType(buildcommit.TypeREBUILD).
+		SetState(buildcommit.StatePLANNING).
+		SetBuildCommitToBuild(entBuild).
+		SetCreatedBy(currentUser.ID.String()).
+		Save(ctx)
+	if err != nil {
+		spawnedScale <- false
+		logger.Log.Errorf("error while creating scale commit: %v", err)
+		return false, fmt.Errorf("error while creating scale commit: %v", err)
+	}
+	rdb.Publish(ctx, "updatedBuildCommit", entScaleCommit.ID.String())
+	err = entBuild.Update().SetBuildToLatestBuildCommit(entScaleCommit).Exec(ctx)
+	if err != nil {
+		spawnedScale <- false
+		logger.Log.Errorf("error while setting latest commit on build: %v", err)
+		return false, fmt.Errorf("error while setting latest commit on build: %v", err)
+	}
+	rdb.Publish(ctx, "updatedBuild", entBuild.ID.String())
+
+	for _, teamPlan := range teamPlans {
+		err = generateRebuildCommitPlans(client, ctx, teamPlan, entScaleCommit)
+		if err != nil {
+			spawnedScale <- false
+			logger.Log.Errorf("error generating plans for scale commit: %v", err)
+			return false, err
+		}
+	}
+
+	spawnedScale <- true
+
+	logger.Log.Debug("-----\nWAITING FOR COMMIT REVIEW\n-----")
+	isApproved, err := utils.WaitForCommitReview(client, rdb, entScaleCommit)
+	if err != nil {
+		logger.Log.Errorf("error while waiting for scale commit to be reviewed: %v", err)
+		entScaleCommit.Update().SetState(buildcommit.StateCANCELLED).Exec(ctx)
+		rdb.Publish(ctx, "updatedBuildCommit", entScaleCommit.ID.String())
+		return false, err
+	}
+
+	// Cancelled or timeout reached, the new teams stay PLANNING
+	if !isApproved {
+		logger.Log.Debug("-----\nCOMMIT CANCELLED/TIMED OUT\n-----")
+		logger.Log.Errorf("scale commit has been cancelled or the review timeout has been reached")
+		err = entScaleCommit.Update().SetState(buildcommit.StateCANCELLED).Exec(ctx)
+		if err != nil {
+			logger.Log.Errorf("error while cancelling scale commit: %v", err)
+			return false, err
+		}
+		rdb.Publish(ctx, "updatedBuildCommit", entScaleCommit.ID.String())
+		taskStatus, serverTask, err = utils.FailServerTask(ctx, client, rdb, taskStatus, serverTask)
+		if err != nil {
+			return false, fmt.Errorf("error failing scale build server task: %v", err)
+		}
+		return false, fmt.Errorf("commit has been cancelled or the review timeout has been reached")
+	}
+	logger.Log.Debug("-----\nCOMMIT APPROVED\n-----")
+
+	env, err := entBuild.QueryBuildToEnvironment().Only(ctx)
+	if err != nil {
+		logger.Log.Errorf("error querying environment from build: %v", err)
+		return false, err
+	}
+	genericBuilder, err := builder.BuilderFromEnvironment(env, logger)
+	if err != nil {
+		taskStatus, serverTask, err = utils.FailServerTask(ctx, client, rdb, taskStatus, serverTask)
+		if err != nil {
+			return false, fmt.Errorf("error failing scale build server task: %v", err)
+		}
+		logger.Log.Errorf("error generating builder: %v", err)
+		return false, err
+	}
+
+	err = entScaleCommit.Update().SetState(buildcommit.StateINPROGRESS).Exec(ctx)
+	if err != nil {
+		taskStatus, serverTask, err = utils.FailServerTask(ctx, client, rdb, taskStatus, serverTask)
+		if err != nil {
+			return false, fmt.Errorf("error failing scale build server task: %v", err)
+		}
+		logger.Log.Errorf("error while starting scale commit: %v", err)
+		return false, err
+	}
+	rdb.Publish(ctx, "updatedBuildCommit", entScaleCommit.ID.String())
+
+	buildContext, scaleDone := commitContext(entScaleCommit)
+	defer scaleDone()
+	err = markForRoutine(buildContext, logger, status.StateAWAITING, entScaleCommit)
+	if err != nil {
+		taskStatus, serverTask, err = utils.FailServerTask(ctx, client, rdb, taskStatus, serverTask)
+		if err != nil {
+			return false, fmt.Errorf("error failing scale build server task: %v", err)
+		}
+		return false, err
+	}
+
+	for _, teamPlan := range teamPlans {
+		wg.Add(1)
+		go buildRoutine(client, logger, &genericBuilder, buildContext, teamPlan, &wg)
+	}
+	wg.Wait()
+	if buildContext.Err() != nil {
+		return false, cancelledCommit(client, logger, serverTask, taskStatus, entScaleCommit)
+	}
+
+	err = entScaleCommit.Update().SetState(buildcommit.StateAPPLIED).Exec(ctx)
+	if err != nil {
+		taskStatus, serverTask, err = utils.FailServerTask(ctx, client, rdb, taskStatus, serverTask)
+		if err != nil {
+			return false, fmt.Errorf("error failing scale build server task: %v", err)
+		}
+		logger.Log.Errorf("error while applying scale commit: %v", err)
+		return false, err
+	}
+	rdb.Publish(ctx, "updatedBuildCommit", entScaleCommit.ID.String())
+
+	taskStatus, serverTask, err = utils.CompleteServerTask(ctx, client, rdb, taskStatus, serverTask)
+	if err != nil {
+		return false, fmt.Errorf("error completing scale build server task: %v", err)
+	}
+	return true, nil
+}
+
+// CheckTeamCidrs checks that the cidrs of an environment templated over ${team} are still valid for every team up
+// to teamCount, the loader only checks them up to the environment's team_count
+func CheckTeamCidrs(ctx context.Context, entEnvironment *ent.Environment, teamCount int) error {
+	if teamCount <= entEnvironment.TeamCount {
+		return nil
+	}
+	entNetworks, err := entEnvironment.QueryEnvironmentToNetwork().All(ctx)
+	if err != nil {
+		return fmt.Errorf("error querying networks of environment: %v", err)
+	}
+	for _, entNetwork := range entNetworks {
+		for _, cidr := range []string{entNetwork.Cidr, entNetwork.Ipv6Cidr} {
+			if !strings.Contains(cidr, ipam.TeamPlaceholder) {
+				continue
+			}
+			for teamNumber := entEnvironment.TeamCount; teamNumber < teamCount; teamNumber++ {
+				_, err = ipam.ParsePrefix(ipam.ForTeam(cidr, teamNumber))
+				if err != nil {
+					return fmt.Errorf("network %s can't have a team %d: %v", entNetwork.HclID, teamNumber, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// deleteTeams removes teams of a build that failed to plan along with everything planned for them
+func deleteTeams(ctx context.Context, client *ent.Client, entBuild *ent.Build, teamNumbers []int) error {
+	inTeams := team.And(team.HasTeamToBuildWith(build.IDEQ(entBuild.ID)), team.TeamNumberIn(teamNumbers...))
+	inNetworks := provisionednetwork.HasProvisionedNetworkToTeamWith(inTeams)
+	inHosts := provisionedhost.HasProvisionedHostToProvisionedNetworkWith(inNetworks)
+	inSteps := provisioningstep.HasProvisioningStepToProvisionedHostWith(inHosts)
+	inPlans := plan.Or(
+		plan.HasPlanToTeamWith(inTeams),
+		plan.HasPlanToProvisionedNetworkWith(inNetworks),
+		plan.HasPlanToProvisionedHostWith(inHosts),
+		plan.HasPlanToProvisioningStepWith(inSteps),
+	)
+	statusIDs, err := client.Status.Query().Where(status.Or(
+		status.HasStatusToTeamWith(inTeams),
+		status.HasStatusToProvisionedNetworkWith(inNetworks),
+		status.HasStatusToProvisionedHostWith(inHosts),
+		status.HasStatusToProvisioningStepWith(inSteps),
+		status.HasStatusToPlanWith(inPlans),
+	)).IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("error querying statuses of teams: %v", err)
+	}
+	// Plans point to everything else, they go first
+	_, err = client.Plan.Delete().Where(inPlans).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("error deleting plans of teams: %v", err)
+	}
+	_, err = client.ProvisioningStep.Delete().Where(inSteps).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("error deleting provisioning steps of teams: %v", err)
+	}
+	_, err = client.ProvisionedHost.Delete().Where(inHosts).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("error deleting provisioned hosts of teams: %v", err)
+	}
+	_, err = client.ProvisionedNetwork.Delete().Where(inNetworks).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("error deleting provisioned networks of teams: %v", err)
+	}
+	_, err = client.Team.Delete().Where(inTeams).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("error deleting teams: %v", err)
+	}
+	_, err = client.Status.Delete().Where(status.IDIn(statusIDs...)).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("error deleting statuses of teams: %v", err)
+	}
+	return nil
+}
+
+// RemoveTeam tears down a single team of a build once its DELETE commit is approved. The other teams keep running.
+func RemoveTeam(client *ent.Client, rdb *redis.Client, logger *logging.Logger, currentUser *ent.AuthUser, serverTask *ent.ServerTask, taskStatus *ent.Status, entBuild *ent.Build, teamNumber int, spawnedRemove chan bool) (bool, error) {
+	ctx := context.Background()
+	defer ctx.Done()
+
+	teamPlan, err := entBuild.QueryBuildToPlan().Where(
+		plan.TypeEQ(plan.TypeStartTeam),
+		plan.HasPlanToTeamWith(team.TeamNumberEQ(teamNumber)),
+	).Only(ctx)
+	if err != nil {
+		spawnedRemove <- false
+		logger.Log.Errorf("error querying plan of team %d: %v", teamNumber, err)
+		return false, fmt.Errorf("error querying plan of team %d: %v", teamNumber, err)
+	}
+
+	commitRevision, err := entBuild.QueryBuildToBuildCommits().Count(ctx)
+	if err != nil {
+		spawnedRemove <- false
+		logger.Log.Errorf("error counting commits on build: %v", err)
+		return false, err
+	}
+	entDeleteCommit, err := client.BuildCommit.Create().
+		SetRevision(commitRevision).
+		SetState(buildcommit.StatePLANNING).
+		SetType(buildcommit.TypeDELETE).
+		SetBuildCommitToBuild(entBuild).
+		SetCreatedBy(currentUser.ID.String()).
+		Save(ctx)
+	if err != nil {
+		spawnedRemove <- false
+		logger.Log.Errorf("error creating remove team commit: %v", err)
+		return false, fmt.Errorf("error creating remove team commit: %v", err)
+	}
+	rdb.Publish(ctx, "updatedBuildCommit", entDeleteCommit.ID.String())
+	err = entBuild.Update().SetBuildToLatestBuildCommit(entDeleteCommit).Exec(ctx)
+	if err != nil {
+		spawnedRemove <- false
+		logger.Log.Errorf("error while setting latest commit on build: %v", err)
+		return false, fmt.Errorf("error while setting latest commit on build: %v", err)
+	}
+	rdb.Publish(ctx, "updatedBuild", entBuild.ID.String())
+
+	teamPlans, err := generateRemoveTeamCommitPlans(client, ctx, teamPlan, entDeleteCommit)
+	if err != nil {
+		spawnedRemove <- false
+		logger.Log.Errorf("error generating plans for remove team commit: %v", err)
+		return false, err
+	}
+
+	spawnedRemove <- true
+
+	logger.Log.Debug("-----\nWAITING FOR COMMIT REVIEW\n-----")
+	isApproved, err := utils.WaitForCommitReview(client, rdb, entDeleteCommit)
+	if err != nil {
+		logger.Log.Errorf("error while waiting for remove team commit to be reviewed: %v", err)
+		entDeleteCommit.Update().SetState(buildcommit.StateCANCELLED).Exec(ctx)
+		rdb.Publish(ctx, "updatedBuildCommit", entDeleteCommit.ID.String())
+		return false, err
+	}
+
+	// Cancelled or timeout reached
+	if !isApproved {
+		logger.Log.Debug("-----\nCOMMIT CANCELLED/TIMED OUT\n-----")
+		logger.Log.Errorf("remove team commit has been cancelled or the review timeout has been reached")
+		err = entDeleteCommit.Update().SetState(buildcommit.StateCANCELLED).Exec(ctx)
+		if err != nil {
+			logger.Log.Errorf("error while cancelling remove team commit: %v", err)
+			return false, err
+		}
+		rdb.Publish(ctx, "updatedBuildCommit", entDeleteCommit.ID.String())
+		taskStatus, serverTask, err = utils.FailServerTask(ctx, client, rdb, taskStatus, serverTask)
+		if err != nil {
+			return false, fmt.Errorf("error failing remove team server task: %v", err)
+		}
+		return false, fmt.Errorf("commit has been cancelled or the review timeout has been reached")
+	}
+	logger.Log.Debug("-----\nCOMMIT APPROVED\n-----")
+
+	err = entDeleteCommit.Update().SetState(buildcommit.StateINPROGRESS).Exec(ctx)
+	if err != nil {
+		taskStatus, serverTask, err = utils.FailServerTask(ctx, client, rdb, taskStatus, serverTask)
+		if err != nil {
+			return false, fmt.Errorf("error failing remove team server task: %v", err)
+		}
+		logger.Log.Errorf("error while starting remove team commit: %v", err)
+		return false, err
+	}
+	rdb.Publish(ctx, "updatedBuildCommit", entDeleteCommit.ID.String())
+
+	for _, entPlan := range teamPlans {
+		planStatus, err := entPlan.PlanToStatus(ctx)
+		if err == nil {
+			err = planStatus.Update().SetState(status.StateTODELETE).Exec(ctx)
+		}
+		if err != nil {
+			taskStatus, serverTask, err = utils.FailServerTask(ctx, client, rdb, taskStatus, serverTask)
+			if err != nil {
+				return false, fmt.Errorf("error failing remove team server task: %v", err)
+			}
+			return false, err
+		}
+		rdb.Publish(ctx, "updatedStatus", planStatus.ID.String())
+	}
+
+	environment, err := entBuild.QueryBuildToEnvironment().Only(ctx)
+	if err != nil {
+		logger.Log.Errorf("error querying environment from build: %v", err)
+		taskStatus, serverTask, err = utils.FailServerTask(ctx, client, rdb, taskStatus, serverTask)
+		if err != nil {
+			return false, fmt.Errorf("error failing remove team server task: %v", err)
+		}
+		return false, err
+	}
+	genericBuilder, err := builder.BuilderFromEnvironment(environment, logger)
+	if err != nil {
+		logger.Log.Errorf("error generating builder: %v", err)
+		taskStatus, serverTask, err = utils.FailServerTask(ctx, client, rdb, taskStatus, serverTask)
+		if err != nil {
+			return false, fmt.Errorf("error failing remove team server task: %v", err)
+		}
+		return false, err
+	}
+
+	deleteContext, deleteDone := commitContext(entDeleteCommit)
+	defer deleteDone()
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go deleteRoutine(client, logger, &genericBuilder, deleteContext, teamPlan, &wg)
+	wg.Wait()
+	if deleteContext.Err() != nil {
+		return false, cancelledCommit(client, logger, serverTask, taskStatus, entDeleteCommit)
+	}
+
+	teamPlanStatus, err := teamPlan.QueryPlanToStatus().Only(ctx)
+	if err != nil {
+		logger.Log.Errorf("error querying status of team %d plan: %v", teamNumber, err)
+		_, _, failErr := utils.FailServerTask(ctx, client, rdb, taskStatus, serverTask, err)
+		if failErr != nil {
+			return false, fmt.Errorf("error failing remove team server task: %v", failErr)
+		}
+		return false, fmt.Errorf("error querying status of team %d plan: %v", teamNumber, err)
+	}
+	if teamPlanStatus.State != status.StateDELETED {
+		logger.Log.Errorf("team %d wasn't fully deleted, its plan is %s", teamNumber, teamPlanStatus.State)
+		taskStatus, serverTask, err = utils.FailServerTask(ctx, client, rdb, taskStatus, serverTask)
+		if err != nil {
+			return false, fmt.Errorf("error failing remove team server task: %v", err)
+		}
+		return false, fmt.Errorf("team %d wasn't fully deleted, its plan is %s", teamNumber, teamPlanStatus.State)
+	}
+	// Drop the team itself so ScaleBuild can add a team with this number back
+	_, err = client.Team.Delete().Where(
+		team.TeamNumberEQ(teamNumber),
+		team.HasTeamToBuildWith(build.IDEQ(entBuild.ID)),
+	).Exec(ctx)
+	if err != nil {
+		logger.Log.Errorf("error deleting team %d: %v", teamNumber, err)
+		_, _, failErr := utils.FailServerTask(ctx, client, rdb, taskStatus, serverTask, err)
+		if failErr != nil {
+			return false, fmt.Errorf("error failing remove team server task: %v", failErr)
+		}
+		return false, fmt.Errorf("error deleting team %d: %v", teamNumber, err)
+	}
+	rdb.Publish(ctx, "updatedBuild", entBuild.ID.String())
+
+	logger.Log.Debugf("removed team %d", teamNumber)
+
+	err = entDeleteCommit.Update().SetState(buildcommit.StateAPPLIED).Exec(ctx)
+	if err != nil {
+		taskStatus, serverTask, err = utils.FailServerTask(ctx, client, rdb, taskStatus, serverTask)
+		if err != nil {
+			return false, fmt.Errorf("error failing remove team server task: %v", err)
+		}
+		logger.Log.Errorf("error while applying remove team commit: %v", err)
+		return false, err
+	}
+	rdb.Publish(ctx, "updatedBuildCommit", entDeleteCommit.ID.String())
+
+	taskStatus, serverTask, err = utils.CompleteServerTask(ctx, client, rdb, taskStatus, serverTask)
+	if err != nil {
+		return false, fmt.Errorf("error completing remove team server task: %v", err)
+	}
+	return true, nil
+}
+
+// generateRemoveTeamCommitPlans adds a TODELETE diff for the team plan and every plan under it, which are returned
+func generateRemoveTeamCommitPlans(client *ent.Client, ctx context.Context, teamPlan *ent.Plan, entDeleteCommit *ent.BuildCommit) ([]*ent.Plan, error) {
+	teamPlans := []*ent.Plan{}
+	seen := map[uuid.UUID]bool{}
+	queue := []*ent.Plan{teamPlan}
+	for len(queue) > 0 {
+		entPlan := queue[0]
+		queue = queue[1:]
+		if seen[entPlan.ID] {
+			continue
+		}
+		seen[entPlan.ID] = true
+		teamPlans = append(teamPlans, entPlan)
+
+		diffRevision, err := entPlan.QueryPlanToPlanDiffs().Count(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error while counting plan diffs on plan: %v", err)
+		}
+		_, err = client.PlanDiff.Create().
+			SetNewState(plandiff.NewStateTODELETE).
+			SetRevision(diffRevision).
+			SetPlanDiffToBuildCommit(entDeleteCommit).
+			SetPlanDiffToPlan(entPlan).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error while creating plan diff: %v", err)
+		}
+		nextPlans, err := entPlan.QueryNextPlan().All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error querying next plans of plan %s: %v", entPlan.ID, err)
+		}
+		queue = append(queue, nextPlans...)
+	}
+	return teamPlans, nil
+}
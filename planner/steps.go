@@ -0,0 +1,526 @@
+package planner
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gen0cide/laforge/ent"
+	"github.com/gen0cide/laforge/ent/agenttask"
+	"github.com/gen0cide/laforge/ent/command"
+	"github.com/gen0cide/laforge/ent/dnsrecord"
+	"github.com/gen0cide/laforge/ent/environment"
+	"github.com/gen0cide/laforge/ent/filedelete"
+	"github.com/gen0cide/laforge/ent/filedownload"
+	"github.com/gen0cide/laforge/ent/fileextract"
+	"github.com/gen0cide/laforge/ent/provisioningstep"
+	"github.com/gen0cide/laforge/ent/script"
+	"github.com/gen0cide/laforge/logging"
+	"github.com/gen0cide/laforge/server/utils"
+	"github.com/google/uuid"
+)
+
+// stepLink links a resolved script, command, file or DNS record to the provisioning step being created
+type stepLink func(*ent.ProvisioningStepCreate) *ent.ProvisioningStepCreate
+
+// stepTask is one agent task of an attempt of a provisioning step
+type stepTask struct {
+	command agenttask.Command
+	args    string
+	timeout int
+}
+
+// stepType plugs a kind of provisioning step into the planner: how a provision_steps ID resolves to it,
+// what is rendered for it while planning and the agent tasks that run it. createProvisioningStep and
+// execStep only go through the registered step types.
+type stepType interface {
+	// Type is what the provisioning steps of this kind are saved as
+	Type() provisioningstep.Type
+	// Resolve looks up the step with the given ID in an environment, the link is nil when there is none
+	Resolve(ctx context.Context, client *ent.Client, environmentID uuid.UUID, hclID string) (stepLink, error)
+	// Render renders the file the agent downloads for a provisioning step, "" when there is nothing to serve
+	Render(ctx context.Context, client *ent.Client, logger *logging.Logger, entStep *ent.ProvisioningStep) (string, error)
+	// Checksum hashes the environment config a provisioning step is planned from (see DiffBuild)
+	Checksum(ctx context.Context, entStep *ent.ProvisioningStep) (string, error)
+	// Options looks up the execution settings of a provisioning step
+	Options(ctx context.Context, entStep *ent.ProvisioningStep) (*stepOptions, error)
+	// Tasks returns the agent tasks of one attempt of a provisioning step, in the order they run
+	Tasks(ctx context.Context, client *ent.Client, logger *logging.Logger, entStep *ent.ProvisioningStep, downloadURL string) ([]stepTask, error)
+}
+
+// serverStepType is a stepType that also does part of its work from the server: Run is called after
+// the agent tasks of an attempt succeed (its error fails the attempt like a failed agent task) and
+// Teardown when the provisioning step is deleted
+type serverStepType interface {
+	stepType
+	Run(ctx context.Context, client *ent.Client, logger *logging.Logger, entStep *ent.ProvisioningStep) error
+	Teardown(ctx context.Context, client *ent.Client, logger *logging.Logger, entStep *ent.ProvisioningStep) error
+}
+
+var (
+	stepTypesMu sync.RWMutex
+	stepTypes   = make(map[provisioningstep.Type]stepType)
+)
+
+func init() {
+	registerStepType(scriptStep{})
+	registerStepType(commandStep{})
+	registerStepType(fileDownloadStep{})
+	registerStepType(fileExtractStep{})
+	registerStepType(fileDeleteStep{})
+	registerStepType(dnsRecordStep{})
+}
+
+// registerStepType makes a kind of provisioning step available to provision_steps. It panics if the
+// type has already been registered.
+func registerStepType(s stepType) {
+	stepTypesMu.Lock()
+	defer stepTypesMu.Unlock()
+	if _, dup := stepTypes[s.Type()]; dup {
+		panic("planner: registerStepType called twice for step type " + s.Type().String())
+	}
+	stepTypes[s.Type()] = s
+}
+
+// lookupStepType returns the registered step type the provisioning steps of the given type run with
+func lookupStepType(t provisioningstep.Type) (stepType, error) {
+	stepTypesMu.RLock()
+	defer stepTypesMu.RUnlock()
+	s, exists := stepTypes[t]
+	if !exists {
+		return nil, fmt.Errorf("no step type registered for %s provisioning steps", t)
+	}
+	return s, nil
+}
+
+// registeredStepTypes returns the registered step types sorted by type
+func registeredStepTypes() []stepType {
+	stepTypesMu.RLock()
+	defer stepTypesMu.RUnlock()
+	types := make([]stepType, 0, len(stepTypes))
+	for _, s := range stepTypes {
+		types = append(types, s)
+	}
+	sort.Slice(types, func(i, j int) bool {
+		return types[i].Type() < types[j].Type()
+	})
+	return types
+}
+
+// resolveStep finds the step type a provision_steps ID belongs to in an environment. IDs that aren't
+// defined, or are defined by more than one type of step, are rejected.
+func resolveStep(ctx context.Context, client *ent.Client, environmentID uuid.UUID, hclID string) (stepType, stepLink, error) {
+	var resolvedType stepType
+	var resolvedLink stepLink
+	matches := []string{}
+	for _, s := range registeredStepTypes() {
+		link, err := s.Resolve(ctx, client, environmentID, hclID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error querying %s %s: %v", s.Type(), hclID, err)
+		}
+		if link == nil {
+			continue
+		}
+		resolvedType, resolvedLink = s, link
+		matches = append(matches, s.Type().String())
+	}
+	switch len(matches) {
+	case 0:
+		return nil, nil, fmt.Errorf("no provisioning step %s found", hclID)
+	case 1:
+		return resolvedType, resolvedLink, nil
+	default:
+		return nil, nil, fmt.Errorf("provisioning step %s is ambiguous, it is defined as a %s", hclID, strings.Join(matches, " and a "))
+	}
+}
+
+// serveStepFile renders the file of a provisioning step (if its type has one) and serves it to the agent
+// through a temporary URL
+func serveStepFile(ctx context.Context, client *ent.Client, logger *logging.Logger, s stepType, entStep *ent.ProvisioningStep) error {
+	filePath, err := s.Render(ctx, client, logger, entStep)
+	if err != nil || filePath == "" {
+		return err
+	}
+	entTmpUrl, err := utils.CreateTempURL(ctx, client, filePath)
+	if err != nil {
+		return err
+	}
+	_, err = entTmpUrl.Update().SetGinFileMiddlewareToProvisioningStep(entStep).Save(ctx)
+	if err != nil {
+		return err
+	}
+	if RenderFilesTask != nil {
+		RenderFilesTask, err = RenderFilesTask.Update().AddServerTaskToGinFileMiddleware(entTmpUrl).Save(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// queryStepURLID returns the URL ID the rendered file of a provisioning step is served under
+func queryStepURLID(ctx context.Context, entStep *ent.ProvisioningStep) (string, error) {
+	entGinMiddleware, err := entStep.QueryProvisioningStepToGinFileMiddleware().Only(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed querying Gin File Middleware for Provisioning Step: %v", err)
+	}
+	return entGinMiddleware.URLID, nil
+}
+
+type scriptStep struct{}
+
+func (scriptStep) Type() provisioningstep.Type { return provisioningstep.TypeScript }
+
+func (scriptStep) Resolve(ctx context.Context, client *ent.Client, environmentID uuid.UUID, hclID string) (stepLink, error) {
+	entScript, err := client.Script.Query().Where(
+		script.HasScriptToEnvironmentWith(environment.IDEQ(environmentID)),
+		script.HclIDEQ(hclID),
+	).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return func(create *ent.ProvisioningStepCreate) *ent.ProvisioningStepCreate {
+		return create.SetProvisioningStepToScript(entScript)
+	}, nil
+}
+
+func (scriptStep) Render(ctx context.Context, client *ent.Client, logger *logging.Logger, entStep *ent.ProvisioningStep) (string, error) {
+	return renderScript(ctx, client, logger, entStep)
+}
+
+func (scriptStep) Checksum(ctx context.Context, entStep *ent.ProvisioningStep) (string, error) {
+	entScript, err := entStep.QueryProvisioningStepToScript().Only(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed querying Script for Provisioning Step: %v", err)
+	}
+	fileSum, err := fileChecksum(entScript.AbsPath)
+	if err != nil {
+		return "", err
+	}
+	return checksum(entScript.Source, entScript.Args, entScript.Vars, fileSum)
+}
+
+func (scriptStep) Options(ctx context.Context, entStep *ent.ProvisioningStep) (*stepOptions, error) {
+	entScript, err := entStep.QueryProvisioningStepToScript().Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying Script for Provisioning Step: %v", err)
+	}
+	return &stepOptions{timeout: entScript.Timeout, cooldown: entScript.Cooldown, ignoreErrors: entScript.IgnoreErrors, retry: entScript.Retry}, nil
+}
+
+func (scriptStep) Tasks(ctx context.Context, client *ent.Client, logger *logging.Logger, entStep *ent.ProvisioningStep, downloadURL string) ([]stepTask, error) {
+	entScript, err := entStep.QueryProvisioningStepToScript().Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying Script for Provisioning Step: %v", err)
+	}
+	if _, ok := entScript.Vars["build_render"]; ok {
+		_, err := renderScript(ctx, client, logger, entStep)
+		if err != nil {
+			return nil, fmt.Errorf("failed rerendering Script: %v", err)
+		}
+		logger.Log.Debugf("sucessful rerendering for Script %s", entScript.HclID)
+	}
+	urlID, err := queryStepURLID(ctx, entStep)
+	if err != nil {
+		return nil, err
+	}
+	// TODO: Add the Ability to change permissions of a file into the agent
+	return []stepTask{
+		{command: agenttask.CommandDOWNLOAD, args: entScript.Source + "💔" + downloadURL + urlID},
+		{command: agenttask.CommandEXECUTE, args: entScript.Source + "💔" + strings.Join(entScript.Args, " "), timeout: entScript.Timeout},
+		{command: agenttask.CommandDELETE, args: entScript.Source},
+	}, nil
+}
+
+type commandStep struct{}
+
+func (commandStep) Type() provisioningstep.Type { return provisioningstep.TypeCommand }
+
+func (commandStep) Resolve(ctx context.Context, client *ent.Client, environmentID uuid.UUID, hclID string) (stepLink, error) {
+	entCommand, err := client.Command.Query().Where(
+		command.HasCommandToEnvironmentWith(environment.IDEQ(environmentID)),
+		command.HclIDEQ(hclID),
+	).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return func(create *ent.ProvisioningStepCreate) *ent.ProvisioningStepCreate {
+		return create.SetProvisioningStepToCommand(entCommand)
+	}, nil
+}
+
+func (commandStep) Render(ctx context.Context, client *ent.Client, logger *logging.Logger, entStep *ent.ProvisioningStep) (string, error) {
+	return "", nil
+}
+
+func (commandStep) Checksum(ctx context.Context, entStep *ent.ProvisioningStep) (string, error) {
+	entCommand, err := entStep.QueryProvisioningStepToCommand().Only(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed querying Command for Provisioning Step: %v", err)
+	}
+	return checksum(entCommand.Program, entCommand.Args, entCommand.Vars)
+}
+
+func (commandStep) Options(ctx context.Context, entStep *ent.ProvisioningStep) (*stepOptions, error) {
+	entCommand, err := entStep.QueryProvisioningStepToCommand().Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying Command for Provisioning Step: %v", err)
+	}
+	return &stepOptions{timeout: entCommand.Timeout, cooldown: entCommand.Cooldown, ignoreErrors: entCommand.IgnoreErrors, retry: entCommand.Retry}, nil
+}
+
+func (commandStep) Tasks(ctx context.Context, client *ent.Client, logger *logging.Logger, entStep *ent.ProvisioningStep, downloadURL string) ([]stepTask, error) {
+	entCommand, err := entStep.QueryProvisioningStepToCommand().Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying Command for Provisioning Step: %v", err)
+	}
+	if entCommand.Program == "REBOOT" {
+		return []stepTask{{command: agenttask.CommandREBOOT}}, nil
+	}
+	return []stepTask{
+		{command: agenttask.CommandEXECUTE, args: entCommand.Program + "💔" + strings.Join(entCommand.Args, " "), timeout: entCommand.Timeout},
+	}, nil
+}
+
+type fileDownloadStep struct{}
+
+func (fileDownloadStep) Type() provisioningstep.Type { return provisioningstep.TypeFileDownload }
+
+func (fileDownloadStep) Resolve(ctx context.Context, client *ent.Client, environmentID uuid.UUID, hclID string) (stepLink, error) {
+	entFileDownload, err := client.FileDownload.Query().Where(
+		filedownload.HasFileDownloadToEnvironmentWith(environment.IDEQ(environmentID)),
+		filedownload.HclIDEQ(hclID),
+	).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return func(create *ent.ProvisioningStepCreate) *ent.ProvisioningStepCreate {
+		return create.SetProvisioningStepToFileDownload(entFileDownload)
+	}, nil
+}
+
+func (fileDownloadStep) Render(ctx context.Context, client *ent.Client, logger *logging.Logger, entStep *ent.ProvisioningStep) (string, error) {
+	return renderFileDownload(ctx, logger, entStep)
+}
+
+func (fileDownloadStep) Checksum(ctx context.Context, entStep *ent.ProvisioningStep) (string, error) {
+	entFileDownload, err := entStep.QueryProvisioningStepToFileDownload().Only(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed querying File Download for Provisioning Step: %v", err)
+	}
+	fileSum := ""
+	if entFileDownload.SourceType != "remote" {
+		fileSum, err = fileChecksum(entFileDownload.AbsPath)
+		if err != nil {
+			return "", err
+		}
+	}
+	return checksum(entFileDownload.SourceType, entFileDownload.Source, entFileDownload.Destination, entFileDownload.Template, entFileDownload.Perms, entFileDownload.Md5, entFileDownload.Sha256, fileSum)
+}
+
+func (fileDownloadStep) Options(ctx context.Context, entStep *ent.ProvisioningStep) (*stepOptions, error) {
+	entFileDownload, err := entStep.QueryProvisioningStepToFileDownload().Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying File Download for Provisioning Step: %v", err)
+	}
+	return &stepOptions{retry: entFileDownload.Retry}, nil
+}
+
+func (fileDownloadStep) Tasks(ctx context.Context, client *ent.Client, logger *logging.Logger, entStep *ent.ProvisioningStep, downloadURL string) ([]stepTask, error) {
+	entFileDownload, err := entStep.QueryProvisioningStepToFileDownload().Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying File Download for Provisioning Step: %v", err)
+	}
+	// Remote sources are served from the server's cache as well (see cacheRemoteFile)
+	urlID, err := queryStepURLID(ctx, entStep)
+	if err != nil {
+		return nil, err
+	}
+	tasks := []stepTask{
+		{command: agenttask.CommandDOWNLOAD, args: entFileDownload.Destination + "💔" + downloadURL + urlID},
+	}
+	if entFileDownload.Perms != "" {
+		mode, err := parseFileMode(entFileDownload.Perms)
+		if err != nil {
+			return nil, fmt.Errorf("invalid perms for File Download %s: %v", entFileDownload.HclID, err)
+		}
+		// The agent takes the mode as a (decimal) integer
+		tasks = append(tasks, stepTask{command: agenttask.CommandCHANGEPERMS, args: entFileDownload.Destination + "💔" + strconv.FormatUint(uint64(mode), 10)})
+	}
+	return tasks, nil
+}
+
+// parseFileMode parses the octal permissions of a file (ex. "0644" or "755")
+func parseFileMode(perms string) (os.FileMode, error) {
+	mode, err := strconv.ParseUint(perms, 8, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%s is not an octal file mode", perms)
+	}
+	if mode > 07777 {
+		return 0, fmt.Errorf("%s is out of range for a file mode", perms)
+	}
+	return os.FileMode(mode), nil
+}
+
+type fileExtractStep struct{}
+
+func (fileExtractStep) Type() provisioningstep.Type { return provisioningstep.TypeFileExtract }
+
+func (fileExtractStep) Resolve(ctx context.Context, client *ent.Client, environmentID uuid.UUID, hclID string) (stepLink, error) {
+	entFileExtract, err := client.FileExtract.Query().Where(
+		fileextract.HasFileExtractToEnvironmentWith(environment.IDEQ(environmentID)),
+		fileextract.HclIDEQ(hclID),
+	).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return func(create *ent.ProvisioningStepCreate) *ent.ProvisioningStepCreate {
+		return create.SetProvisioningStepToFileExtract(entFileExtract)
+	}, nil
+}
+
+func (fileExtractStep) Render(ctx context.Context, client *ent.Client, logger *logging.Logger, entStep *ent.ProvisioningStep) (string, error) {
+	return "", nil
+}
+
+func (fileExtractStep) Checksum(ctx context.Context, entStep *ent.ProvisioningStep) (string, error) {
+	entFileExtract, err := entStep.QueryProvisioningStepToFileExtract().Only(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed querying File Extract for Provisioning Step: %v", err)
+	}
+	return checksum(entFileExtract.Source, entFileExtract.Destination, entFileExtract.Type)
+}
+
+func (fileExtractStep) Options(ctx context.Context, entStep *ent.ProvisioningStep) (*stepOptions, error) {
+	entFileExtract, err := entStep.QueryProvisioningStepToFileExtract().Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying File Extract for Provisioning Step: %v", err)
+	}
+	return &stepOptions{retry: entFileExtract.Retry}, nil
+}
+
+func (fileExtractStep) Tasks(ctx context.Context, client *ent.Client, logger *logging.Logger, entStep *ent.ProvisioningStep, downloadURL string) ([]stepTask, error) {
+	entFileExtract, err := entStep.QueryProvisioningStepToFileExtract().Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying File Extract for Provisioning Step: %v", err)
+	}
+	return []stepTask{
+		{command: agenttask.CommandEXTRACT, args: entFileExtract.Source + "💔" + entFileExtract.Destination},
+	}, nil
+}
+
+type fileDeleteStep struct{}
+
+func (fileDeleteStep) Type() provisioningstep.Type { return provisioningstep.TypeFileDelete }
+
+func (fileDeleteStep) Resolve(ctx context.Context, client *ent.Client, environmentID uuid.UUID, hclID string) (stepLink, error) {
+	entFileDelete, err := client.FileDelete.Query().Where(
+		filedelete.HasFileDeleteToEnvironmentWith(environment.IDEQ(environmentID)),
+		filedelete.HclIDEQ(hclID),
+	).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return func(create *ent.ProvisioningStepCreate) *ent.ProvisioningStepCreate {
+		return create.SetProvisioningStepToFileDelete(entFileDelete)
+	}, nil
+}
+
+func (fileDeleteStep) Render(ctx context.Context, client *ent.Client, logger *logging.Logger, entStep *ent.ProvisioningStep) (string, error) {
+	return "", nil
+}
+
+func (fileDeleteStep) Checksum(ctx context.Context, entStep *ent.ProvisioningStep) (string, error) {
+	entFileDelete, err := entStep.QueryProvisioningStepToFileDelete().Only(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed querying File Delete for Provisioning Step: %v", err)
+	}
+	return checksum(entFileDelete.Path)
+}
+
+func (fileDeleteStep) Options(ctx context.Context, entStep *ent.ProvisioningStep) (*stepOptions, error) {
+	entFileDelete, err := entStep.QueryProvisioningStepToFileDelete().Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying File Delete for Provisioning Step: %v", err)
+	}
+	return &stepOptions{retry: entFileDelete.Retry}, nil
+}
+
+func (fileDeleteStep) Tasks(ctx context.Context, client *ent.Client, logger *logging.Logger, entStep *ent.ProvisioningStep, downloadURL string) ([]stepTask, error) {
+	entFileDelete, err := entStep.QueryProvisioningStepToFileDelete().Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying File Delete for Provisioning Step: %v", err)
+	}
+	return []stepTask{
+		{command: agenttask.CommandDELETE, args: entFileDelete.Path},
+	}, nil
+}
+
+// dnsRecordStep steps don't run anything on the host, the record is pushed to the competition's DNS
+// servers instead
+type dnsRecordStep struct{}
+
+func (dnsRecordStep) Type() provisioningstep.Type { return provisioningstep.TypeDNSRecord }
+
+func (dnsRecordStep) Resolve(ctx context.Context, client *ent.Client, environmentID uuid.UUID, hclID string) (stepLink, error) {
+	entDNSRecord, err := client.DNSRecord.Query().Where(
+		dnsrecord.HasDNSRecordToEnvironmentWith(environment.IDEQ(environmentID)),
+		dnsrecord.HclIDEQ(hclID),
+	).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return func(create *ent.ProvisioningStepCreate) *ent.ProvisioningStepCreate {
+		return create.SetProvisioningStepToDNSRecord(entDNSRecord)
+	}, nil
+}
+
+func (dnsRecordStep) Render(ctx context.Context, client *ent.Client, logger *logging.Logger, entStep *ent.ProvisioningStep) (string, error) {
+	return "", nil
+}
+
+func (dnsRecordStep) Checksum(ctx context.Context, entStep *ent.ProvisioningStep) (string, error) {
+	entDNSRecord, err := entStep.QueryProvisioningStepToDNSRecord().Only(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed querying DNS Record for Provisioning Step: %v", err)
+	}
+	return checksum(entDNSRecord.Name, entDNSRecord.Values, entDNSRecord.Type, entDNSRecord.Zone, entDNSRecord.Vars, entDNSRecord.Disabled)
+}
+
+func (dnsRecordStep) Options(ctx context.Context, entStep *ent.ProvisioningStep) (*stepOptions, error) {
+	return &stepOptions{}, nil
+}
+
+func (dnsRecordStep) Tasks(ctx context.Context, client *ent.Client, logger *logging.Logger, entStep *ent.ProvisioningStep, downloadURL string) ([]stepTask, error) {
+	return []stepTask{}, nil
+}
+
+func (dnsRecordStep) Run(ctx context.Context, client *ent.Client, logger *logging.Logger, entStep *ent.ProvisioningStep) error {
+	return pushDNSRecord(ctx, logger, entStep)
+}
+
+func (dnsRecordStep) Teardown(ctx context.Context, client *ent.Client, logger *logging.Logger, entStep *ent.ProvisioningStep) error {
+	return removeDNSRecord(ctx, logger, entStep)
+}
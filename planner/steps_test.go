@@ -0,0 +1,100 @@
+package planner
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/gen0cide/laforge/ent"
+	"github.com/gen0cide/laforge/ent/provisioningstep"
+	"github.com/google/uuid"
+)
+
+// fakeStep is a step type that resolves the IDs it is given, the other stepType methods aren't used by resolveStep
+type fakeStep struct {
+	stepType
+	kind provisioningstep.Type
+	ids  map[string]bool
+	err  error
+}
+
+func (s fakeStep) Type() provisioningstep.Type { return s.kind }
+
+func (s fakeStep) Resolve(ctx context.Context, client *ent.Client, environmentID uuid.UUID, hclID string) (stepLink, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	if !s.ids[hclID] {
+		return nil, nil
+	}
+	return func(create *ent.ProvisioningStepCreate) *ent.ProvisioningStepCreate { return create }, nil
+}
+
+// withStepTypes swaps the registered step types for the duration of a test
+func withStepTypes(t *testing.T, types ...stepType) {
+	stepTypesMu.Lock()
+	registered := stepTypes
+	stepTypes = make(map[provisioningstep.Type]stepType)
+	stepTypesMu.Unlock()
+	t.Cleanup(func() {
+		stepTypesMu.Lock()
+		stepTypes = registered
+		stepTypesMu.Unlock()
+	})
+	for _, s := range types {
+		registerStepType(s)
+	}
+}
+
+func TestResolveStep(t *testing.T) {
+	scripts := fakeStep{kind: provisioningstep.TypeScript, ids: map[string]bool{"setup": true, "shared": true, "everywhere": true}}
+	commands := fakeStep{kind: provisioningstep.TypeCommand, ids: map[string]bool{"reboot": true, "shared": true, "everywhere": true}}
+	fileDownloads := fakeStep{kind: provisioningstep.TypeFileDownload, ids: map[string]bool{"motd": true, "everywhere": true}}
+	withStepTypes(t, scripts, commands, fileDownloads)
+
+	tests := []struct {
+		hclID    string
+		wantType provisioningstep.Type
+		// wantErr is a substring of the expected error, empty when the step resolves
+		wantErr string
+	}{
+		{hclID: "setup", wantType: provisioningstep.TypeScript},
+		{hclID: "reboot", wantType: provisioningstep.TypeCommand},
+		{hclID: "motd", wantType: provisioningstep.TypeFileDownload},
+		{hclID: "missing", wantErr: "no provisioning step missing found"},
+		{hclID: "shared", wantErr: "provisioning step shared is ambiguous, it is defined as a Command and a Script"},
+		{hclID: "everywhere", wantErr: "provisioning step everywhere is ambiguous, it is defined as a Command and a FileDownload and a Script"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.hclID, func(t *testing.T) {
+			s, link, err := resolveStep(context.Background(), nil, uuid.New(), tt.hclID)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("resolveStep(%q) error = %v, want %q", tt.hclID, err, tt.wantErr)
+				}
+				if s != nil || link != nil {
+					t.Errorf("resolveStep(%q) resolved a step along with an error", tt.hclID)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolveStep(%q) error = %v", tt.hclID, err)
+			}
+			if s == nil || s.Type() != tt.wantType || link == nil {
+				t.Errorf("resolveStep(%q) = %v, want a %s step", tt.hclID, s, tt.wantType)
+			}
+		})
+	}
+}
+
+func TestResolveStepQueryError(t *testing.T) {
+	withStepTypes(t,
+		fakeStep{kind: provisioningstep.TypeScript, ids: map[string]bool{"setup": true}},
+		fakeStep{kind: provisioningstep.TypeCommand, err: fmt.Errorf("connection refused")},
+	)
+	_, _, err := resolveStep(context.Background(), nil, uuid.New(), "setup")
+	if err == nil || !strings.Contains(err.Error(), "error querying Command setup: connection refused") {
+		t.Errorf("resolveStep() error = %v, want the Command query error", err)
+	}
+}
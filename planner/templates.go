@@ -11,6 +11,7 @@ import (
 
 	"github.com/bradfitz/iter"
 	"github.com/gen0cide/laforge/ent"
+	"github.com/gen0cide/laforge/ipam"
 	"github.com/iancoleman/strcase"
 	"github.com/sirupsen/logrus"
 )
@@ -25,6 +26,7 @@ type TempleteContext struct {
 	IncludedNetworks   []*ent.IncludedNetwork
 	Network            *ent.Network
 	Script             *ent.Script
+	FileDownload       *ent.FileDownload
 	Team               *ent.Team
 	Identities         []*ent.Identity
 	ProvisionedNetwork *ent.ProvisionedNetwork
@@ -61,20 +63,56 @@ var TemplateFuncLib = template.FuncMap{
 	"CalcIP":               CalcIP,
 	"TagEquals":            TagEquals,
 	"Octet":                Octet,
+	"Gateway":              Gateway,
+	"Netmask":              Netmask,
 	"Base":                 path.Base,
 }
 
-// Octet is a template helper function to get a network's octet at a specified offset
+// Octet is a template helper function to get the third octet of an IPv4 network
 func Octet(n *ent.Network) string {
 	if n.Cidr == "" {
 		return "NO_CIDR"
 	}
-	octets := strings.Split(n.Cidr, ".")
-	if len(octets) <= 3 {
+	ip, _, err := net.ParseCIDR(n.Cidr)
+	if err != nil || ip.To4() == nil {
 		return "INVALID_CIDR"
 	}
 
-	return octets[2]
+	return strconv.Itoa(int(ip.To4()[2]))
+}
+
+// Gateway is a template helper function to get a network's gateway address, either the gateway_address var or the default for its cidr
+func Gateway(n *ent.Network) string {
+	prefix, err := ipam.ParsePrefix(n.Cidr)
+	if err != nil {
+		return "INVALID_CIDR"
+	}
+	gateway, err := prefix.Gateway(n.Vars[ipam.GatewayVar])
+	if err != nil {
+		return "INVALID_GATEWAY"
+	}
+	return gateway.String()
+}
+
+// Netmask is a template helper function to get a network's netmask (dotted for IPv4, a prefix length for IPv6)
+func Netmask(n *ent.Network) string {
+	prefix, err := ipam.ParsePrefix(n.Cidr)
+	if err != nil {
+		return "INVALID_CIDR"
+	}
+	return prefix.Netmask()
+}
+
+// networkForTeam returns a copy of n with the ${team} placeholder in its cidrs and vars replaced by the team number
+func networkForTeam(n *ent.Network, teamNumber int) *ent.Network {
+	teamNetwork := *n
+	teamNetwork.Cidr = ipam.ForTeam(n.Cidr, teamNumber)
+	teamNetwork.Ipv6Cidr = ipam.ForTeam(n.Ipv6Cidr, teamNumber)
+	teamNetwork.Vars = make(map[string]string, len(n.Vars))
+	for key, value := range n.Vars {
+		teamNetwork.Vars[key] = ipam.ForTeam(value, teamNumber)
+	}
+	return &teamNetwork
 }
 
 func TagEquals(h *ent.Host, tag, value string) bool {
@@ -88,17 +126,15 @@ func TagEquals(h *ent.Host, tag, value string) bool {
 	return false
 }
 
-// CalcIP is used to calculate the IP of a host within a given subnet
+// CalcIP is used to calculate the IP of a host within a given subnet. The subnet can be IPv4 or IPv6
+// of any size and the offset can go past the last octet (e.g. 300 in a /23).
 func CalcIP(subnet string, lastOctect int) (string, error) {
-	ip, _, err := net.ParseCIDR(subnet)
+	ip, err := ipam.HostIP(subnet, int64(lastOctect))
 	if err != nil {
-		logrus.Errorf("Invalid Subnet %v. Err: %v", subnet, err)
+		logrus.Errorf("Invalid host address in subnet %v. Err: %v", subnet, err)
 		return "", err
 	}
-	offset32 := uint32(lastOctect)
-	ip32 := IPv42Int(ip)
-	newIP := Int2IPv4(ip32 + offset32)
-	return newIP.To4().String(), nil
+	return ip, nil
 }
 
 // UnsafeStringAsInt is a template helper function that will return -1 if it cannot convert the string to an integer.
@@ -0,0 +1,121 @@
+// Package retry holds the retry policy of provisioning steps (scripts, commands and file steps).
+// A step whose agent tasks fail is re-run until it succeeds, runs out of attempts or fails in a
+// way the policy doesn't consider retryable.
+package retry
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultBackoff is the wait before the first retry when the policy doesn't set one
+	DefaultBackoff = 10 * time.Second
+	// DefaultMaxBackoff caps the doubling backoff when the policy doesn't set a cap
+	DefaultMaxBackoff = 5 * time.Minute
+)
+
+// Policy is the `retry` block of a script, command or file step
+type Policy struct {
+	// Attempts is the total number of times the step is run, including the first one
+	Attempts int `hcl:"attempts,optional" json:"attempts,omitempty"`
+	// Backoff is the wait before the first retry, it doubles on every retry after that
+	Backoff string `hcl:"backoff,optional" json:"backoff,omitempty"`
+	// MaxBackoff caps the wait between two attempts
+	MaxBackoff string `hcl:"max_backoff,optional" json:"max_backoff,omitempty"`
+	// RetryOn are regular expressions matched against the errors of the failed agent tasks. Only failures
+	// matching one of them are retried, every failure is retried when it is empty.
+	RetryOn []string `hcl:"retry_on,optional" json:"retry_on,omitempty"`
+}
+
+// Validate checks that the durations and expressions of the policy parse
+func (p *Policy) Validate() error {
+	if p == nil {
+		return nil
+	}
+	if p.Attempts < 0 {
+		return fmt.Errorf("attempts can't be negative")
+	}
+	for name, value := range map[string]string{"backoff": p.Backoff, "max_backoff": p.MaxBackoff} {
+		if value == "" {
+			continue
+		}
+		if _, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("invalid %s \"%s\": %v", name, value, err)
+		}
+	}
+	for _, expr := range p.RetryOn {
+		if _, err := compile(expr); err != nil {
+			return fmt.Errorf("invalid retry_on expression \"%s\": %v", expr, err)
+		}
+	}
+	return nil
+}
+
+// MaxAttempts returns how many times the step can be run, a step without a policy is run once
+func (p *Policy) MaxAttempts() int {
+	if p == nil || p.Attempts < 1 {
+		return 1
+	}
+	return p.Attempts
+}
+
+// Delay returns how long to wait before the given attempt (2 being the first retry)
+func (p *Policy) Delay(attempt int) time.Duration {
+	backoff, maxBackoff := DefaultBackoff, DefaultMaxBackoff
+	if p != nil {
+		if d, err := time.ParseDuration(p.Backoff); err == nil {
+			backoff = d
+		}
+		if d, err := time.ParseDuration(p.MaxBackoff); err == nil {
+			maxBackoff = d
+		}
+	}
+	delay := backoff
+	for i := 2; i < attempt && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > maxBackoff {
+		delay = maxBackoff
+	}
+	return delay
+}
+
+// Retryable returns true if every one of the failures matches a retry_on expression
+func (p *Policy) Retryable(failures []string) bool {
+	if p == nil || len(p.RetryOn) == 0 {
+		return true
+	}
+	for _, failure := range failures {
+		matched := false
+		for _, expr := range p.RetryOn {
+			if re, err := compile(expr); err == nil && re.MatchString(failure) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// compiledRetryOn caches the retry_on expressions by source. Policies are decoded from the steps they
+// belong to every time a step runs, so the expressions can't be compiled once on the policy itself.
+var compiledRetryOn sync.Map
+
+// compile returns the compiled expression, compiling it on first use
+func compile(expr string) (*regexp.Regexp, error) {
+	if re, ok := compiledRetryOn.Load(expr); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	compiledRetryOn.Store(expr, re)
+	return re, nil
+}
@@ -0,0 +1,101 @@
+package retry
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPolicyDelay(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  *Policy
+		attempt int
+		want    time.Duration
+	}{
+		{"no policy first retry", nil, 2, DefaultBackoff},
+		{"no policy doubles", nil, 3, 2 * DefaultBackoff},
+		{"no policy capped", nil, 10, DefaultMaxBackoff},
+		{"defaults", &Policy{}, 4, 4 * DefaultBackoff},
+		{"first retry", &Policy{Backoff: "1s", MaxBackoff: "5s"}, 2, time.Second},
+		{"second retry", &Policy{Backoff: "1s", MaxBackoff: "5s"}, 3, 2 * time.Second},
+		{"third retry", &Policy{Backoff: "1s", MaxBackoff: "5s"}, 4, 4 * time.Second},
+		{"capped", &Policy{Backoff: "1s", MaxBackoff: "5s"}, 5, 5 * time.Second},
+		{"stays capped", &Policy{Backoff: "1s", MaxBackoff: "5s"}, 50, 5 * time.Second},
+		{"backoff over cap", &Policy{Backoff: "1m", MaxBackoff: "5s"}, 2, 5 * time.Second},
+		{"default cap", &Policy{Backoff: "1m"}, 5, DefaultMaxBackoff},
+		{"invalid backoff", &Policy{Backoff: "soon"}, 2, DefaultBackoff},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.Delay(tt.attempt); got != tt.want {
+				t.Errorf("Delay(%d) = %s, want %s", tt.attempt, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolicyRetryable(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   *Policy
+		failures []string
+		want     bool
+	}{
+		{"no policy", nil, []string{"exit status 1"}, true},
+		{"no retry_on", &Policy{Attempts: 3}, []string{"exit status 1"}, true},
+		{"matches", &Policy{RetryOn: []string{"timeout"}}, []string{"dial tcp: i/o timeout"}, true},
+		{"matches one of", &Policy{RetryOn: []string{"timeout", "^exit status [0-9]+$"}}, []string{"exit status 2"}, true},
+		{"no match", &Policy{RetryOn: []string{"timeout"}}, []string{"exit status 1"}, false},
+		{"every failure has to match", &Policy{RetryOn: []string{"timeout"}}, []string{"i/o timeout", "exit status 1"}, false},
+		{"invalid expression never matches", &Policy{RetryOn: []string{"("}}, []string{"("}, false},
+		{"no failures", &Policy{RetryOn: []string{"timeout"}}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Twice to go through the compiled expression cache
+			for i := 0; i < 2; i++ {
+				if got := tt.policy.Retryable(tt.failures); got != tt.want {
+					t.Errorf("Retryable(%q) = %v, want %v", tt.failures, got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestPolicyValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  *Policy
+		wantErr bool
+	}{
+		{"no policy", nil, false},
+		{"valid", &Policy{Attempts: 3, Backoff: "5s", MaxBackoff: "1m", RetryOn: []string{"timeout"}}, false},
+		{"negative attempts", &Policy{Attempts: -1}, true},
+		{"invalid backoff", &Policy{Backoff: "soon"}, true},
+		{"invalid max_backoff", &Policy{MaxBackoff: "10"}, true},
+		{"invalid retry_on", &Policy{RetryOn: []string{"("}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.policy.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPolicyMaxAttempts(t *testing.T) {
+	tests := []struct {
+		policy *Policy
+		want   int
+	}{
+		{nil, 1},
+		{&Policy{}, 1},
+		{&Policy{Attempts: 4}, 4},
+	}
+	for _, tt := range tests {
+		if got := tt.policy.MaxAttempts(); got != tt.want {
+			t.Errorf("MaxAttempts() of %+v = %d, want %d", tt.policy, got, tt.want)
+		}
+	}
+}
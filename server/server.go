@@ -9,6 +9,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -17,8 +18,10 @@ import (
 	"github.com/99designs/gqlgen/graphql/handler/lru"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/gen0cide/laforge/dnsserver"
 	"github.com/gen0cide/laforge/ent"
 	"github.com/gen0cide/laforge/ent/authuser"
+	"github.com/gen0cide/laforge/ent/build"
 	"github.com/gen0cide/laforge/ent/ginfilemiddleware"
 	"github.com/gen0cide/laforge/ent/servertask"
 	"github.com/gen0cide/laforge/ent/status"
@@ -28,6 +31,7 @@ import (
 	"github.com/gen0cide/laforge/grpc/server"
 	"github.com/gen0cide/laforge/grpc/server/static"
 	"github.com/gen0cide/laforge/logging"
+	"github.com/gen0cide/laforge/planner"
 	"github.com/gen0cide/laforge/server/utils"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -185,6 +189,83 @@ func tempServerTaskHandler(client *ent.Client) gin.HandlerFunc {
 	}
 }
 
+// planGraphHandler exports the plan DAG of a build. The format query param is dot (default), mermaid or json,
+// teams, networks, hosts and host_tags are comma separated lists that filter the graph.
+func planGraphHandler(client *ent.Client) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		// The middleware lets unauthenticated requests through, check the same roles as the getPlanGraph query
+		currentUser, err := auth.ForContext(ctx.Request.Context())
+		if err != nil {
+			ctx.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+		if currentUser.Role != authuser.RoleADMIN && currentUser.Role != authuser.RoleUSER {
+			ctx.AbortWithStatus(http.StatusForbidden)
+			return
+		}
+
+		buildUUID, err := uuid.Parse(ctx.Param("build_id"))
+		if err != nil {
+			ctx.AbortWithStatus(404)
+			return
+		}
+		entBuild, err := client.Build.Get(ctx, buildUUID)
+		if err != nil {
+			ctx.AbortWithStatus(404)
+			return
+		}
+
+		splitParam := func(name string) []string {
+			if ctx.Query(name) == "" {
+				return nil
+			}
+			return strings.Split(ctx.Query(name), ",")
+		}
+		var filter *planner.BuildFilter
+		if ctx.Query("teams") != "" || ctx.Query("networks") != "" || ctx.Query("hosts") != "" || ctx.Query("host_tags") != "" {
+			filter = &planner.BuildFilter{
+				Networks: splitParam("networks"),
+				Hosts:    splitParam("hosts"),
+				HostTags: splitParam("host_tags"),
+			}
+			for _, team := range splitParam("teams") {
+				teamNumber, err := strconv.Atoi(team)
+				if err != nil {
+					ctx.String(http.StatusBadRequest, "invalid team number %s", team)
+					return
+				}
+				filter.Teams = append(filter.Teams, teamNumber)
+			}
+		}
+
+		entEnvironment, err := entBuild.QueryBuildToEnvironment().Only(ctx)
+		if err != nil {
+			ctx.AbortWithStatus(404)
+			return
+		}
+		err = filter.Validate(ctx, entEnvironment)
+		if err != nil {
+			ctx.String(http.StatusBadRequest, "invalid build filter: %v", err)
+			return
+		}
+
+		format := ctx.DefaultQuery("format", planner.PlanGraphDOT)
+		planGraph, err := planner.ExportPlanGraph(ctx, entBuild, filter, format)
+		if err != nil {
+			ctx.String(http.StatusBadRequest, "%v", err)
+			return
+		}
+		contentType := "text/vnd.graphviz; charset=utf-8"
+		switch strings.ToLower(format) {
+		case planner.PlanGraphMermaid:
+			contentType = "text/plain; charset=utf-8"
+		case planner.PlanGraphJSON:
+			contentType = "application/json; charset=utf-8"
+		}
+		ctx.Data(http.StatusOK, contentType, []byte(planGraph))
+	}
+}
+
 func main() {
 	// Start logging all Logrus output to files
 	ginMode := os.Getenv("GIN_MODE")
@@ -244,9 +325,20 @@ func main() {
 		}
 	}(client, ctx)
 
-	// Fail all Server Tasks that got interrupted
+	// Pick back up the builds that got interrupted, their server tasks keep running
+	resumedBuildIDs, err := planner.ResumeBuilds(ctx, client)
+	if err != nil {
+		logrus.Errorf("error while resuming interrupted builds: %v", err)
+	} else if len(resumedBuildIDs) > 0 {
+		logrus.Warnf("Resumed %d interrupted builds", len(resumedBuildIDs))
+	}
+
+	// Fail all other Server Tasks that got interrupted
 	go func(client *ent.Client, ctx context.Context) {
-		interruptedServerTasks, err := client.ServerTask.Query().Where(servertask.HasServerTaskToStatusWith(status.StateEQ(status.StateINPROGRESS))).All(ctx)
+		interruptedServerTasks, err := client.ServerTask.Query().Where(
+			servertask.HasServerTaskToStatusWith(status.StateEQ(status.StateINPROGRESS)),
+			servertask.Not(servertask.HasServerTaskToBuildWith(build.IDIn(resumedBuildIDs...))),
+		).All(ctx)
 		if err != nil {
 			if ent.IsNotFound(err) {
 				logrus.Info("no interrupted server tasks found.")
@@ -353,6 +445,16 @@ func main() {
 		}
 	}()
 
+	// Answer for the root domains of the competitions with a "laforge" dns block
+	if dnsAddress, ok := os.LookupEnv("DNS_SERVER_ADDRESS"); ok {
+		go func() {
+			err := dnsserver.New(client, rdb).ListenAndServe(ctx, dnsAddress)
+			if err != nil {
+				logrus.Errorf("error running the built-in DNS server: %v", err)
+			}
+		}()
+	}
+
 	auth.InitGoth()
 
 	router := gin.Default()
@@ -395,6 +497,7 @@ func main() {
 	api.GET("/query", gqlHandler)
 	api.GET("/download/:url_id", tempURLHandler(client))
 	api.GET("/view_server_logs/:server_task_id", tempServerTaskHandler(client))
+	api.GET("/plan_graph/:build_id", planGraphHandler(client))
 	api.GET("/playground", playgroundHandler())
 	go router.Run(port)
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/gen0cide/laforge/approval"
 	"github.com/gen0cide/laforge/ent"
 	"github.com/gen0cide/laforge/ent/buildcommit"
 	"github.com/gen0cide/laforge/ent/plandiff"
@@ -12,8 +13,13 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-// CreateRootCommit creates the root commit on a build
-func CreateRootCommit(client *ent.Client, rdb *redis.Client, entBuild *ent.Build) (*ent.BuildCommit, error) {
+// commitReviewFallbackInterval is how often a commit waiting for its review is checked when no
+// update was published for it
+const commitReviewFallbackInterval = 30 * time.Second
+
+// CreateRootCommit creates the root commit on a build. The commit is approved right away if the
+// approval policy of the build's environment auto approves ROOT commits.
+func CreateRootCommit(client *ent.Client, rdb *redis.Client, currentUser *ent.AuthUser, entBuild *ent.Build) (*ent.BuildCommit, error) {
 	ctx := context.Background()
 	defer ctx.Done()
 
@@ -21,13 +27,23 @@ func CreateRootCommit(client *ent.Client, rdb *redis.Client, entBuild *ent.Build
 	if err != nil {
 		return nil, fmt.Errorf("error querying plans from build: %v", err)
 	}
+	entEnvironment, err := entBuild.QueryBuildToEnvironment().Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error querying environment from build: %v", err)
+	}
 
-	rootCommit, err := client.BuildCommit.Create().
+	rootCommitCreate := client.BuildCommit.Create().
 		SetType(buildcommit.TypeROOT).
 		SetRevision(0).
 		SetBuildCommitToBuild(entBuild).
 		SetState(buildcommit.StatePLANNING).
-		Save(ctx)
+		SetCreatedBy(currentUser.ID.String())
+	if entEnvironment.ApprovalPolicy.AutoApproves(string(buildcommit.TypeROOT)) {
+		rootCommitCreate = rootCommitCreate.
+			SetState(buildcommit.StateAPPROVED).
+			SetApprovals([]approval.Approval{{ApprovedAt: time.Now(), Auto: true}})
+	}
+	rootCommit, err := rootCommitCreate.Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error creating root commit: %v", err)
 	}
@@ -63,30 +79,70 @@ func CreateRootCommit(client *ent.Client, rdb *redis.Client, entBuild *ent.Build
 	return rootCommit, nil
 }
 
-// WaitForCommitReview halts program execution until a given build commit has been either approved or cancelled. Returns true if the commit was approved or false if the commit was cancelled or timeout was reached.
-func WaitForCommitReview(client *ent.Client, entBuildCommit *ent.BuildCommit, timeout time.Duration) (bool, error) {
+// commitReviewTimeout returns how long a build commit waits for its review, as set by the approval policy of the build's environment
+func commitReviewTimeout(ctx context.Context, entBuildCommit *ent.BuildCommit) (time.Duration, error) {
+	entEnvironment, err := entBuildCommit.QueryBuildCommitToBuild().QueryBuildToEnvironment().Only(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("error querying environment from build commit: %v", err)
+	}
+	return entEnvironment.ApprovalPolicy.ReviewTimeout(), nil
+}
+
+// WaitForCommitReview halts program execution until a given build commit has been either approved or cancelled. Returns true if the commit was approved or false if the commit was cancelled or the review timeout of the environment's approval policy was reached.
+func WaitForCommitReview(client *ent.Client, rdb *redis.Client, entBuildCommit *ent.BuildCommit) (bool, error) {
 	ctx := context.Background()
 	defer ctx.Done()
-	startTime := time.Now()
+
+	timeout, err := commitReviewTimeout(ctx, entBuildCommit)
+	if err != nil {
+		return false, err
+	}
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	fallback := time.NewTicker(commitReviewFallbackInterval)
+	defer fallback.Stop()
+
+	// Subscribe before the first check so a review made in between isn't missed
+	sub := rdb.Subscribe(ctx, "updatedBuildCommit")
+	defer sub.Close()
+	_, err = sub.Receive(ctx)
+	if err != nil {
+		// The subscription reconnects on its own, until then the commit is checked every fallback interval
+		logrus.Warnf("error subscribing to redis build commit updates: %v", err)
+	}
+	updates := sub.Channel()
+
 	for {
 		entBuildCommit, err := client.BuildCommit.Query().Where(buildcommit.IDEQ(entBuildCommit.ID)).Only(ctx)
 		if err != nil {
 			return false, err
 		}
 
-		// If the user has made a decision
+		// If the users have made a decision
 		if entBuildCommit.State == buildcommit.StateCANCELLED {
 			return false, nil
 		} else if entBuildCommit.State == buildcommit.StateAPPROVED {
 			return true, nil
 		}
 
-		// Check if we've timed out already
-		if time.Since(startTime) >= timeout {
-			break
+		// Otherwise, wait for the commit to be updated and then check again
+	wait:
+		for {
+			select {
+			case message, ok := <-updates:
+				if !ok {
+					// The subscription was closed, fall back to checking every fallback interval
+					updates = nil
+					continue
+				}
+				if message.Payload == entBuildCommit.ID.String() {
+					break wait
+				}
+			case <-fallback.C:
+				break wait
+			case <-deadline.C:
+				return false, nil
+			}
 		}
-		// Otherwise, wait 1 second and then check again
-		time.Sleep(1 * time.Second)
 	}
-	return false, nil
 }
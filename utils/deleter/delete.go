@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"flag"
 	"log"
 	"os"
 
@@ -22,6 +23,10 @@ import (
 )
 
 func main() {
+	sweepEnv := flag.String("sweep", "", "HCL ID of a vsphere-nsxt environment to sweep for orphaned vSphere/NSX-T resources instead of cleaning up the database")
+	confirm := flag.Bool("confirm", false, "Delete the orphans found by -sweep (the default is a dry-run)")
+	flag.Parse()
+
 	logrus.SetLevel(logrus.DebugLevel)
 	pgHost, ok := os.LookupEnv("PG_URI")
 	client := &ent.Client{}
@@ -41,6 +46,13 @@ func main() {
 		log.Fatalf("failed creating schema resources: %v", err)
 	}
 
+	if *sweepEnv != "" {
+		if err := sweep(ctx, client, *sweepEnv, *confirm); err != nil {
+			log.Fatalf("failed to sweep: %v", err)
+		}
+		return
+	}
+
 	//GinFileMiddleware --
 	//AgentStatus --
 	//AgentTask --
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gen0cide/laforge/builder"
+	"github.com/gen0cide/laforge/builder/vspherensxt"
+	"github.com/gen0cide/laforge/ent"
+	"github.com/gen0cide/laforge/ent/environment"
+	"github.com/gen0cide/laforge/ent/status"
+	"github.com/gen0cide/laforge/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// sweep reports the vSphere/NSX-T resources named after builds that no longer exist (or were torn down)
+// and, if confirm is set, deletes them and releases their NAT addresses
+func sweep(ctx context.Context, client *ent.Client, envHclID string, confirm bool) error {
+	logger := &logging.Logger{Log: logrus.StandardLogger()}
+
+	entEnvironment, err := client.Environment.Query().Where(environment.HclIDEQ(envHclID)).Only(ctx)
+	if err != nil {
+		return fmt.Errorf("error querying environment \"%s\": %v", envHclID, err)
+	}
+	genericBuilder, err := builder.BuilderFromEnvironment(entEnvironment, logger)
+	if err != nil {
+		return fmt.Errorf("error generating builder: %v", err)
+	}
+	vsphereBuilder, ok := genericBuilder.(vspherensxt.VSphereNSXTBuilder)
+	if !ok {
+		return fmt.Errorf("builder \"%s\" can't be swept, only %s is supported", entEnvironment.Builder, vspherensxt.ID)
+	}
+
+	// The infrastructure is shared between environments, so every build in the database is considered
+	entBuilds, err := client.Build.Query().WithBuildToStatus().WithBuildToTeam().All(ctx)
+	if err != nil {
+		return fmt.Errorf("error querying builds: %v", err)
+	}
+	liveBuilds := map[string]bool{}
+	liveIps := map[string]bool{}
+	for _, entBuild := range entBuilds {
+		if entBuild.Edges.BuildToStatus != nil && entBuild.Edges.BuildToStatus.State == status.StateDELETED {
+			continue
+		}
+		liveBuilds[entBuild.ID.String()] = true
+		for _, entTeam := range entBuild.Edges.BuildToTeam {
+			if publicIp, exists := entTeam.Vars["gateway_public_ip"]; exists {
+				liveIps[publicIp] = true
+			}
+		}
+	}
+
+	orphans, err := vsphereBuilder.FindOrphans(ctx, liveBuilds, liveIps)
+	if err != nil {
+		return fmt.Errorf("error finding orphans: %v", err)
+	}
+	for _, orphan := range orphans {
+		fmt.Println(orphan)
+	}
+	if !confirm {
+		fmt.Printf("found %d orphans, run again with -confirm to delete them\n", len(orphans))
+		return nil
+	}
+
+	errs := vsphereBuilder.DeleteOrphans(ctx, orphans)
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to delete %d of %d orphans", len(errs), len(orphans))
+	}
+	fmt.Printf("deleted %d orphans\n", len(orphans))
+	return nil
+}